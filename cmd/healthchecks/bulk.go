@@ -0,0 +1,265 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+)
+
+// bulkCommand returns a command which performs action on every check with the given tags
+func bulkCommand(action healthchecksio.GroupAction) func(args []string) error {
+	return func(args []string) error {
+		fs := flag.NewFlagSet(string(action), flag.ContinueOnError)
+		clientFlags := addClientFlags(fs)
+		var tags stringsFlag
+		fs.Var(&tags, "tag", fmt.Sprintf("Only %s checks with this tag, repeat to require several", action))
+		yes := fs.Bool("yes", false, "Don't ask for confirmation")
+		dryRun := fs.Bool("dry-run", false, "Print the affected checks without changing anything")
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		if len(tags) == 0 {
+			return fmt.Errorf("usage: healthchecks %s --tag <tag> [--yes] [--dry-run]", action)
+		}
+
+		client, err := clientFlags.client()
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+		checks, err := healthchecksio.SelectGroup(ctx, client, action, healthchecksio.GetChecks{Tags: tags})
+		if err != nil {
+			return err
+		}
+		printGroup(os.Stdout, action, checks)
+
+		if *dryRun || len(checks) == 0 {
+			return nil
+		}
+		if !*yes {
+			if stdinIsPiped() {
+				return fmt.Errorf("refusing to %s checks without --yes when stdin isn't a terminal", action)
+			}
+			if !confirm(os.Stdin, os.Stdout, fmt.Sprintf("%s %d checks?", action, len(checks))) {
+				return errors.New("aborted")
+			}
+		}
+		return healthchecksio.ApplyGroup(ctx, client, action, checks, healthchecksio.GroupOptions{ConfirmDeletes: true})
+	}
+}
+
+func printGroup(w io.Writer, action healthchecksio.GroupAction, checks []healthchecksio.Check) {
+	if len(checks) == 0 {
+		fmt.Fprintf(w, "No checks to %s\n", action)
+		return
+	}
+	fmt.Fprintf(w, "Checks to %s:\n", action)
+	for _, check := range checks {
+		fmt.Fprintf(w, "  %s  %s (%s)\n", check.UUID, check.Name, check.Status)
+	}
+}
+
+// confirm writes prompt to w and reports if the answer read from r is yes
+func confirm(r io.Reader, w io.Writer, prompt string) bool {
+	fmt.Fprintf(w, "%s [y/N] ", prompt)
+
+	answer, _ := bufio.NewReader(r).ReadString('\n')
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true
+	}
+	return false
+}
+
+// resumeExpiredCommand resumes checks paused with healthchecksio.PauseCheckFor, meant to run from cron
+func resumeExpiredCommand(args []string) error {
+	fs := flag.NewFlagSet("resume-expired", flag.ContinueOnError)
+	clientFlags := addClientFlags(fs)
+	var tags stringsFlag
+	fs.Var(&tags, "tag", "Only resume checks with this tag, repeat to require several")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	client, err := clientFlags.client()
+	if err != nil {
+		return err
+	}
+	resumed, err := healthchecksio.ResumeExpired(context.Background(), client, healthchecksio.GetChecks{Tags: tags})
+	for _, check := range resumed {
+		fmt.Printf("resumed %s (%s)\n", check.Name, check.UUID)
+	}
+	return err
+}
+
+// checkEdit is the change the update command makes to each check
+type checkEdit struct {
+	grace, timeout time.Duration
+	add, remove    []string
+}
+
+// update returns the UpdateCheck applying e to check, or nil when check already matches
+func (e checkEdit) update(check healthchecksio.Check) (*healthchecksio.UpdateCheck, error) {
+	update := &healthchecksio.UpdateCheck{}
+	changed := false
+	if grace := int(e.grace.Seconds()); grace > 0 && grace != check.Grace {
+		update.Grace, changed = grace, true
+	}
+	if timeout := int(e.timeout.Seconds()); timeout > 0 && timeout != check.Timeout {
+		update.Timeout, changed = timeout, true
+	}
+
+	tags := strings.Fields(check.Tags)
+	want := slices.DeleteFunc(slices.Clone(tags), func(tag string) bool {
+		return slices.Contains(e.remove, tag)
+	})
+	for _, tag := range e.add {
+		if !slices.Contains(want, tag) {
+			want = append(want, tag)
+		}
+	}
+	if !slices.Equal(tags, want) {
+		if len(want) == 0 {
+			return nil, errors.New("can't remove every tag")
+		}
+		update.Tags, changed = strings.Join(want, " "), true
+	}
+
+	if !changed {
+		return nil, nil
+	}
+	return update, nil
+}
+
+// updateCommand changes the grace, timeout or tags of every check with the given tags
+func updateCommand(args []string) error {
+	fs := flag.NewFlagSet("update", flag.ContinueOnError)
+	clientFlags := addClientFlags(fs)
+	var tags, add, remove stringsFlag
+	fs.Var(&tags, "tag", "Only update checks with this tag, repeat to require several")
+	fs.Var(&add, "add-tag", "Add this tag, repeat to add several")
+	fs.Var(&remove, "remove-tag", "Remove this tag, repeat to remove several")
+	grace := fs.Duration("grace", 0, "Set the grace period")
+	timeout := fs.Duration("timeout", 0, "Set the timeout of simple checks")
+	rate := fs.Float64("rate", 5, "Most updates sent per second")
+	yes := fs.Bool("yes", false, "Don't ask for confirmation")
+	dryRun := fs.Bool("dry-run", false, "Print the affected checks without changing anything")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	edit := checkEdit{grace: *grace, timeout: *timeout, add: add, remove: remove}
+	if len(tags) == 0 || (edit.grace <= 0 && edit.timeout <= 0 && len(add) == 0 && len(remove) == 0) {
+		return errors.New("usage: healthchecks update --tag <tag> [--grace <duration>] [--timeout <duration>] [--add-tag <tag>] [--remove-tag <tag>] [--rate <n>] [--yes] [--dry-run]")
+	}
+
+	client, err := clientFlags.client()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	list, err := client.GetChecks(ctx, healthchecksio.GetChecks{Tags: tags})
+	if err != nil {
+		return err
+	}
+	var checks []healthchecksio.Check
+	for _, check := range list.Checks {
+		if update, err := edit.update(check); update != nil || err != nil {
+			checks = append(checks, check)
+		}
+	}
+	printGroup(os.Stdout, "update", checks)
+
+	if *dryRun || len(checks) == 0 {
+		return nil
+	}
+	if !*yes {
+		if stdinIsPiped() {
+			return errors.New("refusing to update checks without --yes when stdin isn't a terminal")
+		}
+		if !confirm(os.Stdin, os.Stdout, fmt.Sprintf("update %d checks?", len(checks))) {
+			return errors.New("aborted")
+		}
+	}
+
+	_, err = healthchecksio.BulkUpdate(ctx, client, checks, edit.update, healthchecksio.BulkUpdateOptions{
+		Rate: *rate,
+		Progress: func(p healthchecksio.BulkProgress) {
+			status := "updated"
+			if p.Err != nil {
+				status = "failed: " + p.Err.Error()
+			}
+			fmt.Fprintf(os.Stderr, "[%d/%d] %s %s\n", p.Done, p.Total, p.Check.Name, status)
+		},
+	})
+	return err
+}
+
+// archiveCommand soft-deletes (archive) or brings back (restore) one check
+func archiveCommand(restore bool) func(args []string) error {
+	name, done, apply := "archive", "archived", healthchecksio.ArchiveCheck
+	if restore {
+		name, done, apply = "restore", "restored", healthchecksio.RestoreCheck
+	}
+	return func(args []string) error {
+		fs := flag.NewFlagSet(name, flag.ContinueOnError)
+		clientFlags := addClientFlags(fs)
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		if fs.NArg() != 1 {
+			return fmt.Errorf("usage: healthchecks %s <uuid>", name)
+		}
+
+		client, err := clientFlags.client()
+		if err != nil {
+			return err
+		}
+		check, err := apply(context.Background(), client, fs.Arg(0))
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s %s (%s)\n", done, check.Name, check.UUID)
+		return nil
+	}
+}
+
+// purgeArchivedCommand deletes checks archived longer ago than --older-than, meant to run from cron
+func purgeArchivedCommand(args []string) error {
+	fs := flag.NewFlagSet("purge-archived", flag.ContinueOnError)
+	clientFlags := addClientFlags(fs)
+	var tags stringsFlag
+	fs.Var(&tags, "tag", "Only purge checks with this tag, repeat to require several")
+	olderThan := fs.String("older-than", "30d", "Delete checks archived longer ago than this, e.g. 30d")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	age, err := parseSince(*olderThan)
+	if err != nil {
+		return err
+	}
+
+	client, err := clientFlags.client()
+	if err != nil {
+		return err
+	}
+	purged, err := healthchecksio.PurgeArchived(context.Background(), client, healthchecksio.PurgeOptions{
+		Filter:         healthchecksio.GetChecks{Tags: tags},
+		OlderThan:      age,
+		ConfirmDeletes: true,
+	})
+	for _, check := range purged {
+		fmt.Printf("deleted %s (%s)\n", check.Name, check.UUID)
+	}
+	return err
+}
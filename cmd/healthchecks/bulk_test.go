@@ -0,0 +1,51 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfirm(t *testing.T) {
+	var buf bytes.Buffer
+	require.True(t, confirm(strings.NewReader("y\n"), &buf, "delete 2 checks?"))
+	require.Equal(t, "delete 2 checks? [y/N] ", buf.String())
+
+	require.True(t, confirm(strings.NewReader(" YES \n"), &buf, ""))
+	require.False(t, confirm(strings.NewReader("n\n"), &buf, ""))
+	require.False(t, confirm(strings.NewReader("\n"), &buf, ""))
+	require.False(t, confirm(strings.NewReader(""), &buf, ""))
+}
+
+func TestPrintGroup(t *testing.T) {
+	var buf bytes.Buffer
+	printGroup(&buf, healthchecksio.GroupPause, nil)
+	require.Equal(t, "No checks to pause\n", buf.String())
+
+	buf.Reset()
+	printGroup(&buf, healthchecksio.GroupDelete, []healthchecksio.Check{
+		{UUID: "1", Name: "backup", Status: "up"},
+	})
+	require.Equal(t, "Checks to delete:\n  1  backup (up)\n", buf.String())
+}
+
+func TestCheckEdit(t *testing.T) {
+	edit := checkEdit{grace: 5 * time.Minute, add: []string{"prod"}, remove: []string{"staging"}}
+
+	update, err := edit.update(healthchecksio.Check{Grace: 60, Tags: "backup staging"})
+	require.NoError(t, err)
+	require.Equal(t, &healthchecksio.UpdateCheck{Grace: 300, Tags: "backup prod"}, update)
+
+	// Checks which already match are skipped
+	update, err = edit.update(healthchecksio.Check{Grace: 300, Tags: "backup prod"})
+	require.NoError(t, err)
+	require.Nil(t, update)
+
+	_, err = checkEdit{remove: []string{"staging"}}.update(healthchecksio.Check{Tags: "staging"})
+	require.ErrorContains(t, err, "can't remove every tag")
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config is read from ~/.config/healthchecks/config.yml
+//
+//	default: prod
+//	profiles:
+//	  prod:
+//	    api_key: ...
+//	    ping_key: ...
+//	    protected_tags: [prod]
+//	  selfhosted:
+//	    api_key: ...
+//	    base_url: https://hc.example.com/api/v3
+//	    ping_url: https://hc.example.com/ping
+//	  reporting:
+//	    api_key: ...
+//	    access: read-only
+type Config struct {
+	Default  string             `yaml:"default"`
+	Profiles map[string]Profile `yaml:"profiles"`
+}
+
+// Profile holds the settings for one Healthchecks project
+type Profile struct {
+	APIKey  string `yaml:"api_key"`
+	PingKey string `yaml:"ping_key"`
+	BaseURL string `yaml:"base_url"`
+	PingURL string `yaml:"ping_url"`
+
+	// Access limits the commands' calls to full, read-only or ping-only
+	Access healthchecksio.Access `yaml:"access"`
+
+	// ProtectedTags are tags of checks the delete command refuses to touch
+	ProtectedTags []string `yaml:"protected_tags"`
+}
+
+func defaultConfigPath() string {
+	if path := os.Getenv("HEALTHCHECKS_CONFIG"); path != "" {
+		return path
+	}
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "healthchecks", "config.yml")
+}
+
+func readConfig(path string) (*Config, error) {
+	var cfg Config
+	if path == "" {
+		return &cfg, nil
+	}
+
+	bs, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return &cfg, nil
+		}
+		return nil, fmt.Errorf("reading config: %w", err)
+	}
+	if err := yaml.Unmarshal(bs, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing config %s: %w", path, err)
+	}
+	return &cfg, nil
+}
+
+// profile returns the named profile, falling back to the config's default
+func (c *Config) profile(name string) (Profile, error) {
+	if name == "" {
+		name = c.Default
+	}
+	if name == "" {
+		return Profile{}, nil
+	}
+	p, exists := c.Profiles[name]
+	if !exists {
+		return Profile{}, fmt.Errorf("profile %q not found", name)
+	}
+	return p, nil
+}
+
+// clientFlags registers the flags shared by every command which talks to Healthchecks.
+// Values are resolved from flags, then environment variables, then the selected profile.
+type clientFlags struct {
+	config  *string
+	profile *string
+	apiKey  *string
+	pingKey *string
+	baseURL *string
+	pingURL *string
+	access  *string
+}
+
+// stringsFlag collects the values of a flag which may be repeated
+type stringsFlag []string
+
+func (s *stringsFlag) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *stringsFlag) Set(value string) error {
+	*s = append(*s, value)
+	return nil
+}
+
+// parseArgs parses fs's flags wherever they appear in args, so `get <id> --output json`
+// works like `get --output json <id>`, and returns the positional arguments. Everything
+// after "--" is positional.
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		rest := fs.Args()
+		if consumed := len(args) - len(rest); consumed > 0 && args[consumed-1] == "--" {
+			return append(positional, rest...), nil
+		}
+		if len(rest) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, rest[0])
+		args = rest[1:]
+	}
+}
+
+func addClientFlags(fs *flag.FlagSet) clientFlags {
+	return clientFlags{
+		config:  fs.String("config", defaultConfigPath(), "Path to the config file"),
+		profile: fs.String("profile", "", "Config profile to use (default $HEALTHCHECKS_PROFILE)"),
+		apiKey:  fs.String("api-key", "", "API key (default $HEALTHCHECKS_API_KEY)"),
+		pingKey: fs.String("ping-key", "", "Project ping key, required to ping by slug (default $HEALTHCHECKS_PING_KEY)"),
+		baseURL: fs.String("base-url", "", "Address of the v3 API (default $HEALTHCHECKS_BASE_URL)"),
+		pingURL: fs.String("ping-url", "", "Address of the ping endpoint (default $HEALTHCHECKS_PING_URL)"),
+		access:  fs.String("access", "", "Refuse calls beyond full, read-only or ping-only access (default $HEALTHCHECKS_ACCESS)"),
+	}
+}
+
+func (f clientFlags) resolve() (Profile, error) {
+	cfg, err := readConfig(*f.config)
+	if err != nil {
+		return Profile{}, err
+	}
+	p, err := cfg.profile(firstNonEmpty(*f.profile, os.Getenv("HEALTHCHECKS_PROFILE")))
+	if err != nil {
+		return Profile{}, err
+	}
+
+	return Profile{
+		APIKey:  firstNonEmpty(*f.apiKey, os.Getenv("HEALTHCHECKS_API_KEY"), p.APIKey),
+		PingKey: firstNonEmpty(*f.pingKey, os.Getenv("HEALTHCHECKS_PING_KEY"), p.PingKey),
+		BaseURL: firstNonEmpty(*f.baseURL, os.Getenv("HEALTHCHECKS_BASE_URL"), p.BaseURL),
+		PingURL: firstNonEmpty(*f.pingURL, os.Getenv("HEALTHCHECKS_PING_URL"), p.PingURL, "https://hc-ping.com"),
+		Access:  healthchecksio.Access(firstNonEmpty(*f.access, os.Getenv("HEALTHCHECKS_ACCESS"), string(p.Access))),
+
+		ProtectedTags: p.ProtectedTags,
+	}, nil
+}
+
+// client returns an API client, which requires an API key
+func (f clientFlags) client() (healthchecksio.Client, error) {
+	p, err := f.resolve()
+	if err != nil {
+		return nil, err
+	}
+	if p.APIKey == "" {
+		return nil, errors.New("missing API key, set --api-key, HEALTHCHECKS_API_KEY or a config profile")
+	}
+	return newClient(p), nil
+}
+
+func newClient(p Profile) healthchecksio.Client {
+	var opts []healthchecksio.ClientOption
+	if p.BaseURL != "" {
+		opts = append(opts, healthchecksio.WithBaseURL(p.BaseURL))
+	}
+	if p.Access != "" {
+		opts = append(opts, healthchecksio.WithAccess(p.Access))
+	}
+	if len(p.ProtectedTags) > 0 {
+		opts = append(opts, healthchecksio.WithDeleteProtection(healthchecksio.DeleteProtection{ProtectedTags: p.ProtectedTags}))
+	}
+	return healthchecksio.NewClient(p.APIKey, opts...)
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
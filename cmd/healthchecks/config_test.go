@@ -0,0 +1,108 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientFlags_Resolve(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yml")
+
+	err := os.WriteFile(path, []byte(`
+default: prod
+profiles:
+  prod:
+    api_key: prod-key
+    ping_key: prod-ping
+    protected_tags: [prod, db]
+  selfhosted:
+    api_key: hosted-key
+    base_url: https://hc.example.com/api/v3
+    ping_url: https://hc.example.com/ping
+  reporting:
+    api_key: reporting-key
+    access: read-only
+`), 0600)
+	require.NoError(t, err)
+
+	for _, key := range []string{"HEALTHCHECKS_PROFILE", "HEALTHCHECKS_API_KEY", "HEALTHCHECKS_PING_KEY", "HEALTHCHECKS_BASE_URL", "HEALTHCHECKS_PING_URL", "HEALTHCHECKS_ACCESS"} {
+		t.Setenv(key, "")
+	}
+
+	resolve := func(t *testing.T, args ...string) Profile {
+		t.Helper()
+
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		flags := addClientFlags(fs)
+		require.NoError(t, fs.Parse(append([]string{"--config", path}, args...)))
+
+		p, err := flags.resolve()
+		require.NoError(t, err)
+		return p
+	}
+
+	t.Run("default profile", func(t *testing.T) {
+		p := resolve(t)
+		require.Equal(t, "prod-key", p.APIKey)
+		require.Equal(t, "prod-ping", p.PingKey)
+		require.Equal(t, "https://hc-ping.com", p.PingURL)
+		require.Equal(t, []string{"prod", "db"}, p.ProtectedTags)
+	})
+
+	t.Run("named profile", func(t *testing.T) {
+		p := resolve(t, "--profile", "selfhosted")
+		require.Equal(t, "hosted-key", p.APIKey)
+		require.Equal(t, "https://hc.example.com/api/v3", p.BaseURL)
+		require.Equal(t, "https://hc.example.com/ping", p.PingURL)
+	})
+
+	t.Run("access", func(t *testing.T) {
+		require.Empty(t, resolve(t).Access)
+		require.Equal(t, healthchecksio.AccessReadOnly, resolve(t, "--profile", "reporting").Access)
+		require.Equal(t, healthchecksio.AccessPingOnly, resolve(t, "--access", "ping-only").Access)
+	})
+
+	t.Run("env and flags win", func(t *testing.T) {
+		t.Setenv("HEALTHCHECKS_API_KEY", "env-key")
+		require.Equal(t, "env-key", resolve(t).APIKey)
+		require.Equal(t, "flag-key", resolve(t, "--api-key", "flag-key").APIKey)
+	})
+
+	t.Run("missing profile", func(t *testing.T) {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		flags := addClientFlags(fs)
+		require.NoError(t, fs.Parse([]string{"--config", path, "--profile", "staging"}))
+
+		_, err := flags.resolve()
+		require.ErrorContains(t, err, `profile "staging" not found`)
+	})
+}
+
+func TestParseArgs(t *testing.T) {
+	cases := []struct {
+		args       []string
+		positional []string
+		output     string
+	}{
+		{[]string{"abc", "--output", "json"}, []string{"abc"}, "json"},
+		{[]string{"--output", "json", "abc"}, []string{"abc"}, "json"},
+		{[]string{"abc", "--output", "json", "def"}, []string{"abc", "def"}, "json"},
+		{[]string{"abc", "--", "--output", "json"}, []string{"abc", "--output", "json"}, ""},
+		{nil, nil, ""},
+	}
+	for _, tc := range cases {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		output := fs.String("output", "", "")
+		positional, err := parseArgs(fs, tc.args)
+		require.NoError(t, err, tc.args)
+		require.Equal(t, tc.positional, positional, tc.args)
+		require.Equal(t, tc.output, *output, tc.args)
+	}
+}
@@ -0,0 +1,237 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"gopkg.in/yaml.v3"
+)
+
+func importCrontabCommand(args []string) error {
+	fs := flag.NewFlagSet("import-crontab", flag.ContinueOnError)
+	var files stringsFlag
+	fs.Var(&files, "f", "Crontab file or directory (like /etc/cron.d) to import, repeatable (default stdin)")
+	system := fs.Bool("system", false, "Entries name a user before the command, the default for /etc/crontab and /etc/cron.d")
+	tz := fs.String("tz", "", "Timezone of entries which don't set CRON_TZ")
+	var tags stringsFlag
+	fs.Var(&tags, "tag", "Tag every imported check, repeatable")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	opts := healthchecksio.CrontabOptions{
+		Timezone: *tz,
+		Tags:     strings.Join(tags, " "),
+	}
+	var checks []healthchecksio.CreateCheck
+	if len(files) == 0 {
+		opts.Source, opts.System = "stdin", *system
+		parsed, err := healthchecksio.ParseCrontab(os.Stdin, opts)
+		if err != nil {
+			return err
+		}
+		checks = parsed
+	}
+	for _, path := range files {
+		paths, err := crontabPaths(path)
+		if err != nil {
+			return err
+		}
+		for _, path := range paths {
+			opts.Source = path
+			opts.System = *system || isSystemCrontab(path)
+			parsed, err := parseCrontabFile(path, opts)
+			if err != nil {
+				return err
+			}
+			checks = append(checks, parsed...)
+		}
+	}
+	return writeManifest(os.Stdout, &healthchecksio.Manifest{Checks: checks})
+}
+
+func importSystemdCommand(args []string) error {
+	fs := flag.NewFlagSet("import-systemd", flag.ContinueOnError)
+	var files stringsFlag
+	fs.Var(&files, "f", "Timer unit file or directory to import, repeatable, - reads systemctl cat output from stdin (default /etc/systemd/system)")
+	tz := fs.String("tz", "", "Timezone of OnCalendar expressions without one")
+	var tags stringsFlag
+	fs.Var(&tags, "tag", "Tag every imported check, repeatable")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		files = stringsFlag{"/etc/systemd/system"}
+	}
+
+	opts := healthchecksio.SystemdTimerOptions{
+		Timezone: *tz,
+		Tags:     strings.Join(tags, " "),
+	}
+	var units []timerUnit
+	for _, path := range files {
+		found, err := readTimerUnits(path)
+		if err != nil {
+			return err
+		}
+		units = append(units, found...)
+	}
+
+	var checks []healthchecksio.CreateCheck
+	for _, unit := range units {
+		check, err := healthchecksio.ParseSystemdTimer(unit.name, strings.NewReader(unit.body), opts)
+		if errors.Is(err, healthchecksio.ErrUnsupportedSchedule) {
+			fmt.Fprintf(os.Stderr, "WARN: skipping %v\n", err)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		checks = append(checks, *check)
+	}
+	return writeManifest(os.Stdout, &healthchecksio.Manifest{Checks: checks})
+}
+
+type timerUnit struct {
+	name string
+	body string
+}
+
+// readTimerUnits reads a .timer file, the .timer files in a directory or (for "-") `systemctl cat` output from stdin
+func readTimerUnits(path string) ([]timerUnit, error) {
+	if path == "-" {
+		return splitSystemctlCat(os.Stdin)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	paths := []string{path}
+	if info.IsDir() {
+		if paths, err = filepath.Glob(filepath.Join(path, "*.timer")); err != nil {
+			return nil, err
+		}
+	}
+
+	var out []timerUnit
+	for _, path := range paths {
+		bs, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, timerUnit{name: filepath.Base(path), body: string(bs)})
+	}
+	return out, nil
+}
+
+// splitSystemctlCat splits `systemctl cat` output, which precedes each file with a "# /path/to/unit" line.
+// Drop-ins (.conf files) are appended to the unit they belong to.
+func splitSystemctlCat(r io.Reader) ([]timerUnit, error) {
+	bs, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []timerUnit
+	current := -1 // index of the unit lines belong to, -1 while inside another kind of unit
+	for _, line := range strings.SplitAfter(string(bs), "\n") {
+		if path, isHeader := strings.CutPrefix(strings.TrimSpace(line), "# /"); isHeader {
+			switch {
+			case strings.HasSuffix(path, ".timer"):
+				out = append(out, timerUnit{name: filepath.Base(path)})
+				current = len(out) - 1
+			case strings.HasSuffix(path, ".timer.d/"+filepath.Base(path)) && strings.HasSuffix(path, ".conf"):
+				// drop-ins follow the unit they extend
+			default:
+				current = -1
+			}
+			continue
+		}
+		if current >= 0 {
+			out[current].body += line
+		}
+	}
+	return out, nil
+}
+
+// crontabPaths expands a directory into the crontabs inside it, skipping the editor and
+// package manager leftovers cron itself ignores
+func crontabPaths(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || strings.HasPrefix(name, ".") || strings.ContainsAny(name, "~") ||
+			strings.HasSuffix(name, ".dpkg-dist") || strings.HasSuffix(name, ".rpmsave") {
+			continue
+		}
+		out = append(out, filepath.Join(path, name))
+	}
+	return out, nil
+}
+
+func isSystemCrontab(path string) bool {
+	path = filepath.ToSlash(filepath.Clean(path))
+	return path == "/etc/crontab" || strings.HasPrefix(path, "/etc/cron.d/")
+}
+
+func parseCrontabFile(path string, opts healthchecksio.CrontabOptions) ([]healthchecksio.CreateCheck, error) {
+	fd, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+
+	return healthchecksio.ParseCrontab(fd, opts)
+}
+
+// writeManifest writes manifest as YAML readable by sync, checks are unique by slug
+func writeManifest(w io.Writer, manifest *healthchecksio.Manifest) error {
+	seen := make(map[string]bool)
+	for _, check := range manifest.Checks {
+		if seen[check.Slug] {
+			return fmt.Errorf("several imported checks have the slug %q, import them separately", check.Slug)
+		}
+		seen[check.Slug] = true
+	}
+	if len(manifest.Checks) == 0 {
+		return errors.New("no jobs found to import")
+	}
+
+	// Round trip through JSON so the manifest uses the API's field names
+	bs, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	var generic any
+	if err := json.Unmarshal(bs, &generic); err != nil {
+		return err
+	}
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(generic); err != nil {
+		return err
+	}
+	return enc.Close()
+}
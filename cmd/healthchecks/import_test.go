@@ -0,0 +1,87 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCrontabPaths(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"backup", "reports", ".placeholder", "backup~", "backup.dpkg-dist"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), nil, 0600))
+	}
+
+	paths, err := crontabPaths(dir)
+	require.NoError(t, err)
+	require.Equal(t, []string{filepath.Join(dir, "backup"), filepath.Join(dir, "reports")}, paths)
+
+	require.True(t, isSystemCrontab("/etc/cron.d/backup"))
+	require.True(t, isSystemCrontab("/etc/crontab"))
+	require.False(t, isSystemCrontab(dir))
+}
+
+func TestWriteManifest(t *testing.T) {
+	manifest := &healthchecksio.Manifest{
+		Checks: []healthchecksio.CreateCheck{{
+			Name:        "backup.sh",
+			Slug:        "backup",
+			Schedule:    "0 3 * * *",
+			Timezone:    "America/Chicago",
+			Description: "Imported from crontab line 1",
+		}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, writeManifest(&buf, manifest))
+
+	// The output is a manifest sync accepts
+	path := filepath.Join(t.TempDir(), "checks.yml")
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0600))
+	read, err := readManifest(path)
+	require.NoError(t, err)
+	require.Equal(t, manifest, read)
+
+	manifest.Checks = append(manifest.Checks, manifest.Checks[0])
+	require.ErrorContains(t, writeManifest(&buf, manifest), `several imported checks have the slug "backup"`)
+}
+
+func TestSplitSystemctlCat(t *testing.T) {
+	out := `# /etc/systemd/system/backup.timer
+[Timer]
+OnCalendar=daily
+
+# /etc/systemd/system/backup.timer.d/override.conf
+[Timer]
+OnCalendar=
+OnCalendar=*-*-* 03:00
+
+# /etc/systemd/system/backup.service
+[Service]
+ExecStart=/usr/local/bin/backup.sh
+
+# /usr/lib/systemd/system/logrotate.timer
+[Unit]
+Description=Daily rotation of log files
+
+[Timer]
+OnCalendar=daily
+`
+	units, err := splitSystemctlCat(strings.NewReader(out))
+	require.NoError(t, err)
+	require.Len(t, units, 2)
+	require.Equal(t, "backup.timer", units[0].name)
+	require.Equal(t, "logrotate.timer", units[1].name)
+	require.NotContains(t, units[0].body, "ExecStart")
+
+	// The drop-in overrides the unit's schedule
+	check, err := healthchecksio.ParseSystemdTimer(units[0].name, strings.NewReader(units[0].body), healthchecksio.SystemdTimerOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "0 3 * * *", check.Schedule)
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+)
+
+var lintColumns = []column[healthchecksio.LintFinding]{
+	{name: "name", value: func(f healthchecksio.LintFinding) string { return f.Name }},
+	{name: "rule", value: func(f healthchecksio.LintFinding) string { return f.Rule }},
+	{name: "message", value: func(f healthchecksio.LintFinding) string { return f.Message }},
+	{name: "uuid", wide: true, value: func(f healthchecksio.LintFinding) string { return f.UUID }},
+	{name: "slug", wide: true, value: func(f healthchecksio.LintFinding) string { return f.Slug }},
+}
+
+// lintRules are the rules which can be selected with --rule
+var lintRules = []healthchecksio.LintRule{
+	healthchecksio.LintMissingDescription,
+	healthchecksio.LintNoChannels,
+	healthchecksio.LintNoTags,
+	healthchecksio.LintGraceShorterThanTimeout,
+	healthchecksio.LintNeverPinged,
+	healthchecksio.LintMissingOwner,
+	healthchecksio.LintMissingRunbook,
+}
+
+func lintCommand(args []string) error {
+	fs := flag.NewFlagSet("lint", flag.ContinueOnError)
+	clientFlags := addClientFlags(fs)
+	outputFlags := addOutputFlags(fs)
+	var tags, ruleNames stringsFlag
+	fs.Var(&tags, "tag", "Only lint checks with this tag, repeat to require several")
+	fs.Var(&ruleNames, "rule", "Rule to apply instead of the defaults, repeat for several: "+lintRuleNames())
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	rules, err := selectLintRules(ruleNames)
+	if err != nil {
+		return err
+	}
+
+	client, err := clientFlags.client()
+	if err != nil {
+		return err
+	}
+	findings, err := healthchecksio.LintChecks(context.Background(), client, healthchecksio.GetChecks{Tags: tags}, rules...)
+	if err != nil {
+		return err
+	}
+	if findings == nil {
+		findings = []healthchecksio.LintFinding{} // json output is [] rather than null
+	}
+
+	findingID := func(f healthchecksio.LintFinding) string { return f.UUID }
+	if err := render(os.Stdout, outputFlags, findings, findings, findingID, lintColumns); err != nil {
+		return err
+	}
+	if len(findings) > 0 {
+		// Fail CI jobs running the audit
+		return exitCode(1)
+	}
+	return nil
+}
+
+func selectLintRules(names []string) ([]healthchecksio.LintRule, error) {
+	var rules []healthchecksio.LintRule
+	for _, name := range names {
+		idx := -1
+		for i := range lintRules {
+			if lintRules[i].Name == name {
+				idx = i
+			}
+		}
+		if idx < 0 {
+			return nil, fmt.Errorf("unknown lint rule %q, expected one of %s", name, lintRuleNames())
+		}
+		rules = append(rules, lintRules[idx])
+	}
+	return rules, nil
+}
+
+func lintRuleNames() string {
+	names := make([]string, len(lintRules))
+	for i := range lintRules {
+		names[i] = lintRules[i].Name
+	}
+	return strings.Join(names, ", ")
+}
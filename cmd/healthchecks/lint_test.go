@@ -0,0 +1,22 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectLintRules(t *testing.T) {
+	rules, err := selectLintRules(nil)
+	require.NoError(t, err)
+	require.Empty(t, rules)
+
+	rules, err = selectLintRules([]string{"missing-owner", "no-tags"})
+	require.NoError(t, err)
+	require.Len(t, rules, 2)
+	require.Equal(t, "missing-owner", rules[0].Name)
+	require.Equal(t, "no-tags", rules[1].Name)
+
+	_, err = selectLintRules([]string{"typo"})
+	require.ErrorContains(t, err, `unknown lint rule "typo", expected one of missing-description`)
+}
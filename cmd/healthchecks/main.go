@@ -0,0 +1,84 @@
+// Command healthchecks is a command line client for healthchecks.io
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+)
+
+type command struct {
+	usage string
+	run   func(args []string) error
+}
+
+var commands = map[string]command{
+	"archive":        {usage: "archive <uuid>  (pauses and tags the check archived:<date>, undo with restore)", run: archiveCommand(false)},
+	"delete":         {usage: "delete --tag <tag> [--yes] [--dry-run]", run: bulkCommand(healthchecksio.GroupDelete)},
+	"flips":          {usage: "flips <uuid|unique_key> [--seconds <n>] [--output table|wide|json|yaml] [--quiet]", run: flipsCommand},
+	"get":            {usage: "get <uuid|unique_key> [--output table|wide|json|yaml] [--quiet]", run: getCommand},
+	"import-crontab": {usage: "import-crontab [-f /etc/cron.d] [--system] [--tz <tz>] [--tag <tag>]  (prints a sync manifest)", run: importCrontabCommand},
+	"import-systemd": {usage: "import-systemd [-f /etc/systemd/system|-] [--tz <tz>] [--tag <tag>]  (prints a sync manifest)", run: importSystemdCommand},
+	"lint":           {usage: "lint [--tag <tag>] [--rule <name>] [--output table|wide|json|yaml] [--quiet]  (exits 1 when there are findings)", run: lintCommand},
+	"list":           {usage: "list [--tag <tag>] [--slug <slug>] [--output table|wide|json|yaml] [--quiet]", run: listCommand},
+	"nagios":         {usage: "nagios <slug|uuid> | nagios --passive --host <host> [--tag <tag>] [--command-file <path>]", run: nagiosCommand},
+	"pause":          {usage: "pause --tag <tag> [--yes] [--dry-run]", run: bulkCommand(healthchecksio.GroupPause)},
+	"ping":           {usage: "ping <slug|uuid> [--fail|--start|--log]  (reads the ping body from stdin)", run: pingCommand},
+	"pings":          {usage: "pings <uuid|unique_key> [--type fail] [--output table|wide|json|yaml] [--quiet]", run: pingsCommand},
+	"purge-archived": {usage: "purge-archived [--tag <tag>] [--older-than 30d]  (deletes checks archived longer ago, run from cron)", run: purgeArchivedCommand},
+	"restore":        {usage: "restore <uuid>  (undoes archive)", run: archiveCommand(true)},
+	"resume":         {usage: "resume --tag <tag> [--yes] [--dry-run]", run: bulkCommand(healthchecksio.GroupResume)},
+	"resume-expired": {usage: "resume-expired [--tag <tag>]  (resumes checks whose timed pause has passed, run from cron)", run: resumeExpiredCommand},
+	"run":            {usage: "run [--slug <template>] [--tag <tag>] [--timeout <duration>] [--max-log <bytes>] [--env <name>] [--redact <regex>] [--exit-info] [<slug|uuid>] -- <command> [args...]  (pings start and the outcome with a structured body, defaults from run.toml)", run: runCommand},
+	"sync":           {usage: "sync -f checks.yml [--tag <tag>] [--prune] [--require-owner] [--dry-run]", run: syncCommand},
+	"update":         {usage: "update --tag <tag> [--grace <duration>] [--timeout <duration>] [--add-tag <tag>] [--remove-tag <tag>] [--rate <n>] [--yes] [--dry-run]", run: updateCommand},
+	"uptime":         {usage: "uptime [--tag <tag>] [--since 30d] [--output table|wide|json|yaml] [--quiet]", run: uptimeCommand},
+	"validate":       {usage: "validate -f checks.yml | validate --schema", run: validateCommand},
+	"watch":          {usage: "watch [--tag <tag>] [--interval <duration>]", run: watchCommand},
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	cmd, exists := commands[os.Args[1]]
+	if !exists {
+		fmt.Fprintf(os.Stderr, "unknown command %q\n", os.Args[1])
+		usage()
+		os.Exit(2)
+	}
+
+	if err := cmd.run(os.Args[2:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			os.Exit(2)
+		}
+		var code exitCode
+		if errors.As(err, &code) {
+			os.Exit(int(code))
+		}
+		fmt.Fprintf(os.Stderr, "ERROR: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "Usage: healthchecks <command> [flags]")
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "Commands:")
+
+	var names []string
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %s\n", commands[name].usage)
+	}
+}
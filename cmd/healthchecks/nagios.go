@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksnagios"
+
+	"github.com/google/uuid"
+)
+
+// exitCode makes main exit with a specific status, without printing an error
+type exitCode int
+
+func (e exitCode) Error() string {
+	return fmt.Sprintf("exit status %d", int(e))
+}
+
+func nagiosCommand(args []string) error {
+	fs := flag.NewFlagSet("nagios", flag.ContinueOnError)
+	clientFlags := addClientFlags(fs)
+	passive := fs.Bool("passive", false, "Print external commands with every check's result instead of acting as a plugin")
+	host := fs.String("host", "", "Host the passive results are submitted for")
+	commandFile := fs.String("command-file", "", "Append passive results to this command file (e.g. /var/lib/nagios/rw/nagios.cmd) instead of stdout")
+	var tags stringsFlag
+	fs.Var(&tags, "tag", "Only submit checks with this tag, repeat to require several")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	if !*passive {
+		if fs.NArg() != 1 {
+			return errors.New("usage: healthchecks nagios <slug|uuid>")
+		}
+		// Plugins report every problem through their output and exit code
+		check, err := nagiosCheck(ctx, clientFlags, fs.Arg(0))
+		if err != nil {
+			fmt.Printf("UNKNOWN - %v\n", err)
+			return exitCode(healthchecksnagios.Unknown)
+		}
+		fmt.Println(healthchecksnagios.Output(*check, time.Now()))
+		if state := healthchecksnagios.StateOf(*check); state != healthchecksnagios.OK {
+			return exitCode(state)
+		}
+		return nil
+	}
+
+	if *host == "" {
+		return errors.New("--passive requires --host")
+	}
+	client, err := clientFlags.client()
+	if err != nil {
+		return err
+	}
+	list, err := client.GetChecks(ctx, healthchecksio.GetChecks{Tags: tags})
+	if err != nil {
+		return err
+	}
+
+	out := os.Stdout
+	if *commandFile != "" {
+		// The command file is a named pipe, it's opened for writing without creating it
+		out, err = os.OpenFile(*commandFile, os.O_WRONLY|os.O_APPEND, 0)
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+	}
+	now := time.Now()
+	return healthchecksnagios.WriteCommands(out, healthchecksnagios.Results(*host, list.Checks, now), now)
+}
+
+func nagiosCheck(ctx context.Context, flags clientFlags, identifier string) (*healthchecksio.Check, error) {
+	client, err := flags.client()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uuid.Parse(identifier); err == nil {
+		return client.GetCheck(ctx, identifier)
+	}
+	return healthchecksio.GetChecksBySlugExact(ctx, client, identifier)
+}
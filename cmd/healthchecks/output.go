@@ -0,0 +1,91 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+)
+
+// outputFlags registers the flags shared by every read command
+type outputFlags struct {
+	format *string
+	quiet  *bool
+}
+
+func addOutputFlags(fs *flag.FlagSet) outputFlags {
+	return outputFlags{
+		format: fs.String("output", "table", "Output format: table, wide, json or yaml"),
+		quiet:  fs.Bool("quiet", false, "Only print identifiers"),
+	}
+}
+
+// column is one column of table output
+type column[T any] struct {
+	name  string
+	wide  bool // only shown with --output wide
+	value func(T) string
+}
+
+// render writes items in the selected format. data is what json and yaml output encode,
+// and id returns what --quiet prints for each item.
+func render[T any](w io.Writer, o outputFlags, data any, items []T, id func(T) string, columns []column[T]) error {
+	if *o.quiet {
+		for _, item := range items {
+			fmt.Fprintln(w, id(item))
+		}
+		return nil
+	}
+
+	switch strings.ToLower(*o.format) {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(data)
+
+	case "yaml":
+		// Round trip through JSON so yaml output uses the API's field names
+		bs, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		var generic any
+		if err := json.Unmarshal(bs, &generic); err != nil {
+			return err
+		}
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		if err := enc.Encode(generic); err != nil {
+			return err
+		}
+		return enc.Close()
+
+	case "table", "wide":
+		wide := strings.EqualFold(*o.format, "wide")
+
+		tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		var names []string
+		for _, col := range columns {
+			if wide || !col.wide {
+				names = append(names, strings.ToUpper(col.name))
+			}
+		}
+		fmt.Fprintln(tw, strings.Join(names, "\t"))
+
+		for _, item := range items {
+			var values []string
+			for _, col := range columns {
+				if wide || !col.wide {
+					values = append(values, col.value(item))
+				}
+			}
+			fmt.Fprintln(tw, strings.Join(values, "\t"))
+		}
+		return tw.Flush()
+	}
+	return fmt.Errorf("unknown output format %q", *o.format)
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	checks := []healthchecksio.Check{
+		{UUID: "a", Name: "backup", Status: "up", Tags: "prod", Grace: 60},
+		{UUID: "b", Name: "reports", Status: "down"},
+	}
+	list := &healthchecksio.CheckListResponse{Checks: checks}
+
+	renderArgs := func(t *testing.T, args ...string) string {
+		t.Helper()
+
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		o := addOutputFlags(fs)
+		require.NoError(t, fs.Parse(args))
+
+		var buf bytes.Buffer
+		require.NoError(t, render(&buf, o, list, checks, checkID, checkColumns))
+		return buf.String()
+	}
+
+	t.Run("table", func(t *testing.T) {
+		out := renderArgs(t)
+		require.Contains(t, out, "NAME")
+		require.Contains(t, out, "backup")
+		require.NotContains(t, out, "UUID")
+	})
+
+	t.Run("wide", func(t *testing.T) {
+		out := renderArgs(t, "--output", "wide")
+		require.Contains(t, out, "UUID")
+		require.Contains(t, out, "GRACE")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		out := renderArgs(t, "--output", "json")
+		require.Contains(t, out, `"name": "backup"`)
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		out := renderArgs(t, "--output", "yaml")
+		require.Contains(t, out, "name: backup")
+		require.Contains(t, out, "grace: 60")
+	})
+
+	t.Run("quiet", func(t *testing.T) {
+		require.Equal(t, "a\nb\n", renderArgs(t, "--quiet"))
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		o := addOutputFlags(fs)
+		require.NoError(t, fs.Parse([]string{"--output", "xml"}))
+
+		err := render(&bytes.Buffer{}, o, list, checks, checkID, checkColumns)
+		require.ErrorContains(t, err, `unknown output format "xml"`)
+	})
+}
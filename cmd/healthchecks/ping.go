@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/google/uuid"
+)
+
+func pingCommand(args []string) error {
+	fs := flag.NewFlagSet("ping", flag.ContinueOnError)
+	clientFlags := addClientFlags(fs)
+	fail := fs.Bool("fail", false, "Send a failure ping")
+	start := fs.Bool("start", false, "Send a start ping")
+	log := fs.Bool("log", false, "Send a log ping")
+	maxBody := fs.Int("max-body", 100_000, "Maximum ping body size in bytes, the end of stdin is kept")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return errors.New("usage: healthchecks ping <slug|uuid> [--fail|--start|--log]")
+	}
+	if *maxBody < 0 {
+		return fmt.Errorf("--max-body must be at least 0, got %d", *maxBody)
+	}
+
+	var opts []healthchecksio.PingOption
+	switch {
+	case *fail && !*start && !*log:
+		opts = append(opts, healthchecksio.WithFail())
+	case *start && !*fail && !*log:
+		opts = append(opts, healthchecksio.WithStart())
+	case *log && !*fail && !*start:
+		opts = append(opts, healthchecksio.WithLog())
+	case *fail || *start || *log:
+		return errors.New("only one of --fail, --start or --log can be used")
+	}
+
+	profile, err := clientFlags.resolve()
+	if err != nil {
+		return err
+	}
+	address, err := pingAddress(profile.PingURL, profile.PingKey, positional[0])
+	if err != nil {
+		return err
+	}
+
+	var body string
+	if stdinIsPiped() {
+		tail := newTailBuffer(*maxBody)
+		if _, err := io.Copy(tail, os.Stdin); err != nil {
+			return fmt.Errorf("reading stdin: %w", err)
+		}
+		body = tail.String()
+	}
+
+	_, err = newClient(profile).Ping(context.Background(), address, body, opts...)
+	return err
+}
+
+// pingAddress builds the ping URL for a check UUID, or a slug within the project of pingKey
+func pingAddress(base, pingKey, identifier string) (string, error) {
+	u, err := url.Parse(base)
+	if err != nil {
+		return "", fmt.Errorf("parsing ping url: %w", err)
+	}
+	if _, err := uuid.Parse(identifier); err == nil {
+		return u.JoinPath(identifier).String(), nil
+	}
+	if pingKey == "" {
+		return "", errors.New("pinging by slug requires --ping-key or HEALTHCHECKS_PING_KEY")
+	}
+	return u.JoinPath(pingKey, identifier).String(), nil
+}
+
+func stdinIsPiped() bool {
+	info, err := os.Stdin.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice == 0
+}
+
+// tailBuffer is an io.Writer which keeps only the last max bytes written to it
+type tailBuffer struct {
+	max int
+	buf []byte
+}
+
+func newTailBuffer(max int) *tailBuffer {
+	return &tailBuffer{max: max}
+}
+
+func (t *tailBuffer) Write(p []byte) (int, error) {
+	n := len(p)
+	if len(p) >= t.max {
+		t.buf = append(t.buf[:0], p[len(p)-t.max:]...)
+		return n, nil
+	}
+	if overflow := len(t.buf) + len(p) - t.max; overflow > 0 {
+		t.buf = append(t.buf[:0], t.buf[overflow:]...)
+	}
+	t.buf = append(t.buf, p...)
+	return n, nil
+}
+
+func (t *tailBuffer) String() string {
+	return string(t.buf)
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPingAddress(t *testing.T) {
+	addr, err := pingAddress("https://hc-ping.com", "", "5bf66975-d4c7-4bf5-bcc8-b8d8a82ea278")
+	require.NoError(t, err)
+	require.Equal(t, "https://hc-ping.com/5bf66975-d4c7-4bf5-bcc8-b8d8a82ea278", addr)
+
+	addr, err = pingAddress("https://hc-ping.com", "pingkey", "nightly-backup")
+	require.NoError(t, err)
+	require.Equal(t, "https://hc-ping.com/pingkey/nightly-backup", addr)
+
+	_, err = pingAddress("https://hc-ping.com", "", "nightly-backup")
+	require.ErrorContains(t, err, "requires --ping-key")
+}
+
+func TestTailBuffer(t *testing.T) {
+	tail := newTailBuffer(10)
+
+	tail.Write([]byte("hello "))
+	require.Equal(t, "hello ", tail.String())
+
+	tail.Write([]byte("world"))
+	require.Equal(t, "ello world", tail.String())
+
+	tail.Write([]byte(strings.Repeat("x", 20) + "0123456789"))
+	require.Equal(t, "0123456789", tail.String())
+}
+
+func TestPingCommand(t *testing.T) {
+	paths := make(chan string, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths <- r.URL.Path
+	}))
+	defer srv.Close()
+
+	for _, key := range []string{"HEALTHCHECKS_PROFILE", "HEALTHCHECKS_PING_KEY", "HEALTHCHECKS_PING_URL"} {
+		t.Setenv(key, "")
+	}
+
+	// Flags may follow the check, as in the README
+	err := pingCommand([]string{"nightly-backup", "--config", "", "--ping-key", "pingkey", "--ping-url", srv.URL, "--fail"})
+	require.NoError(t, err)
+	require.Equal(t, "/pingkey/nightly-backup/fail", <-paths)
+
+	err = pingCommand([]string{"nightly-backup", "--max-body", "-1"})
+	require.ErrorContains(t, err, "--max-body must be at least 0")
+}
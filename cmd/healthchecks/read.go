@@ -0,0 +1,161 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+)
+
+var checkColumns = []column[healthchecksio.Check]{
+	{name: "name", value: func(c healthchecksio.Check) string { return c.Name }},
+	{name: "status", value: func(c healthchecksio.Check) string { return c.Status }},
+	{name: "last ping", value: func(c healthchecksio.Check) string { return lastPingAge(c, time.Now()) }},
+	{name: "tags", value: func(c healthchecksio.Check) string { return c.Tags }},
+	{name: "uuid", wide: true, value: func(c healthchecksio.Check) string { return c.UUID }},
+	{name: "slug", wide: true, value: func(c healthchecksio.Check) string { return c.Slug }},
+	{name: "timeout", wide: true, value: func(c healthchecksio.Check) string { return strconv.Itoa(c.Timeout) }},
+	{name: "grace", wide: true, value: func(c healthchecksio.Check) string { return strconv.Itoa(c.Grace) }},
+}
+
+func checkID(c healthchecksio.Check) string {
+	return c.UUID
+}
+
+func listCommand(args []string) error {
+	fs := flag.NewFlagSet("list", flag.ContinueOnError)
+	clientFlags := addClientFlags(fs)
+	outputFlags := addOutputFlags(fs)
+	var tags stringsFlag
+	fs.Var(&tags, "tag", "Only list checks with this tag, repeat to require several")
+	slug := fs.String("slug", "", "Only list checks with this slug")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	client, err := clientFlags.client()
+	if err != nil {
+		return err
+	}
+	list, err := client.GetChecks(context.Background(), healthchecksio.GetChecks{
+		Slug: *slug,
+		Tags: tags,
+	})
+	if err != nil {
+		return err
+	}
+	return render(os.Stdout, outputFlags, list, list.Checks, checkID, checkColumns)
+}
+
+func getCommand(args []string) error {
+	fs := flag.NewFlagSet("get", flag.ContinueOnError)
+	clientFlags := addClientFlags(fs)
+	outputFlags := addOutputFlags(fs)
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return errors.New("usage: healthchecks get <uuid|unique_key>")
+	}
+
+	client, err := clientFlags.client()
+	if err != nil {
+		return err
+	}
+	check, err := client.GetCheck(context.Background(), positional[0])
+	if err != nil {
+		return err
+	}
+	return render(os.Stdout, outputFlags, check, []healthchecksio.Check{*check}, checkID, checkColumns)
+}
+
+var pingColumns = []column[healthchecksio.Ping]{
+	{name: "n", value: func(p healthchecksio.Ping) string { return strconv.Itoa(p.N) }},
+	{name: "type", value: func(p healthchecksio.Ping) string { return p.Type }},
+	{name: "date", value: func(p healthchecksio.Ping) string { return p.Date.Format(time.RFC3339) }},
+	{name: "duration", value: func(p healthchecksio.Ping) string { return strconv.FormatFloat(p.Duration, 'f', -1, 64) }},
+	{name: "method", wide: true, value: func(p healthchecksio.Ping) string { return p.Method }},
+	{name: "remote addr", wide: true, value: func(p healthchecksio.Ping) string { return p.RemoteAddr }},
+	{name: "user agent", wide: true, value: func(p healthchecksio.Ping) string { return p.Ua }},
+}
+
+// pingTypeNames are the values --type accepts
+var pingTypeNames = []healthchecksio.PingType{
+	healthchecksio.PingSuccess, healthchecksio.PingStart, healthchecksio.PingFail, healthchecksio.PingLog, healthchecksio.PingIgnored,
+}
+
+func pingsCommand(args []string) error {
+	fs := flag.NewFlagSet("pings", flag.ContinueOnError)
+	clientFlags := addClientFlags(fs)
+	outputFlags := addOutputFlags(fs)
+	var types stringsFlag
+	fs.Var(&types, "type", "Only list pings of this type (success, start, fail, log, ign), repeatable")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return errors.New("usage: healthchecks pings <uuid|unique_key>")
+	}
+	var opts []healthchecksio.CallOption
+	if len(types) > 0 {
+		pingTypes := make([]healthchecksio.PingType, len(types))
+		for i, t := range types {
+			pingTypes[i] = healthchecksio.PingType(t)
+			if !slices.Contains(pingTypeNames, pingTypes[i]) {
+				return fmt.Errorf("invalid --type %q, expected one of success, start, fail, log or ign", t)
+			}
+		}
+		opts = append(opts, healthchecksio.WithTypes(pingTypes...))
+	}
+
+	client, err := clientFlags.client()
+	if err != nil {
+		return err
+	}
+	list, err := client.GetPings(context.Background(), positional[0], opts...)
+	if err != nil {
+		return err
+	}
+	pingID := func(p healthchecksio.Ping) string { return strconv.Itoa(p.N) }
+	return render(os.Stdout, outputFlags, list, list.Pings, pingID, pingColumns)
+}
+
+var flipColumns = []column[healthchecksio.Flip]{
+	{name: "timestamp", value: func(f healthchecksio.Flip) string { return f.Timestamp }},
+	{name: "up", value: func(f healthchecksio.Flip) string { return strconv.Itoa(f.Up) }},
+}
+
+func flipsCommand(args []string) error {
+	fs := flag.NewFlagSet("flips", flag.ContinueOnError)
+	clientFlags := addClientFlags(fs)
+	outputFlags := addOutputFlags(fs)
+	seconds := fs.Int("seconds", 0, "Only return flips from the last N seconds")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return errors.New("usage: healthchecks flips <uuid|unique_key>")
+	}
+
+	client, err := clientFlags.client()
+	if err != nil {
+		return err
+	}
+	list, err := client.GetFlips(context.Background(), positional[0], healthchecksio.GetFlipsRequest{
+		Seconds: *seconds,
+	})
+	if err != nil {
+		return err
+	}
+	flipID := func(f healthchecksio.Flip) string { return f.Timestamp }
+	return render(os.Stdout, outputFlags, list, list.Flips, flipID, flipColumns)
+}
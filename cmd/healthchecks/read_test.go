@@ -0,0 +1,78 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// captureStdout returns what fn writes to os.Stdout
+func captureStdout(t *testing.T, fn func() error) (string, error) {
+	t.Helper()
+
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	out := make(chan string)
+	go func() {
+		bs, _ := io.ReadAll(r)
+		out <- string(bs)
+	}()
+	err = fn()
+	w.Close()
+	return <-out, err
+}
+
+func TestReadCommands_FlagsAfterID(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/checks/abc":
+			w.Write([]byte(`{"uuid":"abc","name":"backup"}`))
+		case "/checks/abc/pings/":
+			w.Write([]byte(`{"pings":[{"type":"fail","n":2},{"type":"success","n":1}]}`))
+		case "/checks/abc/flips/":
+			require.Equal(t, "60", r.URL.Query().Get("seconds"))
+			w.Write([]byte(`{"flips":[{"timestamp":"2026-10-14T10:00:00+00:00","up":0}]}`))
+		}
+	}))
+	defer srv.Close()
+
+	for _, key := range []string{"HEALTHCHECKS_PROFILE", "HEALTHCHECKS_ACCESS", "HEALTHCHECKS_BASE_URL"} {
+		t.Setenv(key, "")
+	}
+	client := []string{"--config", "", "--api-key", "key", "--base-url", srv.URL}
+
+	out, err := captureStdout(t, func() error {
+		return getCommand(append([]string{"abc", "--output", "json"}, client...))
+	})
+	require.NoError(t, err)
+	require.Contains(t, out, `"name": "backup"`)
+
+	out, err = captureStdout(t, func() error {
+		return pingsCommand(append([]string{"abc", "--quiet"}, client...))
+	})
+	require.NoError(t, err)
+	require.Equal(t, "2\n1\n", out)
+
+	out, err = captureStdout(t, func() error {
+		return pingsCommand(append([]string{"abc", "--type", "fail", "--quiet"}, client...))
+	})
+	require.NoError(t, err)
+	require.Equal(t, "2\n", out)
+
+	err = pingsCommand(append([]string{"abc", "--type", "failure"}, client...))
+	require.EqualError(t, err, `invalid --type "failure", expected one of success, start, fail, log or ign`)
+
+	out, err = captureStdout(t, func() error {
+		return flipsCommand(append([]string{"abc", "--seconds", "60", "--quiet"}, client...))
+	})
+	require.NoError(t, err)
+	require.Equal(t, "2026-10-14T10:00:00+00:00\n", out)
+}
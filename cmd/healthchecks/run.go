@@ -0,0 +1,329 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/google/uuid"
+)
+
+func runCommand(args []string) error {
+	fs := flag.NewFlagSet("run", flag.ContinueOnError)
+	clientFlags := addClientFlags(fs)
+	runFlags := addRunFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	identifier, command := splitRunArgs(args, fs.Args())
+	if len(command) == 0 {
+		return errors.New("usage: healthchecks run [--slug <template>] [--tag <tag>] [--timeout <duration>] [--max-log <bytes>] [--env <name>] [--redact <regex>] [--exit-info] [<slug|uuid>] -- <command> [args...]")
+	}
+
+	host, _ := os.Hostname()
+	data := runTemplate{
+		Command: filepath.Base(command[0]),
+		Args:    command[1:],
+		Host:    strings.ToLower(strings.Split(host, ".")[0]),
+		Slug:    healthchecksio.CommandSlug(strings.Join(command, " ")),
+	}
+	settings, err := runFlags.resolve(fs, firstNonEmpty(identifier, data.Slug))
+	if err != nil {
+		return err
+	}
+	if identifier == "" {
+		if identifier, err = data.render("slug", settings.Slug); err != nil {
+			return fmt.Errorf("slug template: %w", err)
+		}
+		if identifier == "" {
+			return errors.New("slug template rendered an empty slug")
+		}
+	}
+	var tags []string
+	for _, text := range settings.Tags {
+		tag, err := data.render("tag", text)
+		if err != nil {
+			return fmt.Errorf("tag template %q: %w", text, err)
+		}
+		tags = append(tags, strings.Fields(tag)...)
+	}
+
+	var timeout time.Duration
+	if settings.Timeout != "" {
+		if timeout, err = time.ParseDuration(settings.Timeout); err != nil {
+			return fmt.Errorf("invalid timeout %q", settings.Timeout)
+		}
+	}
+	for _, pattern := range settings.Env {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("env %q: %w", pattern, err)
+		}
+	}
+	var redactions []*regexp.Regexp
+	for _, expr := range settings.Redact {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return fmt.Errorf("redact: %w", err)
+		}
+		redactions = append(redactions, re)
+	}
+
+	profile, err := clientFlags.resolve()
+	if err != nil {
+		return err
+	}
+	client := newClient(profile)
+
+	var address string
+	if len(tags) > 0 {
+		// Tagging is best effort, the job still runs and is pinged without it
+		check, err := tagCheck(context.Background(), client, profile, identifier, tags)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "WARNING: tagging %s: %v\n", identifier, err)
+		} else {
+			address = check.PingURL
+		}
+	}
+	if address == "" {
+		if address, err = pingAddress(profile.PingURL, profile.PingKey, identifier); err != nil {
+			return err
+		}
+	}
+
+	job := &job{
+		client:  client,
+		address: address,
+		stdout:  os.Stdout,
+		stderr:  os.Stderr,
+		maxLog:  settings.MaxLog,
+		timeout: timeout,
+
+		env:      settings.Env,
+		redact:   redactions,
+		exitInfo: settings.ExitInfo != nil && *settings.ExitInfo,
+	}
+	code, err := job.run(context.Background(), command)
+	if err != nil {
+		return err
+	}
+	if code != 0 {
+		return exitCode(code)
+	}
+	return nil
+}
+
+// splitRunArgs splits the arguments left after parsing flags into the check and command.
+// The check is left out when flags are followed by "--" (run --tag cron -- ./backup.sh).
+func splitRunArgs(args, rest []string) (string, []string) {
+	if len(rest) < len(args) && args[len(args)-len(rest)-1] == "--" {
+		return "", rest
+	}
+	if len(rest) > 1 && rest[1] == "--" {
+		rest = slices.Delete(slices.Clone(rest), 1, 2)
+	}
+	if len(rest) < 2 {
+		return "", nil
+	}
+	return rest[0], rest[1:]
+}
+
+// tagCheck adds tags to the check, creating a check with the slug when it's missing
+func tagCheck(ctx context.Context, client healthchecksio.ManagementClient, profile Profile, identifier string, tags []string) (*healthchecksio.Check, error) {
+	if profile.APIKey == "" {
+		return nil, errors.New("tags need an API key, set --api-key, HEALTHCHECKS_API_KEY or a config profile")
+	}
+	if _, err := uuid.Parse(identifier); err == nil {
+		return healthchecksio.AddTags(ctx, client, identifier, tags...)
+	}
+
+	// Creating with unique would replace the tags an existing check already has
+	check, err := healthchecksio.GetChecksBySlugExact(ctx, client, identifier)
+	if errors.Is(err, healthchecksio.ErrNotFound) {
+		return client.CreateCheck(ctx, &healthchecksio.CreateCheck{
+			Name:   identifier,
+			Slug:   identifier,
+			Tags:   strings.Join(tags, " "),
+			Unique: []string{"slug"},
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	current := strings.Fields(check.Tags)
+	if !slices.ContainsFunc(tags, func(tag string) bool { return !slices.Contains(current, tag) }) {
+		return check, nil
+	}
+	return healthchecksio.AddTags(ctx, client, check.UUID, tags...)
+}
+
+// job runs a command between a start and completion ping, sending a healthchecksio.PingEnvelope
+// with the command's status, duration, host, error and output tail
+type job struct {
+	client  healthchecksio.Pinger
+	address string
+
+	stdout, stderr io.Writer
+	maxLog         int
+
+	// timeout kills the command once it has run this long, zero doesn't limit it
+	timeout time.Duration
+
+	// env are the names (or path.Match patterns) of environment variables sent with the completion ping
+	env []string
+
+	// redact replaces secrets in the output, error and environment sent with the completion ping
+	redact []*regexp.Regexp
+
+	// exitInfo sends how the command's process exited
+	exitInfo bool
+}
+
+// redacted replaces secrets in ping bodies
+const redacted = "[redacted]"
+
+// secretName matches environment variables whose values are always redacted
+var secretName = regexp.MustCompile(`(?i)pass|secret|token|key|auth|credential|cookie|session|private`)
+
+// run returns the command's exit code, errors are only returned when it can't be started
+// or the completion ping fails
+func (j *job) run(ctx context.Context, command []string) (int, error) {
+	host, _ := os.Hostname()
+
+	start := healthchecksio.PingEnvelope{Status: "start", Host: host}
+	if _, err := j.client.Ping(ctx, j.address, start.String(), healthchecksio.WithStart()); err != nil {
+		// The job still runs when the start ping fails
+		fmt.Fprintf(j.stderr, "WARNING: %v\n", err)
+	}
+
+	tail := &lockedWriter{w: newTailBuffer(j.maxLog)}
+	runCtx := ctx
+	if j.timeout > 0 {
+		var cancel context.CancelFunc
+		runCtx, cancel = context.WithTimeout(ctx, j.timeout)
+		defer cancel()
+	}
+
+	cmd := exec.CommandContext(runCtx, command[0], command[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = io.MultiWriter(j.stdout, tail)
+	cmd.Stderr = io.MultiWriter(j.stderr, tail)
+	// Children of a killed command can hold its output open, stop waiting for them
+	cmd.WaitDelay = time.Second
+
+	started := time.Now()
+	runErr := cmd.Run()
+	timedOut := j.timeout > 0 && errors.Is(runCtx.Err(), context.DeadlineExceeded)
+
+	result := healthchecksio.PingEnvelope{
+		Status:   "success",
+		Duration: time.Since(started).Seconds(),
+		Host:     host,
+		Log:      j.redactText(tail.String()),
+		Env:      j.environment(),
+	}
+	if j.exitInfo && cmd.ProcessState != nil {
+		result.Exit = exitOf(cmd.ProcessState)
+	}
+	code := 0
+	var opts []healthchecksio.PingOption
+	if runErr != nil {
+		result.Status = "fail"
+		result.Error = j.redactText(runErr.Error())
+		opts = append(opts, healthchecksio.WithFail())
+
+		code = 1
+		var exitErr *exec.ExitError
+		if errors.As(runErr, &exitErr) && exitErr.ExitCode() > 0 {
+			code = exitErr.ExitCode()
+		}
+		if timedOut {
+			// The exit code timeout(1) uses
+			result.Error = fmt.Sprintf("timed out after %s", j.timeout)
+			code = 124
+		}
+	}
+
+	if _, err := j.client.Ping(context.WithoutCancel(ctx), j.address, result.String(), opts...); err != nil {
+		return code, errors.Join(runErr, err)
+	}
+	var exitErr *exec.ExitError
+	if runErr != nil && !errors.As(runErr, &exitErr) {
+		return code, runErr
+	}
+	return code, nil
+}
+
+// environment returns the selected environment variables, redacting values of secret
+// looking names and text matching the redact expressions
+func (j *job) environment() map[string]string {
+	if len(j.env) == 0 {
+		return nil
+	}
+	out := make(map[string]string)
+	for _, kv := range os.Environ() {
+		name, value, _ := strings.Cut(kv, "=")
+		if !slices.ContainsFunc(j.env, func(pattern string) bool {
+			matched, _ := path.Match(pattern, name)
+			return matched
+		}) {
+			continue
+		}
+		if secretName.MatchString(name) {
+			out[name] = redacted
+		} else {
+			out[name] = j.redactText(value)
+		}
+	}
+	return out
+}
+
+func (j *job) redactText(s string) string {
+	for _, re := range j.redact {
+		s = re.ReplaceAllLiteralString(s, redacted)
+	}
+	return s
+}
+
+// exitOf describes how a process exited
+func exitOf(state *os.ProcessState) *healthchecksio.PingExit {
+	exit := &healthchecksio.PingExit{
+		Code:       state.ExitCode(),
+		UserTime:   state.UserTime().Seconds(),
+		SystemTime: state.SystemTime().Seconds(),
+	}
+	if !state.Exited() {
+		exit.Signal, _ = strings.CutPrefix(state.String(), "signal: ")
+	}
+	return exit
+}
+
+// lockedWriter serializes writes from a command's stdout and stderr
+type lockedWriter struct {
+	mu sync.Mutex
+	w  *tailBuffer
+}
+
+func (l *lockedWriter) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.w.Write(p)
+}
+
+func (l *lockedWriter) String() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.w.String()
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobRun(t *testing.T) {
+	var mu sync.Mutex
+	var paths, bodies []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bs, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		bodies = append(bodies, string(bs))
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	var stdout bytes.Buffer
+	j := &job{
+		client:  healthchecksio.NewClient(""),
+		address: srv.URL + "/job",
+		stdout:  &stdout,
+		stderr:  io.Discard,
+		maxLog:  100,
+	}
+
+	// stdout and stderr are read concurrently, sleep so their order in the log is stable
+	code, err := j.run(context.Background(), []string{"sh", "-c", "echo backing up; sleep 0.1; echo disk full >&2; exit 3"})
+	require.NoError(t, err)
+	require.Equal(t, 3, code)
+	require.Equal(t, "backing up\n", stdout.String())
+
+	require.Equal(t, []string{"/job/start", "/job/fail"}, paths)
+	envelope, ok := healthchecksio.ParsePingEnvelope(bodies[1])
+	require.True(t, ok)
+	require.Equal(t, "fail", envelope.Status)
+	require.Equal(t, "exit status 3", envelope.Error)
+	require.Equal(t, "backing up\ndisk full\n", envelope.Log)
+	require.NotEmpty(t, envelope.Host)
+
+	paths, bodies = nil, nil
+	code, err = j.run(context.Background(), []string{"true"})
+	require.NoError(t, err)
+	require.Zero(t, code)
+	require.Equal(t, []string{"/job/start", "/job"}, paths)
+
+	envelope, ok = healthchecksio.ParsePingEnvelope(bodies[1])
+	require.True(t, ok)
+	require.Equal(t, "success", envelope.Status)
+	require.Empty(t, envelope.Error)
+
+	// Commands which can't start are reported as failures
+	paths = nil
+	code, err = j.run(context.Background(), []string{"/does/not/exist"})
+	require.Error(t, err)
+	require.Equal(t, 1, code)
+	require.Equal(t, []string{"/job/start", "/job/fail"}, paths)
+}
+
+func TestJobRun_Environment(t *testing.T) {
+	var body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bs, _ := io.ReadAll(r.Body)
+		body = string(bs)
+	}))
+	defer srv.Close()
+
+	t.Setenv("DEPLOY_ENV", "staging")
+	t.Setenv("DEPLOY_URL", "postgres://app:hunter2@db/app")
+	t.Setenv("DEPLOY_TOKEN", "ghp_abc123")
+	t.Setenv("UNSELECTED", "value")
+
+	j := &job{
+		client:   healthchecksio.NewClient(""),
+		address:  srv.URL + "/job",
+		stdout:   io.Discard,
+		stderr:   io.Discard,
+		maxLog:   100,
+		env:      []string{"DEPLOY_*", "MISSING"},
+		redact:   []*regexp.Regexp{regexp.MustCompile(`://[^@/]+@`), regexp.MustCompile(`ghp_\w+`)},
+		exitInfo: true,
+	}
+	code, err := j.run(context.Background(), []string{"sh", "-c", "echo using ghp_def456; exit 2"})
+	require.NoError(t, err)
+	require.Equal(t, 2, code)
+
+	envelope, ok := healthchecksio.ParsePingEnvelope(body)
+	require.True(t, ok)
+	require.Equal(t, map[string]string{
+		"DEPLOY_ENV":   "staging",
+		"DEPLOY_URL":   "postgres[redacted]db/app",
+		"DEPLOY_TOKEN": "[redacted]",
+	}, envelope.Env)
+	require.Equal(t, "using [redacted]\n", envelope.Log)
+	require.Equal(t, 2, envelope.Exit.Code)
+	require.Empty(t, envelope.Exit.Signal)
+
+	// Killed processes report the signal
+	code, err = j.run(context.Background(), []string{"sh", "-c", "kill -9 $$"})
+	require.NoError(t, err)
+	require.Equal(t, 1, code)
+
+	envelope, ok = healthchecksio.ParsePingEnvelope(body)
+	require.True(t, ok)
+	require.Equal(t, -1, envelope.Exit.Code)
+	require.Equal(t, "killed", envelope.Exit.Signal)
+
+	// Nothing extra is sent unless asked for
+	j.env, j.exitInfo = nil, false
+	_, err = j.run(context.Background(), []string{"true"})
+	require.NoError(t, err)
+	require.NotContains(t, body, "env")
+	require.NotContains(t, body, "exit")
+}
+
+func TestJobRun_Timeout(t *testing.T) {
+	var body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bs, _ := io.ReadAll(r.Body)
+		body = string(bs)
+	}))
+	defer srv.Close()
+
+	j := &job{
+		client:  healthchecksio.NewClient(""),
+		address: srv.URL + "/job",
+		stdout:  io.Discard,
+		stderr:  io.Discard,
+		maxLog:  100,
+		timeout: 50 * time.Millisecond,
+	}
+	started := time.Now()
+	code, err := j.run(context.Background(), []string{"sleep", "5"})
+	require.NoError(t, err)
+	require.Equal(t, 124, code)
+	require.Less(t, time.Since(started), 2*time.Second)
+
+	envelope, ok := healthchecksio.ParsePingEnvelope(body)
+	require.True(t, ok)
+	require.Equal(t, "fail", envelope.Status)
+	require.Equal(t, "timed out after 50ms", envelope.Error)
+}
+
+func TestRunCommand_Tags(t *testing.T) {
+	var mu sync.Mutex
+	var requests []string
+	var existing string
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bs, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		check := `{"uuid":"abc","slug":"nightly-true","tags":"` + existing + `","ping_url":"` + srv.URL + `/ping/abc"}`
+		switch r.URL.Path {
+		case "/api/v3/checks/":
+			switch {
+			case r.Method == "GET" && existing == "":
+				w.Write([]byte(`{"checks":[]}`))
+			case r.Method == "GET":
+				require.Equal(t, "nightly-true", r.URL.Query().Get("slug"))
+				w.Write([]byte(`{"checks":[` + check + `]}`))
+			default:
+				require.JSONEq(t, `{"name":"nightly-true","slug":"nightly-true","tags":"cron db","unique":["slug"]}`, string(bs))
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(check))
+			}
+		case "/api/v3/checks/abc":
+			if r.Method == "POST" {
+				require.JSONEq(t, `{"tags":"owned cron db"}`, string(bs))
+			}
+			w.Write([]byte(check))
+		}
+	}))
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "run.toml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+slug = "nightly-{{.Slug}}"
+tags = ["cron"]
+
+[jobs.true]
+tags = ["db"]
+`), 0600))
+	for _, key := range []string{"HEALTHCHECKS_PROFILE", "HEALTHCHECKS_PING_KEY", "HEALTHCHECKS_ACCESS", "HEALTHCHECKS_RUN_SLUG", "HEALTHCHECKS_RUN_TAGS", "HEALTHCHECKS_RUN_TIMEOUT"} {
+		t.Setenv(key, "")
+	}
+	args := []string{
+		"--config", "", "--run-config", path,
+		"--api-key", "key", "--base-url", srv.URL + "/api/v3",
+		"--", "true",
+	}
+
+	// A missing check is created, no ping key is needed as the check's ping URL is used
+	require.NoError(t, runCommand(args))
+	require.Equal(t, []string{"GET /api/v3/checks/", "POST /api/v3/checks/", "POST /ping/abc/start", "POST /ping/abc"}, requests)
+
+	// An existing check keeps its tags
+	existing, requests = "owned", nil
+	require.NoError(t, runCommand(args))
+	require.Equal(t, []string{"GET /api/v3/checks/", "GET /api/v3/checks/abc", "GET /api/v3/checks/abc", "POST /api/v3/checks/abc", "POST /ping/abc/start", "POST /ping/abc"}, requests)
+}
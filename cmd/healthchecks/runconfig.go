@@ -0,0 +1,212 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/BurntSushi/toml"
+)
+
+// RunConfig is read from run.toml next to config.yml and configures `healthchecks run`,
+// so a crontab's lines only need the command. Jobs are keyed by the slug import-crontab
+// gives their command (or the check given on the command line) and override the defaults.
+//
+//	slug = "{{.Host}}-{{.Slug}}"
+//	tags = ["cron", "{{.Host}}"]
+//	timeout = "2h"
+//	env = ["DEPLOY_*"]
+//	redact = ['ghp_\w+']
+//
+//	[jobs.backup]
+//	timeout = "6h"
+//	tags = ["db"]
+//	exit_info = true
+type RunConfig struct {
+	RunSettings
+	Jobs map[string]RunSettings `toml:"jobs"`
+}
+
+// RunSettings configure how a job is run and reported
+type RunSettings struct {
+	// Slug is a text/template of the check pinged when none is given, see runTemplate
+	Slug string `toml:"slug"`
+
+	// Tags are templates of tags added to the check, which is created when it's missing.
+	// A job's tags are added to the default tags.
+	Tags []string `toml:"tags"`
+
+	// Timeout kills the command once it has run this long, e.g. "30m"
+	Timeout string `toml:"timeout"`
+
+	MaxLog   int      `toml:"max_log"`
+	Env      []string `toml:"env"`
+	Redact   []string `toml:"redact"`
+	ExitInfo *bool    `toml:"exit_info"`
+}
+
+func defaultRunConfigPath() string {
+	if path := os.Getenv("HEALTHCHECKS_RUN_CONFIG"); path != "" {
+		return path
+	}
+	if path := defaultConfigPath(); path != "" {
+		return filepath.Join(filepath.Dir(path), "run.toml")
+	}
+	return ""
+}
+
+func readRunConfig(path string) (*RunConfig, error) {
+	var cfg RunConfig
+	if path == "" {
+		return &cfg, nil
+	}
+
+	bs, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return &cfg, nil
+		}
+		return nil, fmt.Errorf("reading run config: %w", err)
+	}
+	md, err := toml.Decode(string(bs), &cfg)
+	if err != nil {
+		return nil, fmt.Errorf("parsing run config %s: %w", path, err)
+	}
+	if undecoded := md.Undecoded(); len(undecoded) > 0 {
+		return nil, fmt.Errorf("parsing run config %s: unknown key %s", path, undecoded[0])
+	}
+	return &cfg, nil
+}
+
+// job returns the defaults overridden by the named job's section. Its tags, env and redact
+// lists are added to the defaults, so a job can't lose the host's redactions.
+func (c *RunConfig) job(name string) RunSettings {
+	out := c.RunSettings
+	job, exists := c.Jobs[name]
+	if !exists {
+		return out
+	}
+	if job.Slug != "" {
+		out.Slug = job.Slug
+	}
+	out.Tags = append(slices.Clip(out.Tags), job.Tags...)
+	if job.Timeout != "" {
+		out.Timeout = job.Timeout
+	}
+	if job.MaxLog != 0 {
+		out.MaxLog = job.MaxLog
+	}
+	out.Env = append(slices.Clip(out.Env), job.Env...)
+	out.Redact = append(slices.Clip(out.Redact), job.Redact...)
+	if job.ExitInfo != nil {
+		out.ExitInfo = job.ExitInfo
+	}
+	return out
+}
+
+// runFlags are the flags of `healthchecks run` which override RunSettings
+type runFlags struct {
+	config   *string
+	slug     *string
+	tags     *stringsFlag
+	timeout  *string
+	maxLog   *int
+	env      *stringsFlag
+	redact   *stringsFlag
+	exitInfo *bool
+}
+
+func addRunFlags(fs *flag.FlagSet) runFlags {
+	f := runFlags{
+		config:   fs.String("run-config", defaultRunConfigPath(), "Path to the job config file (default $HEALTHCHECKS_RUN_CONFIG or run.toml next to --config)"),
+		slug:     fs.String("slug", "", "Template of the check's slug when none is given (default $HEALTHCHECKS_RUN_SLUG or {{.Slug}})"),
+		tags:     &stringsFlag{},
+		timeout:  fs.String("timeout", "", "Kill the command after this long (default $HEALTHCHECKS_RUN_TIMEOUT)"),
+		maxLog:   fs.Int("max-log", 10_000, "Maximum bytes of output kept for the ping, the end is kept"),
+		env:      &stringsFlag{},
+		redact:   &stringsFlag{},
+		exitInfo: fs.Bool("exit-info", false, "Include the exit code, signal and CPU time in the ping"),
+	}
+	fs.Var(f.tags, "tag", "Tag the check, creating it when it's missing, repeatable (default $HEALTHCHECKS_RUN_TAGS)")
+	fs.Var(f.env, "env", "Include this environment variable in the ping, * matches any characters, repeatable")
+	fs.Var(f.redact, "redact", "Replace text matching this regex in the ping with [redacted], repeatable")
+	return f
+}
+
+// resolve layers the settings of job: flags, then environment variables, then the job's
+// section of the config file and the file's defaults
+func (f runFlags) resolve(fs *flag.FlagSet, job string) (RunSettings, error) {
+	cfg, err := readRunConfig(*f.config)
+	if err != nil {
+		return RunSettings{}, err
+	}
+	s := cfg.job(job)
+
+	if v := os.Getenv("HEALTHCHECKS_RUN_SLUG"); v != "" {
+		s.Slug = v
+	}
+	if v := os.Getenv("HEALTHCHECKS_RUN_TAGS"); v != "" {
+		s.Tags = strings.Fields(v)
+	}
+	if v := os.Getenv("HEALTHCHECKS_RUN_TIMEOUT"); v != "" {
+		s.Timeout = v
+	}
+
+	fs.Visit(func(fl *flag.Flag) {
+		switch fl.Name {
+		case "slug":
+			s.Slug = *f.slug
+		case "tag":
+			s.Tags = *f.tags
+		case "timeout":
+			s.Timeout = *f.timeout
+		case "max-log":
+			s.MaxLog = *f.maxLog
+		case "exit-info":
+			s.ExitInfo = f.exitInfo
+		}
+	})
+	s.Env = append(s.Env, *f.env...)
+	s.Redact = append(s.Redact, *f.redact...)
+
+	if s.Slug == "" {
+		s.Slug = "{{.Slug}}"
+	}
+	if s.MaxLog <= 0 {
+		s.MaxLog = *f.maxLog
+	}
+	return s, nil
+}
+
+// runTemplate is the data slug and tag templates are rendered with
+type runTemplate struct {
+	// Command is the name of the program, e.g. backup.sh
+	Command string
+
+	// Args are the program's arguments
+	Args []string
+
+	// Host is the machine's hostname up to the first dot
+	Host string
+
+	// Slug is the slug import-crontab gives the command, e.g. backup
+	Slug string
+}
+
+func (d runTemplate) render(name, text string) (string, error) {
+	tmpl, err := template.New(name).Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, d); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunFlags_Resolve(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.toml")
+	err := os.WriteFile(path, []byte(`
+slug = "{{.Host}}-{{.Slug}}"
+tags = ["cron"]
+timeout = "2h"
+redact = ['ghp_\w+']
+
+[jobs.backup]
+timeout = "6h"
+tags = ["db"]
+redact = ['://[^@/]+@']
+exit_info = true
+`), 0600)
+	require.NoError(t, err)
+
+	for _, key := range []string{"HEALTHCHECKS_RUN_SLUG", "HEALTHCHECKS_RUN_TAGS", "HEALTHCHECKS_RUN_TIMEOUT"} {
+		t.Setenv(key, "")
+	}
+
+	resolve := func(t *testing.T, job string, args ...string) RunSettings {
+		t.Helper()
+
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		flags := addRunFlags(fs)
+		require.NoError(t, fs.Parse(append([]string{"--run-config", path}, args...)))
+
+		s, err := flags.resolve(fs, job)
+		require.NoError(t, err)
+		return s
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		s := resolve(t, "report")
+		require.Equal(t, "{{.Host}}-{{.Slug}}", s.Slug)
+		require.Equal(t, []string{"cron"}, s.Tags)
+		require.Equal(t, "2h", s.Timeout)
+		require.Equal(t, 10_000, s.MaxLog)
+		require.Nil(t, s.ExitInfo)
+	})
+
+	t.Run("job", func(t *testing.T) {
+		s := resolve(t, "backup")
+		require.Equal(t, []string{"cron", "db"}, s.Tags)
+		require.Equal(t, "6h", s.Timeout)
+		require.Equal(t, []string{`ghp_\w+`, `://[^@/]+@`}, s.Redact)
+		require.True(t, *s.ExitInfo)
+	})
+
+	t.Run("env overrides the file", func(t *testing.T) {
+		t.Setenv("HEALTHCHECKS_RUN_TIMEOUT", "3h")
+		t.Setenv("HEALTHCHECKS_RUN_TAGS", "nightly host-1")
+
+		s := resolve(t, "backup")
+		require.Equal(t, "3h", s.Timeout)
+		require.Equal(t, []string{"nightly", "host-1"}, s.Tags)
+	})
+
+	t.Run("flags override env", func(t *testing.T) {
+		t.Setenv("HEALTHCHECKS_RUN_TIMEOUT", "3h")
+		t.Setenv("HEALTHCHECKS_RUN_SLUG", "env-{{.Slug}}")
+
+		s := resolve(t, "backup", "--timeout", "10m", "--slug", "{{.Slug}}", "--tag", "a", "--tag", "b", "--exit-info=false", "--redact", "secret")
+		require.Equal(t, "10m", s.Timeout)
+		require.Equal(t, "{{.Slug}}", s.Slug)
+		require.Equal(t, []string{"a", "b"}, s.Tags)
+		require.False(t, *s.ExitInfo)
+
+		// Redactions are never dropped
+		require.Equal(t, []string{`ghp_\w+`, `://[^@/]+@`, "secret"}, s.Redact)
+	})
+
+	t.Run("no config", func(t *testing.T) {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		flags := addRunFlags(fs)
+		require.NoError(t, fs.Parse([]string{"--run-config", filepath.Join(t.TempDir(), "missing.toml")}))
+
+		s, err := flags.resolve(fs, "backup")
+		require.NoError(t, err)
+		require.Equal(t, "{{.Slug}}", s.Slug)
+		require.Empty(t, s.Tags)
+	})
+}
+
+func TestReadRunConfig_UnknownKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.toml")
+	require.NoError(t, os.WriteFile(path, []byte("[jobs.backup]\ntimout = \"1h\"\n"), 0600))
+
+	_, err := readRunConfig(path)
+	require.ErrorContains(t, err, "unknown key jobs.backup.timout")
+}
+
+func TestSplitRunArgs(t *testing.T) {
+	cases := []struct {
+		args       []string
+		rest       []string
+		identifier string
+		command    []string
+	}{
+		{[]string{"backup", "--", "./backup.sh", "-v"}, []string{"backup", "--", "./backup.sh", "-v"}, "backup", []string{"./backup.sh", "-v"}},
+		{[]string{"backup", "./backup.sh"}, []string{"backup", "./backup.sh"}, "backup", []string{"./backup.sh"}},
+		{[]string{"--tag", "cron", "--", "./backup.sh"}, []string{"./backup.sh"}, "", []string{"./backup.sh"}},
+		{[]string{"--", "./backup.sh", "--", "x"}, []string{"./backup.sh", "--", "x"}, "", []string{"./backup.sh", "--", "x"}},
+		{[]string{"backup"}, []string{"backup"}, "", nil},
+	}
+	for _, tc := range cases {
+		identifier, command := splitRunArgs(tc.args, tc.rest)
+		require.Equal(t, tc.identifier, identifier, tc.args)
+		require.Equal(t, tc.command, command, tc.args)
+	}
+}
+
+func TestRunTemplate(t *testing.T) {
+	data := runTemplate{Command: "backup.sh", Args: []string{"--full"}, Host: "web-1", Slug: "backup"}
+
+	slug, err := data.render("slug", "{{.Host}}-{{.Slug}}")
+	require.NoError(t, err)
+	require.Equal(t, "web-1-backup", slug)
+
+	_, err = data.render("slug", "{{.Missing}}")
+	require.Error(t, err)
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"gopkg.in/yaml.v3"
+)
+
+func syncCommand(args []string) error {
+	fs := flag.NewFlagSet("sync", flag.ContinueOnError)
+	clientFlags := addClientFlags(fs)
+	file := fs.String("f", "", "Path to the manifest file, - for stdin")
+	var tags stringsFlag
+	fs.Var(&tags, "tag", "Only manage existing checks with this tag, repeat to require several")
+	env := fs.String("env", "", "Prefix slugs and names with <env>- and tag checks env:<env>")
+	prune := fs.Bool("prune", false, "Delete managed checks which are not in the manifest")
+	dryRun := fs.Bool("dry-run", false, "Print the plan without changing anything")
+	allowConflicts := fs.Bool("allow-conflicts", false, "Create checks even when an unmanaged check has the same slug or name")
+	requireOwner := fs.Bool("require-owner", false, "Fail when a check in the manifest has no owner:<owner> tag")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *file == "" {
+		return errors.New("usage: healthchecks sync -f checks.yml [--env prod] [--prune] [--dry-run]")
+	}
+
+	manifest, err := readManifest(*file)
+	if err != nil {
+		return err
+	}
+	client, err := clientFlags.client()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	plan, err := healthchecksio.PlanSync(ctx, client, manifest.Checks, healthchecksio.SyncOptions{
+		Filter:    healthchecksio.GetChecks{Tags: tags},
+		Prune:     *prune,
+		Namespace: healthchecksio.EnvironmentNamespace(*env),
+
+		DetectConflicts: !*allowConflicts,
+		RequireOwner:    *requireOwner,
+		Descriptions:    manifest.Descriptions,
+		ConfirmDeletes:  true,
+	})
+	if err != nil {
+		return err
+	}
+	printPlan(os.Stdout, plan)
+
+	if *dryRun || len(plan.Pending()) == 0 {
+		return nil
+	}
+	return healthchecksio.ApplySync(ctx, client, plan)
+}
+
+// readManifest reads and validates a YAML (or JSON) manifest which uses the API's
+// field names from path, or stdin when path is "-"
+func readManifest(path string) (*healthchecksio.Manifest, error) {
+	var bs []byte
+	var err error
+	if path == "-" {
+		bs, err = io.ReadAll(os.Stdin)
+	} else {
+		bs, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading manifest: %w", err)
+	}
+
+	var generic any
+	if err := yaml.Unmarshal(bs, &generic); err != nil {
+		return nil, fmt.Errorf("parsing manifest %s: %w", path, err)
+	}
+	bs, err = json.Marshal(generic)
+	if err != nil {
+		return nil, fmt.Errorf("parsing manifest %s: %w", path, err)
+	}
+	if err := healthchecksio.ValidateManifest(bs); err != nil {
+		return nil, fmt.Errorf("parsing manifest %s: %w", path, err)
+	}
+
+	var manifest healthchecksio.Manifest
+	dec := json.NewDecoder(bytes.NewReader(bs))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("parsing manifest %s: %w", path, err)
+	}
+	return &manifest, nil
+}
+
+func validateCommand(args []string) error {
+	fs := flag.NewFlagSet("validate", flag.ContinueOnError)
+	file := fs.String("f", "", "Path to the manifest file, - for stdin")
+	schema := fs.Bool("schema", false, "Print the manifest's JSON Schema")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *schema {
+		_, err := os.Stdout.Write(healthchecksio.ManifestSchema)
+		return err
+	}
+	if *file == "" {
+		return errors.New("usage: healthchecks validate -f checks.yml | healthchecks validate --schema")
+	}
+
+	manifest, err := readManifest(*file)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s: %d checks OK\n", *file, len(manifest.Checks))
+	return nil
+}
+
+const colorYellow = "\033[33m"
+
+func printPlan(w io.Writer, plan *healthchecksio.SyncPlan) {
+	pending := plan.Pending()
+	if len(pending) == 0 {
+		fmt.Fprintln(w, "No changes, checks match the manifest")
+		return
+	}
+
+	var creates, updates, deletes int
+	for _, change := range pending {
+		switch change.Action {
+		case healthchecksio.SyncCreate:
+			creates++
+			fmt.Fprintf(w, "%s+ create %s%s\n", colorGreen, change.Slug, colorReset)
+		case healthchecksio.SyncUpdate:
+			updates++
+			fmt.Fprintf(w, "%s~ update %s (%s)%s\n", colorYellow, change.Slug, strings.Join(change.Fields, ", "), colorReset)
+		case healthchecksio.SyncDelete:
+			deletes++
+			fmt.Fprintf(w, "%s- delete %s%s\n", colorRed, change.Slug, colorReset)
+		}
+	}
+	fmt.Fprintf(w, "\nPlan: %d to create, %d to update, %d to delete\n", creates, updates, deletes)
+}
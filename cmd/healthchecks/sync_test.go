@@ -0,0 +1,93 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadManifest(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checks.yml")
+	err := os.WriteFile(path, []byte(`
+checks:
+  - name: Nightly backup
+    slug: nightly-backup
+    schedule: "0 3 * * *"
+    tz: America/Chicago
+    grace: 3600
+    desc: Runs pg_dump
+`), 0600)
+	require.NoError(t, err)
+
+	manifest, err := readManifest(path)
+	require.NoError(t, err)
+	require.Len(t, manifest.Checks, 1)
+
+	check := manifest.Checks[0]
+	require.Equal(t, "nightly-backup", check.Slug)
+	require.Equal(t, "0 3 * * *", check.Schedule)
+	require.Equal(t, "America/Chicago", check.Timezone)
+	require.Equal(t, 3600, check.Grace)
+	require.Equal(t, "Runs pg_dump", check.Description)
+
+	// Descriptions are read along with the checks
+	err = os.WriteFile(path, []byte(`
+checks:
+  - slug: nightly-backup
+descriptions:
+  vars:
+    repo: github.com/acme/jobs
+  checks:
+    nightly-backup:
+      owner: payments
+`), 0600)
+	require.NoError(t, err)
+
+	manifest, err = readManifest(path)
+	require.NoError(t, err)
+	require.Equal(t, &healthchecksio.ManifestDescriptions{
+		Vars:   map[string]string{"repo": "github.com/acme/jobs"},
+		Checks: map[string]map[string]string{"nightly-backup": {"owner": "payments"}},
+	}, manifest.Descriptions)
+
+	// Typos are rejected
+	err = os.WriteFile(path, []byte("checks:\n  - nmae: typo\n"), 0600)
+	require.NoError(t, err)
+
+	_, err = readManifest(path)
+	require.ErrorContains(t, err, `unknown field "nmae"`)
+
+	err = os.WriteFile(path, []byte("checks:\n  - slug: a\n    timeout: 10\n"), 0600)
+	require.NoError(t, err)
+
+	_, err = readManifest(path)
+	require.ErrorContains(t, err, "checks[0].timeout: must be at least 60")
+}
+
+func TestPrintPlan(t *testing.T) {
+	var buf bytes.Buffer
+	printPlan(&buf, &healthchecksio.SyncPlan{
+		Changes: []healthchecksio.SyncChange{
+			{Action: healthchecksio.SyncCreate, Slug: "a"},
+			{Action: healthchecksio.SyncUpdate, Slug: "b", Fields: []string{"grace", "tags"}},
+			{Action: healthchecksio.SyncUnchanged, Slug: "same"},
+			{Action: healthchecksio.SyncDelete, Slug: "d"},
+		},
+	})
+
+	out := buf.String()
+	require.Contains(t, out, "+ create a")
+	require.Contains(t, out, "~ update b (grace, tags)")
+	require.NotContains(t, out, "same")
+	require.Contains(t, out, "- delete d")
+	require.Contains(t, out, "Plan: 1 to create, 1 to update, 1 to delete")
+
+	buf.Reset()
+	printPlan(&buf, &healthchecksio.SyncPlan{})
+	require.Contains(t, buf.String(), "No changes")
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+)
+
+var uptimeColumns = []column[healthchecksio.UptimeReport]{
+	{name: "name", value: func(r healthchecksio.UptimeReport) string { return r.Check.Name }},
+	{name: "uptime", value: func(r healthchecksio.UptimeReport) string { return formatPercent(r.Uptime) }},
+	{name: "downtime", value: func(r healthchecksio.UptimeReport) string { return r.Downtime.Truncate(time.Second).String() }},
+	{name: "outages", value: func(r healthchecksio.UptimeReport) string { return strconv.Itoa(len(r.Outages)) }},
+	{name: "status", value: func(r healthchecksio.UptimeReport) string { return r.Check.Status }},
+	{name: "uuid", wide: true, value: func(r healthchecksio.UptimeReport) string { return r.Check.UUID }},
+	{name: "slug", wide: true, value: func(r healthchecksio.UptimeReport) string { return r.Check.Slug }},
+	{name: "longest outage", wide: true, value: func(r healthchecksio.UptimeReport) string { return longestOutage(r).String() }},
+}
+
+// uptimeReport is the json and yaml output of the uptime command
+type uptimeReport struct {
+	Start  time.Time     `json:"start"`
+	End    time.Time     `json:"end"`
+	Checks []checkUptime `json:"checks"`
+}
+
+type checkUptime struct {
+	UUID            string   `json:"uuid"`
+	Name            string   `json:"name"`
+	Slug            string   `json:"slug,omitempty"`
+	Status          string   `json:"status"`
+	UptimePercent   float64  `json:"uptime_percent"`
+	DowntimeSeconds float64  `json:"downtime_seconds"`
+	Outages         []outage `json:"outages"`
+}
+
+type outage struct {
+	Start time.Time `json:"start"`
+
+	// End is empty while the check is still down
+	End *time.Time `json:"end,omitempty"`
+}
+
+func uptimeCommand(args []string) error {
+	fs := flag.NewFlagSet("uptime", flag.ContinueOnError)
+	clientFlags := addClientFlags(fs)
+	outputFlags := addOutputFlags(fs)
+	var tags stringsFlag
+	fs.Var(&tags, "tag", "Only report checks with this tag, repeat to require several")
+	since := fs.String("since", "30d", "How far back to report, e.g. 30d or 12h")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	period, err := parseSince(*since)
+	if err != nil {
+		return err
+	}
+
+	client, err := clientFlags.client()
+	if err != nil {
+		return err
+	}
+	end := time.Now().UTC().Truncate(time.Second)
+	start := end.Add(-period)
+	reports, err := healthchecksio.ReportUptime(context.Background(), client, healthchecksio.GetChecks{Tags: tags}, start, end)
+	if err != nil {
+		return err
+	}
+
+	reportID := func(r healthchecksio.UptimeReport) string { return r.Check.UUID }
+	return render(os.Stdout, outputFlags, newUptimeReport(start, end, reports), reports, reportID, uptimeColumns)
+}
+
+func newUptimeReport(start, end time.Time, reports []healthchecksio.UptimeReport) uptimeReport {
+	out := uptimeReport{Start: start, End: end, Checks: make([]checkUptime, 0, len(reports))}
+	for _, r := range reports {
+		check := checkUptime{
+			UUID:            r.Check.UUID,
+			Name:            r.Check.Name,
+			Slug:            r.Check.Slug,
+			Status:          r.Check.Status,
+			UptimePercent:   r.Uptime * 100,
+			DowntimeSeconds: r.Downtime.Seconds(),
+			Outages:         make([]outage, 0, len(r.Outages)),
+		}
+		for _, o := range r.Outages {
+			converted := outage{Start: o.Start}
+			if !o.End.IsZero() {
+				converted.End = &o.End
+			}
+			check.Outages = append(check.Outages, converted)
+		}
+		out.Checks = append(out.Checks, check)
+	}
+	return out
+}
+
+// parseSince parses a duration, also accepting whole days like "30d"
+func parseSince(value string) (time.Duration, error) {
+	var period time.Duration
+	if days, found := strings.CutSuffix(value, "d"); found {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("invalid --since %q", value)
+		}
+		period = time.Duration(n) * 24 * time.Hour
+	} else {
+		var err error
+		if period, err = time.ParseDuration(value); err != nil {
+			return 0, fmt.Errorf("invalid --since %q", value)
+		}
+	}
+	if period <= 0 {
+		return 0, fmt.Errorf("--since must be positive, got %q", value)
+	}
+	return period, nil
+}
+
+func formatPercent(fraction float64) string {
+	return strconv.FormatFloat(fraction*100, 'f', 3, 64) + "%"
+}
+
+func longestOutage(r healthchecksio.UptimeReport) time.Duration {
+	var longest time.Duration
+	for _, o := range r.Outages {
+		end := o.End
+		if end.IsZero() {
+			end = r.End
+		}
+		longest = max(longest, end.Sub(o.Start))
+	}
+	return longest
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSince(t *testing.T) {
+	period, err := parseSince("30d")
+	require.NoError(t, err)
+	require.Equal(t, 30*24*time.Hour, period)
+
+	period, err = parseSince("90m")
+	require.NoError(t, err)
+	require.Equal(t, 90*time.Minute, period)
+
+	for _, bad := range []string{"", "d", "-1d", "0h", "soon"} {
+		_, err = parseSince(bad)
+		require.Error(t, err, bad)
+	}
+}
+
+func TestNewUptimeReport(t *testing.T) {
+	start := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(10 * time.Hour)
+	report := newUptimeReport(start, end, []healthchecksio.UptimeReport{{
+		Check:    healthchecksio.Check{UUID: "1", Name: "backup", Status: "down"},
+		Start:    start,
+		End:      end,
+		Uptime:   0.75,
+		Downtime: 150 * time.Minute,
+		Outages: []healthchecksio.Outage{
+			{Start: start.Add(time.Hour), End: start.Add(2 * time.Hour)},
+			{Start: start.Add(9 * time.Hour)},
+		},
+	}})
+
+	check := report.Checks[0]
+	require.Equal(t, 75.0, check.UptimePercent)
+	require.Equal(t, 9000.0, check.DowntimeSeconds)
+	require.Equal(t, start.Add(2*time.Hour), *check.Outages[0].End)
+	require.Nil(t, check.Outages[1].End)
+
+	require.Equal(t, "75.000%", formatPercent(0.75))
+}
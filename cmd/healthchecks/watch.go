@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"text/tabwriter"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+)
+
+func watchCommand(args []string) error {
+	fs := flag.NewFlagSet("watch", flag.ContinueOnError)
+	clientFlags := addClientFlags(fs)
+	var tags stringsFlag
+	fs.Var(&tags, "tag", "Only show checks with this tag, repeat to require several")
+	interval := fs.Duration("interval", 30*time.Second, "How often to refresh")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	client, err := clientFlags.client()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+
+	previous := make(map[string]string)
+	for {
+		list, err := client.GetChecks(ctx, healthchecksio.GetChecks{Tags: tags})
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			fmt.Fprintf(os.Stderr, "ERROR: %v\n", err)
+		} else {
+			fmt.Fprint(os.Stdout, "\033[H\033[2J")
+			renderWatchTable(os.Stdout, list.Checks, previous, time.Now())
+
+			previous = make(map[string]string, len(list.Checks))
+			for _, check := range list.Checks {
+				previous[check.UUID] = check.Status
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+const (
+	colorReset = "\033[0m"
+	colorRed   = "\033[31m"
+	colorGreen = "\033[32m"
+	colorBold  = "\033[1m"
+)
+
+// renderWatchTable writes the status table, highlighting checks whose status
+// differs from the previous render
+func renderWatchTable(w io.Writer, checks []healthchecksio.Check, previous map[string]string, now time.Time) {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "NAME\tSTATUS\tLAST PING\t\n")
+
+	for _, check := range checks {
+		status := check.Status
+		switch healthchecksio.CheckStatus(status) {
+		case healthchecksio.StatusDown:
+			status = colorRed + status + colorReset
+		case healthchecksio.StatusUp:
+			status = colorGreen + status + colorReset
+		}
+		if prev, seen := previous[check.UUID]; seen && prev != check.Status {
+			status = colorBold + "* " + status + colorReset
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t\n", check.Name, status, lastPingAge(check, now))
+	}
+	tw.Flush()
+
+	fmt.Fprintf(w, "\nUpdated %s\n", now.Format(time.Kitchen))
+}
+
+func lastPingAge(check healthchecksio.Check, now time.Time) string {
+	when, ok := check.LastPingTime()
+	if !ok {
+		return "never"
+	}
+	return now.Sub(when).Truncate(time.Second).String() + " ago"
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderWatchTable(t *testing.T) {
+	now := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+	checks := []healthchecksio.Check{
+		{UUID: "a", Name: "backup", Status: "up", LastPing: "2025-03-01T11:58:30+00:00"},
+		{UUID: "b", Name: "reports", Status: "down", LastPing: nil},
+	}
+	previous := map[string]string{
+		"a": "up",
+		"b": "up",
+	}
+
+	var buf bytes.Buffer
+	renderWatchTable(&buf, checks, previous, now)
+
+	out := buf.String()
+	require.Contains(t, out, "backup")
+	require.Contains(t, out, "1m30s ago")
+	require.Contains(t, out, "never")
+	require.Contains(t, out, "* "+colorRed+"down")
+	require.NotContains(t, out, "* "+colorGreen+"up")
+}
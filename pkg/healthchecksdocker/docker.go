@@ -0,0 +1,233 @@
+// Package healthchecksdocker provisions checks declared as labels on Docker containers, e.g.
+//
+//	labels:
+//	  healthchecks.slug: nightly-backup
+//	  healthchecks.schedule: "0 3 * * *"
+//	  healthchecks.tz: America/Chicago
+//
+// It talks to the Docker Engine API with the standard library.
+package healthchecksdocker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+)
+
+// Label prefixes every label read by this package
+const Label = "healthchecks."
+
+// Container is the part of a container needed to provision its check
+type Container struct {
+	ID     string
+	Names  []string
+	Labels map[string]string
+}
+
+// Lister lists containers which have label set, stopped ones included
+type Lister interface {
+	ListContainers(ctx context.Context, label string) ([]Container, error)
+}
+
+// Engine lists containers through the Docker Engine API
+type Engine struct {
+	client  *http.Client
+	baseURL string
+}
+
+// NewEngine connects to host, e.g. "unix:///var/run/docker.sock" or "tcp://127.0.0.1:2375".
+// An empty host uses $DOCKER_HOST and then the default socket.
+func NewEngine(host string) (*Engine, error) {
+	if host == "" {
+		host = os.Getenv("DOCKER_HOST")
+	}
+	if host == "" {
+		host = "unix:///var/run/docker.sock"
+	}
+	u, err := url.Parse(host)
+	if err != nil {
+		return nil, fmt.Errorf("docker host: %w", err)
+	}
+
+	switch u.Scheme {
+	case "unix":
+		socket := u.Path
+		transport := &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", socket)
+			},
+		}
+		return &Engine{client: &http.Client{Transport: transport}, baseURL: "http://docker"}, nil
+	case "tcp", "http":
+		return &Engine{client: &http.Client{}, baseURL: "http://" + u.Host}, nil
+	}
+	return nil, fmt.Errorf("docker host: unsupported scheme %q", u.Scheme)
+}
+
+// ListContainers lists containers which have label set, stopped ones included so a container
+// which exited (e.g. a cron-style job between runs) keeps its check
+func (e *Engine) ListContainers(ctx context.Context, label string) ([]Container, error) {
+	filters, err := json.Marshal(map[string][]string{"label": {label}})
+	if err != nil {
+		return nil, err
+	}
+	address := e.baseURL + "/containers/json?all=true&filters=" + url.QueryEscape(string(filters))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", address, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("listing containers: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Message string `json:"message"`
+		}
+		json.NewDecoder(resp.Body).Decode(&apiErr)
+		return nil, fmt.Errorf("listing containers failed with %d: %s", resp.StatusCode, apiErr.Message)
+	}
+
+	var out []Container
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("listing containers: %w", err)
+	}
+	return out, nil
+}
+
+// Options configures Provision
+type Options struct {
+	// Tags are set on every check and identify the managed set, e.g. "docker host:web-1"
+	Tags string
+
+	// Prune deletes managed checks whose containers were removed, stopped containers keep theirs
+	Prune bool
+
+	// DryRun plans changes without applying them
+	DryRun bool
+}
+
+// Check returns the check declared by a container's labels, ok is false without a healthchecks.slug label.
+// Supported labels are slug, name, desc, tags, schedule, tz, timeout and grace (in seconds).
+func Check(c Container, opts Options) (check healthchecksio.CreateCheck, ok bool, err error) {
+	labels := c.Labels
+	if labels[Label+"slug"] == "" {
+		return check, false, nil
+	}
+
+	check = healthchecksio.CreateCheck{
+		Slug:        labels[Label+"slug"],
+		Name:        labels[Label+"name"],
+		Description: labels[Label+"desc"],
+		Schedule:    labels[Label+"schedule"],
+		Timezone:    labels[Label+"tz"],
+		Tags:        strings.Join(strings.Fields(opts.Tags+" "+labels[Label+"tags"]), " "),
+	}
+	if check.Name == "" {
+		check.Name = defaultName(c)
+	}
+	if check.Timeout, err = intLabel(labels, "timeout"); err != nil {
+		return check, false, err
+	}
+	if check.Grace, err = intLabel(labels, "grace"); err != nil {
+		return check, false, err
+	}
+	return check, true, nil
+}
+
+// defaultName names checks after their Compose service, or the container
+func defaultName(c Container) string {
+	if project, service := c.Labels["com.docker.compose.project"], c.Labels["com.docker.compose.service"]; service != "" {
+		if project != "" {
+			return project + "/" + service
+		}
+		return service
+	}
+	if len(c.Names) > 0 {
+		return strings.TrimPrefix(c.Names[0], "/")
+	}
+	return c.ID
+}
+
+func intLabel(labels map[string]string, name string) (int, error) {
+	value := labels[Label+name]
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("label %s%s: %w", Label, name, err)
+	}
+	return n, nil
+}
+
+// Checks returns the checks declared by containers. Replicas (e.g. Swarm tasks or scaled Compose
+// services) declaring the same slug are merged, but they must declare the same check.
+func Checks(containers []Container, opts Options) ([]healthchecksio.CreateCheck, error) {
+	var out []healthchecksio.CreateCheck
+	bySlug := make(map[string]int)
+	for _, c := range containers {
+		check, ok, err := Check(c, opts)
+		if err != nil {
+			return nil, fmt.Errorf("container %s: %w", defaultName(c), err)
+		}
+		if !ok {
+			continue
+		}
+		if idx, exists := bySlug[check.Slug]; exists {
+			if !sameReplica(out[idx], check) {
+				return nil, fmt.Errorf("container %s: slug %q is declared differently by another container", defaultName(c), check.Slug)
+			}
+			continue
+		}
+		bySlug[check.Slug] = len(out)
+		out = append(out, check)
+	}
+	return out, nil
+}
+
+// sameReplica reports whether a and b declare the same check, replicas are named after different containers
+func sameReplica(a, b healthchecksio.CreateCheck) bool {
+	a.Name, b.Name = "", ""
+	return reflect.DeepEqual(a, b)
+}
+
+// Provision creates, updates (and with opts.Prune deletes) checks so they match the labels of containers
+func Provision(ctx context.Context, lister Lister, client healthchecksio.ManagementClient, opts Options) (*healthchecksio.SyncPlan, error) {
+	if opts.Tags == "" {
+		return nil, errors.New("provision containers: Tags is required to identify managed checks")
+	}
+
+	containers, err := lister.ListContainers(ctx, Label+"slug")
+	if err != nil {
+		return nil, fmt.Errorf("provision containers: %w", err)
+	}
+	checks, err := Checks(containers, opts)
+	if err != nil {
+		return nil, fmt.Errorf("provision containers: %w", err)
+	}
+
+	plan, err := healthchecksio.Sync(ctx, client, checks, healthchecksio.SyncOptions{
+		Filter: healthchecksio.GetChecks{Tags: strings.Fields(opts.Tags)},
+		Prune:  opts.Prune,
+		DryRun: opts.DryRun,
+	})
+	if err != nil {
+		return plan, fmt.Errorf("provision containers: %w", err)
+	}
+	return plan, nil
+}
@@ -0,0 +1,100 @@
+package healthchecksdocker
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEngine_ListContainers(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "docker.sock")
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+
+	var all, filters string
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/containers/json", r.URL.Path)
+		all, filters = r.URL.Query().Get("all"), r.URL.Query().Get("filters")
+		json.NewEncoder(w).Encode([]map[string]any{{
+			"Id":     "abc123",
+			"Names":  []string{"/backup-1"},
+			"Image":  "backup:latest",
+			"Labels": map[string]string{"healthchecks.slug": "nightly-backup"},
+		}})
+	}))
+	srv.Listener = listener
+	srv.Start()
+	defer srv.Close()
+
+	engine, err := NewEngine("unix://" + socket)
+	require.NoError(t, err)
+
+	containers, err := engine.ListContainers(context.Background(), "healthchecks.slug")
+	require.NoError(t, err)
+	require.Equal(t, "true", all) // exited containers keep their checks when pruning
+	require.Equal(t, `{"label":["healthchecks.slug"]}`, filters)
+	require.Equal(t, []Container{{
+		ID:     "abc123",
+		Names:  []string{"/backup-1"},
+		Labels: map[string]string{"healthchecks.slug": "nightly-backup"},
+	}}, containers)
+
+	_, err = NewEngine("ssh://docker@host")
+	require.Error(t, err)
+}
+
+func TestChecks(t *testing.T) {
+	containers := []Container{
+		{
+			ID:    "1",
+			Names: []string{"/shop-backup-1"},
+			Labels: map[string]string{
+				"healthchecks.slug":          "shop-backup",
+				"healthchecks.schedule":      "0 3 * * *",
+				"healthchecks.tz":            "Europe/Berlin",
+				"healthchecks.grace":         "900",
+				"healthchecks.tags":          "db",
+				"com.docker.compose.project": "shop",
+				"com.docker.compose.service": "backup",
+			},
+		},
+		{ID: "2", Names: []string{"/web"}},
+		{
+			ID:     "3",
+			Names:  []string{"/worker.1"},
+			Labels: map[string]string{"healthchecks.slug": "worker", "healthchecks.timeout": "300"},
+		},
+		{
+			ID:     "4",
+			Names:  []string{"/worker.2"},
+			Labels: map[string]string{"healthchecks.slug": "worker", "healthchecks.timeout": "300"},
+		},
+	}
+
+	checks, err := Checks(containers, Options{Tags: "docker"})
+	require.NoError(t, err)
+	require.Len(t, checks, 2)
+
+	require.Equal(t, "shop/backup", checks[0].Name)
+	require.Equal(t, "0 3 * * *", checks[0].Schedule)
+	require.Equal(t, "Europe/Berlin", checks[0].Timezone)
+	require.Equal(t, 900, checks[0].Grace)
+	require.Equal(t, "docker db", checks[0].Tags)
+
+	require.Equal(t, "worker.1", checks[1].Name)
+	require.Equal(t, 300, checks[1].Timeout)
+
+	containers[3].Labels["healthchecks.timeout"] = "600"
+	_, err = Checks(containers, Options{})
+	require.EqualError(t, err, `container worker.2: slug "worker" is declared differently by another container`)
+
+	containers[3].Labels["healthchecks.timeout"] = "ten minutes"
+	_, err = Checks(containers, Options{})
+	require.ErrorContains(t, err, "label healthchecks.timeout")
+}
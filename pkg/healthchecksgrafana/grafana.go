@@ -0,0 +1,319 @@
+// Package healthchecksgrafana serves checks and their flips through the query contract of
+// Grafana's JSON datasources (SimpleJSON, and Infinity's JSON backend), so dashboards can
+// chart uptime and current status without a metrics store in between.
+package healthchecksgrafana
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/google/uuid"
+)
+
+// Targets are "<metric>:<slug|uuid>", or "status" for the table of every check:
+//
+//	up:<check>      timeserie, 1 while the check was up and 0 while it was down
+//	uptime:<check>  timeserie, a single point with the percentage of the range the check was up
+//	status          table of checks with their status, last ping and uptime over the range
+const (
+	UpMetric     = "up"
+	UptimeMetric = "uptime"
+	StatusTable  = "status"
+)
+
+// Options configures a Handler
+type Options struct {
+	// Filter limits which checks are offered and shown in the status table
+	Filter healthchecksio.GetChecks
+}
+
+// Handler implements the datasource's endpoints: GET / to test the connection and
+// POST /search, /query and /annotations. Mount it with http.StripPrefix under another path.
+type Handler struct {
+	client healthchecksio.CheckReader
+	opts   Options
+	mux    *http.ServeMux
+}
+
+// NewHandler creates a Handler reading checks from client
+func NewHandler(client healthchecksio.CheckReader, opts Options) *Handler {
+	h := &Handler{client: client, opts: opts, mux: http.NewServeMux()}
+	h.mux.HandleFunc("GET /{$}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	h.mux.HandleFunc("POST /search", h.search)
+	h.mux.HandleFunc("POST /query", h.query)
+	h.mux.HandleFunc("POST /annotations", h.annotations)
+	return h
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *Handler) search(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Target string `json:"target"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	list, err := h.client.GetChecks(r.Context(), h.opts.Filter)
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+
+	targets := []string{StatusTable}
+	for _, check := range list.Checks {
+		id := checkTarget(check)
+		targets = append(targets, UpMetric+":"+id, UptimeMetric+":"+id)
+	}
+	if req.Target != "" {
+		targets = slices.DeleteFunc(targets, func(t string) bool { return !strings.Contains(t, req.Target) })
+	}
+	writeJSON(w, targets)
+}
+
+// checkTarget identifies check in targets, by slug when it has one
+func checkTarget(check healthchecksio.Check) string {
+	if check.Slug != "" {
+		return check.Slug
+	}
+	return check.UUID
+}
+
+type timeRange struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
+
+type queryRequest struct {
+	Range   timeRange `json:"range"`
+	Targets []struct {
+		Target string `json:"target"`
+	} `json:"targets"`
+}
+
+// Series is a timeserie response, datapoints are [value, unix milliseconds]
+type Series struct {
+	Target     string       `json:"target"`
+	Datapoints [][2]float64 `json:"datapoints"`
+}
+
+// Table is a table response
+type Table struct {
+	Type    string   `json:"type"`
+	Columns []Column `json:"columns"`
+	Rows    [][]any  `json:"rows"`
+}
+
+// Column is a Table's column
+type Column struct {
+	Text string `json:"text"`
+	Type string `json:"type"`
+}
+
+func (h *Handler) query(w http.ResponseWriter, r *http.Request) {
+	var req queryRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if !req.Range.To.After(req.Range.From) {
+		writeError(w, http.StatusBadRequest, errors.New("range is empty"))
+		return
+	}
+
+	out := make([]any, 0, len(req.Targets))
+	for _, target := range req.Targets {
+		result, err := h.target(r.Context(), target.Target, req.Range)
+		if err != nil {
+			writeError(w, statusOf(err), fmt.Errorf("%s: %w", target.Target, err))
+			return
+		}
+		out = append(out, result)
+	}
+	writeJSON(w, out)
+}
+
+var errUnknownTarget = errors.New("unknown target")
+
+func (h *Handler) target(ctx context.Context, target string, rng timeRange) (any, error) {
+	if target == StatusTable {
+		return h.statusTable(ctx, rng)
+	}
+	metric, id, found := strings.Cut(target, ":")
+	if !found || (metric != UpMetric && metric != UptimeMetric) {
+		return nil, errUnknownTarget
+	}
+
+	check, err := h.check(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	report, err := h.history(ctx, *check, rng)
+	if err != nil {
+		return nil, err
+	}
+
+	series := Series{Target: target, Datapoints: [][2]float64{}}
+	if metric == UptimeMetric {
+		series.Datapoints = append(series.Datapoints, [2]float64{100 * report.Uptime, millis(rng.To)})
+		return series, nil
+	}
+	periods := report.Periods()
+	for _, p := range periods {
+		series.Datapoints = append(series.Datapoints, [2]float64{boolValue(p.Up), millis(p.Start)})
+	}
+	// Close the last period so the line reaches the end of the range
+	if n := len(periods); n > 0 {
+		series.Datapoints = append(series.Datapoints, [2]float64{boolValue(periods[n-1].Up), millis(rng.To)})
+	}
+	return series, nil
+}
+
+func (h *Handler) statusTable(ctx context.Context, rng timeRange) (Table, error) {
+	list, err := h.client.GetChecks(ctx, h.opts.Filter)
+	if err != nil {
+		return Table{}, err
+	}
+	table := Table{
+		Type: "table",
+		Columns: []Column{
+			{Text: "Check", Type: "string"},
+			{Text: "Status", Type: "string"},
+			{Text: "Last ping", Type: "time"},
+			{Text: "Uptime", Type: "number"},
+			{Text: "Tags", Type: "string"},
+		},
+		Rows: [][]any{},
+	}
+	for _, check := range list.Checks {
+		report, err := h.history(ctx, check, rng)
+		if err != nil {
+			return Table{}, fmt.Errorf("%s: %w", checkTarget(check), err)
+		}
+		var lastPing any
+		if last, pinged := check.LastPingTime(); pinged {
+			lastPing = millis(last)
+		}
+		table.Rows = append(table.Rows, []any{check.Name, check.Status, lastPing, 100 * report.Uptime, check.Tags})
+	}
+	return table, nil
+}
+
+// check finds a check by UUID or slug
+func (h *Handler) check(ctx context.Context, id string) (*healthchecksio.Check, error) {
+	if _, err := uuid.Parse(id); err == nil {
+		return h.client.GetCheck(ctx, id)
+	}
+	return healthchecksio.GetChecksBySlugExact(ctx, h.client, id)
+}
+
+// history measures check over rng with healthchecksio.ComputeUptime, whose periods are the
+// series and annotations. The state at the start of rng comes from the last flip before it.
+func (h *Handler) history(ctx context.Context, check healthchecksio.Check, rng timeRange) (*healthchecksio.UptimeReport, error) {
+	flips, err := h.client.GetFlips(ctx, check.UUID, healthchecksio.GetFlipsRequest{End: rng.To.Unix()})
+	if err != nil {
+		return nil, err
+	}
+	return healthchecksio.ComputeUptime(check, flips.Flips, rng.From, rng.To)
+}
+
+type annotationRequest struct {
+	Range      timeRange `json:"range"`
+	Annotation struct {
+		Name  string `json:"name"`
+		Query string `json:"query"`
+	} `json:"annotation"`
+}
+
+// Annotation marks a check going down or recovering
+type Annotation struct {
+	Annotation any      `json:"annotation"`
+	Time       float64  `json:"time"`
+	Title      string   `json:"title"`
+	Text       string   `json:"text"`
+	Tags       []string `json:"tags"`
+}
+
+// annotations marks every flip within the range of the check in the annotation's query
+func (h *Handler) annotations(w http.ResponseWriter, r *http.Request) {
+	var req annotationRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	check, err := h.check(r.Context(), strings.TrimSpace(req.Annotation.Query))
+	if err != nil {
+		writeError(w, statusOf(err), err)
+		return
+	}
+	report, err := h.history(r.Context(), *check, req.Range)
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+
+	out := []Annotation{}
+	for _, p := range report.Periods()[1:] {
+		state := "down"
+		if p.Up {
+			state = "up"
+		}
+		out = append(out, Annotation{
+			Annotation: req.Annotation,
+			Time:       millis(p.Start),
+			Title:      fmt.Sprintf("%s is %s", check.Name, state),
+			Tags:       append(strings.Fields(check.Tags), state),
+		})
+	}
+	writeJSON(w, out)
+}
+
+func millis(t time.Time) float64 {
+	return float64(t.UnixMilli())
+}
+
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func statusOf(err error) int {
+	switch {
+	case errors.Is(err, errUnknownTarget):
+		return http.StatusBadRequest
+	case errors.Is(err, healthchecksio.ErrNotFound):
+		return http.StatusNotFound
+	}
+	return http.StatusBadGateway
+}
+
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20)).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("decoding request: %w", err))
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,97 @@
+package healthchecksgrafana
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+const backupUUID = "6f1a4e38-9a2f-4c6b-9b5e-0c1d2e3f4a5b"
+
+func newTestHandler(t *testing.T) *Handler {
+	t.Helper()
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/checks/":
+			w.Write([]byte(`{"checks":[{"uuid":"` + backupUUID + `","name":"Backup","slug":"backup","tags":"db","status":"up","last_ping":"2025-03-01T11:00:00+00:00"}]}`))
+		case "/checks/" + backupUUID + "/flips/":
+			require.Equal(t, "1740830400", r.URL.Query().Get("end"))
+			w.Write([]byte(`{"flips":[
+				{"timestamp":"2025-02-28T00:00:00+00:00","up":1},
+				{"timestamp":"2025-03-01T06:00:00+00:00","up":0},
+				{"timestamp":"2025-03-01T09:00:00+00:00","up":1}
+			]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(api.Close)
+
+	client := healthchecksio.NewClient("key", healthchecksio.WithBaseURL(api.URL))
+	return NewHandler(client, Options{})
+}
+
+func post(t *testing.T, h http.Handler, path, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("POST", path, strings.NewReader(body)))
+	return w
+}
+
+const queryRange = `"range":{"from":"2025-03-01T00:00:00Z","to":"2025-03-01T12:00:00Z"}`
+
+func TestSearch(t *testing.T) {
+	h := newTestHandler(t)
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	w = post(t, h, "/search", `{"target":""}`)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `["status","up:backup","uptime:backup"]`, w.Body.String())
+
+	w = post(t, h, "/search", `{"target":"uptime"}`)
+	require.JSONEq(t, `["uptime:backup"]`, w.Body.String())
+}
+
+func TestQuery(t *testing.T) {
+	h := newTestHandler(t)
+
+	w := post(t, h, "/query", `{`+queryRange+`,"targets":[{"target":"up:backup"},{"target":"uptime:backup"},{"target":"status"}]}`)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var got []json.RawMessage
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+	require.Len(t, got, 3)
+
+	// Up at the start of the range, down from 06:00 to 09:00
+	require.JSONEq(t, `{"target":"up:backup","datapoints":[[1,1740787200000],[0,1740808800000],[1,1740819600000],[1,1740830400000]]}`, string(got[0]))
+	require.JSONEq(t, `{"target":"uptime:backup","datapoints":[[75,1740830400000]]}`, string(got[1]))
+	require.JSONEq(t, `{"type":"table","columns":[
+		{"text":"Check","type":"string"},{"text":"Status","type":"string"},{"text":"Last ping","type":"time"},
+		{"text":"Uptime","type":"number"},{"text":"Tags","type":"string"}
+	],"rows":[["Backup","up",1740826800000,75,"db"]]}`, string(got[2]))
+
+	w = post(t, h, "/query", `{`+queryRange+`,"targets":[{"target":"latency:backup"}]}`)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestAnnotations(t *testing.T) {
+	h := newTestHandler(t)
+
+	w := post(t, h, "/annotations", `{`+queryRange+`,"annotation":{"name":"flips","query":"backup"}}`)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.JSONEq(t, `[
+		{"annotation":{"name":"flips","query":"backup"},"time":1740808800000,"title":"Backup is down","text":"","tags":["db","down"]},
+		{"annotation":{"name":"flips","query":"backup"},"time":1740819600000,"title":"Backup is up","text":"","tags":["db","up"]}
+	]`, w.Body.String())
+}
@@ -0,0 +1,103 @@
+package healthchecksgrpc
+
+import (
+	"strings"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksgrpc/healthchecksv1"
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func toProtoCheck(check healthchecksio.Check) *healthchecksv1.Check {
+	out := &healthchecksv1.Check{
+		Uuid:           check.UUID,
+		Name:           check.Name,
+		Slug:           check.Slug,
+		Tags:           strings.Fields(check.Tags),
+		Desc:           check.Desc,
+		Status:         check.Status,
+		TimeoutSeconds: int64(check.Timeout),
+		GraceSeconds:   int64(check.Grace),
+		Schedule:       check.Schedule,
+		Timezone:       check.Timezone,
+		NPings:         int64(check.NPings),
+		ManualResume:   check.ManualResume,
+		Methods:        check.Methods,
+		PingUrl:        check.PingURL,
+		Channels:       splitChannels(check.Channels),
+	}
+	if last, pinged := check.LastPingTime(); pinged {
+		out.LastPing = timestamppb.New(last)
+	}
+	if next, ok := check.NextPing.(string); ok {
+		if at, err := time.Parse(time.RFC3339, next); err == nil {
+			out.NextPing = timestamppb.New(at)
+		}
+	}
+	return out
+}
+
+func splitChannels(channels string) []string {
+	var out []string
+	for _, id := range strings.Split(channels, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+func toCreateCheck(fields *healthchecksv1.CheckFields) healthchecksio.CreateCheck {
+	return healthchecksio.CreateCheck{
+		Name:         fields.GetName(),
+		Slug:         fields.GetSlug(),
+		Tags:         strings.Join(fields.GetTags(), " "),
+		Description:  fields.GetDesc(),
+		Timeout:      int(fields.GetTimeoutSeconds()),
+		Grace:        int(fields.GetGraceSeconds()),
+		Schedule:     fields.GetSchedule(),
+		Timezone:     fields.GetTimezone(),
+		ManualResume: fields.GetManualResume(),
+		Methods:      fields.GetMethods(),
+		Channels:     strings.Join(fields.GetChannels(), ","),
+	}
+}
+
+func toUpdateCheck(fields *healthchecksv1.CheckFields) healthchecksio.UpdateCheck {
+	return healthchecksio.UpdateCheck{
+		Name:         fields.GetName(),
+		Slug:         fields.GetSlug(),
+		Tags:         strings.Join(fields.GetTags(), " "),
+		Description:  fields.GetDesc(),
+		Timeout:      int(fields.GetTimeoutSeconds()),
+		Grace:        int(fields.GetGraceSeconds()),
+		Schedule:     fields.GetSchedule(),
+		Timezone:     fields.GetTimezone(),
+		ManualResume: fields.GetManualResume(),
+		Methods:      fields.GetMethods(),
+		Channels:     strings.Join(fields.GetChannels(), ","),
+	}
+}
+
+func toProtoPing(ping healthchecksio.Ping) *healthchecksv1.Ping {
+	return &healthchecksv1.Ping{
+		N:               int64(ping.N),
+		Type:            ping.Type,
+		Date:            timestamppb.New(ping.Date),
+		Scheme:          ping.Scheme,
+		RemoteAddr:      ping.RemoteAddr,
+		Method:          ping.Method,
+		UserAgent:       ping.Ua,
+		DurationSeconds: ping.Duration,
+	}
+}
+
+func toProtoFlip(flip healthchecksio.Flip) (*healthchecksv1.Flip, error) {
+	at, err := flip.Time()
+	if err != nil {
+		return nil, err
+	}
+	return &healthchecksv1.Flip{Timestamp: timestamppb.New(at), Up: flip.Up == 1}, nil
+}
@@ -0,0 +1,1264 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: healthchecks.proto
+
+// Healthchecks exposes a healthchecks.io project through one credentialed gateway, so
+// services manage checks and send pings without holding API keys.
+
+package healthchecksv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PingRequest_Kind int32
+
+const (
+	PingRequest_KIND_SUCCESS PingRequest_Kind = 0
+	PingRequest_KIND_START   PingRequest_Kind = 1
+	PingRequest_KIND_FAIL    PingRequest_Kind = 2
+	PingRequest_KIND_LOG     PingRequest_Kind = 3
+)
+
+// Enum value maps for PingRequest_Kind.
+var (
+	PingRequest_Kind_name = map[int32]string{
+		0: "KIND_SUCCESS",
+		1: "KIND_START",
+		2: "KIND_FAIL",
+		3: "KIND_LOG",
+	}
+	PingRequest_Kind_value = map[string]int32{
+		"KIND_SUCCESS": 0,
+		"KIND_START":   1,
+		"KIND_FAIL":    2,
+		"KIND_LOG":     3,
+	}
+)
+
+func (x PingRequest_Kind) Enum() *PingRequest_Kind {
+	p := new(PingRequest_Kind)
+	*p = x
+	return p
+}
+
+func (x PingRequest_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PingRequest_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_healthchecks_proto_enumTypes[0].Descriptor()
+}
+
+func (PingRequest_Kind) Type() protoreflect.EnumType {
+	return &file_healthchecks_proto_enumTypes[0]
+}
+
+func (x PingRequest_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PingRequest_Kind.Descriptor instead.
+func (PingRequest_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_healthchecks_proto_rawDescGZIP(), []int{13, 0}
+}
+
+type Check struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Uuid           string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Slug           string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	Tags           []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Desc           string                 `protobuf:"bytes,5,opt,name=desc,proto3" json:"desc,omitempty"`
+	Status         string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	TimeoutSeconds int64                  `protobuf:"varint,7,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	GraceSeconds   int64                  `protobuf:"varint,8,opt,name=grace_seconds,json=graceSeconds,proto3" json:"grace_seconds,omitempty"`
+	Schedule       string                 `protobuf:"bytes,9,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Timezone       string                 `protobuf:"bytes,10,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	NPings         int64                  `protobuf:"varint,11,opt,name=n_pings,json=nPings,proto3" json:"n_pings,omitempty"`
+	LastPing       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=last_ping,json=lastPing,proto3" json:"last_ping,omitempty"`
+	NextPing       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=next_ping,json=nextPing,proto3" json:"next_ping,omitempty"`
+	ManualResume   bool                   `protobuf:"varint,14,opt,name=manual_resume,json=manualResume,proto3" json:"manual_resume,omitempty"`
+	Methods        string                 `protobuf:"bytes,15,opt,name=methods,proto3" json:"methods,omitempty"`
+	PingUrl        string                 `protobuf:"bytes,16,opt,name=ping_url,json=pingUrl,proto3" json:"ping_url,omitempty"`
+	Channels       []string               `protobuf:"bytes,17,rep,name=channels,proto3" json:"channels,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Check) Reset() {
+	*x = Check{}
+	mi := &file_healthchecks_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Check) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Check) ProtoMessage() {}
+
+func (x *Check) ProtoReflect() protoreflect.Message {
+	mi := &file_healthchecks_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Check.ProtoReflect.Descriptor instead.
+func (*Check) Descriptor() ([]byte, []int) {
+	return file_healthchecks_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Check) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Check) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Check) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Check) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Check) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+func (x *Check) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Check) GetTimeoutSeconds() int64 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *Check) GetGraceSeconds() int64 {
+	if x != nil {
+		return x.GraceSeconds
+	}
+	return 0
+}
+
+func (x *Check) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *Check) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Check) GetNPings() int64 {
+	if x != nil {
+		return x.NPings
+	}
+	return 0
+}
+
+func (x *Check) GetLastPing() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastPing
+	}
+	return nil
+}
+
+func (x *Check) GetNextPing() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextPing
+	}
+	return nil
+}
+
+func (x *Check) GetManualResume() bool {
+	if x != nil {
+		return x.ManualResume
+	}
+	return false
+}
+
+func (x *Check) GetMethods() string {
+	if x != nil {
+		return x.Methods
+	}
+	return ""
+}
+
+func (x *Check) GetPingUrl() string {
+	if x != nil {
+		return x.PingUrl
+	}
+	return ""
+}
+
+func (x *Check) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type ListChecksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Slug  string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	// Only checks having every tag are listed
+	Tags          []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChecksRequest) Reset() {
+	*x = ListChecksRequest{}
+	mi := &file_healthchecks_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChecksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChecksRequest) ProtoMessage() {}
+
+func (x *ListChecksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_healthchecks_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChecksRequest.ProtoReflect.Descriptor instead.
+func (*ListChecksRequest) Descriptor() ([]byte, []int) {
+	return file_healthchecks_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListChecksRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *ListChecksRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ListChecksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Checks        []*Check               `protobuf:"bytes,1,rep,name=checks,proto3" json:"checks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChecksResponse) Reset() {
+	*x = ListChecksResponse{}
+	mi := &file_healthchecks_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChecksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChecksResponse) ProtoMessage() {}
+
+func (x *ListChecksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_healthchecks_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChecksResponse.ProtoReflect.Descriptor instead.
+func (*ListChecksResponse) Descriptor() ([]byte, []int) {
+	return file_healthchecks_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListChecksResponse) GetChecks() []*Check {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+type GetCheckRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A check's UUID, unique key or slug
+	Check         string `protobuf:"bytes,1,opt,name=check,proto3" json:"check,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCheckRequest) Reset() {
+	*x = GetCheckRequest{}
+	mi := &file_healthchecks_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCheckRequest) ProtoMessage() {}
+
+func (x *GetCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_healthchecks_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCheckRequest.ProtoReflect.Descriptor instead.
+func (*GetCheckRequest) Descriptor() ([]byte, []int) {
+	return file_healthchecks_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetCheckRequest) GetCheck() string {
+	if x != nil {
+		return x.Check
+	}
+	return ""
+}
+
+type CheckRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A check's UUID or slug
+	Check         string `protobuf:"bytes,1,opt,name=check,proto3" json:"check,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckRequest) Reset() {
+	*x = CheckRequest{}
+	mi := &file_healthchecks_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckRequest) ProtoMessage() {}
+
+func (x *CheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_healthchecks_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckRequest.ProtoReflect.Descriptor instead.
+func (*CheckRequest) Descriptor() ([]byte, []int) {
+	return file_healthchecks_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CheckRequest) GetCheck() string {
+	if x != nil {
+		return x.Check
+	}
+	return ""
+}
+
+type CheckFields struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Slug           string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Tags           []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Desc           string                 `protobuf:"bytes,4,opt,name=desc,proto3" json:"desc,omitempty"`
+	TimeoutSeconds int64                  `protobuf:"varint,5,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	GraceSeconds   int64                  `protobuf:"varint,6,opt,name=grace_seconds,json=graceSeconds,proto3" json:"grace_seconds,omitempty"`
+	Schedule       string                 `protobuf:"bytes,7,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Timezone       string                 `protobuf:"bytes,8,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	ManualResume   bool                   `protobuf:"varint,9,opt,name=manual_resume,json=manualResume,proto3" json:"manual_resume,omitempty"`
+	Methods        string                 `protobuf:"bytes,10,opt,name=methods,proto3" json:"methods,omitempty"`
+	// Channel IDs, or "*" for every channel
+	Channels      []string `protobuf:"bytes,11,rep,name=channels,proto3" json:"channels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckFields) Reset() {
+	*x = CheckFields{}
+	mi := &file_healthchecks_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckFields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckFields) ProtoMessage() {}
+
+func (x *CheckFields) ProtoReflect() protoreflect.Message {
+	mi := &file_healthchecks_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckFields.ProtoReflect.Descriptor instead.
+func (*CheckFields) Descriptor() ([]byte, []int) {
+	return file_healthchecks_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CheckFields) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CheckFields) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *CheckFields) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CheckFields) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+func (x *CheckFields) GetTimeoutSeconds() int64 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *CheckFields) GetGraceSeconds() int64 {
+	if x != nil {
+		return x.GraceSeconds
+	}
+	return 0
+}
+
+func (x *CheckFields) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *CheckFields) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *CheckFields) GetManualResume() bool {
+	if x != nil {
+		return x.ManualResume
+	}
+	return false
+}
+
+func (x *CheckFields) GetMethods() string {
+	if x != nil {
+		return x.Methods
+	}
+	return ""
+}
+
+func (x *CheckFields) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type CreateCheckRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Check *CheckFields           `protobuf:"bytes,1,opt,name=check,proto3" json:"check,omitempty"`
+	// Fields which identify an existing check to return instead of creating a duplicate
+	Unique        []string `protobuf:"bytes,2,rep,name=unique,proto3" json:"unique,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCheckRequest) Reset() {
+	*x = CreateCheckRequest{}
+	mi := &file_healthchecks_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCheckRequest) ProtoMessage() {}
+
+func (x *CreateCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_healthchecks_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCheckRequest.ProtoReflect.Descriptor instead.
+func (*CreateCheckRequest) Descriptor() ([]byte, []int) {
+	return file_healthchecks_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateCheckRequest) GetCheck() *CheckFields {
+	if x != nil {
+		return x.Check
+	}
+	return nil
+}
+
+func (x *CreateCheckRequest) GetUnique() []string {
+	if x != nil {
+		return x.Unique
+	}
+	return nil
+}
+
+type UpdateCheckRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A check's UUID or slug
+	Check string `protobuf:"bytes,1,opt,name=check,proto3" json:"check,omitempty"`
+	// Only non-empty fields are changed
+	Update        *CheckFields `protobuf:"bytes,2,opt,name=update,proto3" json:"update,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCheckRequest) Reset() {
+	*x = UpdateCheckRequest{}
+	mi := &file_healthchecks_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCheckRequest) ProtoMessage() {}
+
+func (x *UpdateCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_healthchecks_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCheckRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCheckRequest) Descriptor() ([]byte, []int) {
+	return file_healthchecks_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateCheckRequest) GetCheck() string {
+	if x != nil {
+		return x.Check
+	}
+	return ""
+}
+
+func (x *UpdateCheckRequest) GetUpdate() *CheckFields {
+	if x != nil {
+		return x.Update
+	}
+	return nil
+}
+
+type Ping struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	N               int64                  `protobuf:"varint,1,opt,name=n,proto3" json:"n,omitempty"`
+	Type            string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Date            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Scheme          string                 `protobuf:"bytes,4,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	RemoteAddr      string                 `protobuf:"bytes,5,opt,name=remote_addr,json=remoteAddr,proto3" json:"remote_addr,omitempty"`
+	Method          string                 `protobuf:"bytes,6,opt,name=method,proto3" json:"method,omitempty"`
+	UserAgent       string                 `protobuf:"bytes,7,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	DurationSeconds float64                `protobuf:"fixed64,8,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Ping) Reset() {
+	*x = Ping{}
+	mi := &file_healthchecks_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ping) ProtoMessage() {}
+
+func (x *Ping) ProtoReflect() protoreflect.Message {
+	mi := &file_healthchecks_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ping.ProtoReflect.Descriptor instead.
+func (*Ping) Descriptor() ([]byte, []int) {
+	return file_healthchecks_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Ping) GetN() int64 {
+	if x != nil {
+		return x.N
+	}
+	return 0
+}
+
+func (x *Ping) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Ping) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *Ping) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
+func (x *Ping) GetRemoteAddr() string {
+	if x != nil {
+		return x.RemoteAddr
+	}
+	return ""
+}
+
+func (x *Ping) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Ping) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *Ping) GetDurationSeconds() float64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type ListPingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pings         []*Ping                `protobuf:"bytes,1,rep,name=pings,proto3" json:"pings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPingsResponse) Reset() {
+	*x = ListPingsResponse{}
+	mi := &file_healthchecks_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPingsResponse) ProtoMessage() {}
+
+func (x *ListPingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_healthchecks_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPingsResponse.ProtoReflect.Descriptor instead.
+func (*ListPingsResponse) Descriptor() ([]byte, []int) {
+	return file_healthchecks_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListPingsResponse) GetPings() []*Ping {
+	if x != nil {
+		return x.Pings
+	}
+	return nil
+}
+
+type ListFlipsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A check's UUID or slug
+	Check         string                 `protobuf:"bytes,1,opt,name=check,proto3" json:"check,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFlipsRequest) Reset() {
+	*x = ListFlipsRequest{}
+	mi := &file_healthchecks_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFlipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFlipsRequest) ProtoMessage() {}
+
+func (x *ListFlipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_healthchecks_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFlipsRequest.ProtoReflect.Descriptor instead.
+func (*ListFlipsRequest) Descriptor() ([]byte, []int) {
+	return file_healthchecks_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListFlipsRequest) GetCheck() string {
+	if x != nil {
+		return x.Check
+	}
+	return ""
+}
+
+func (x *ListFlipsRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *ListFlipsRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+type Flip struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Up            bool                   `protobuf:"varint,2,opt,name=up,proto3" json:"up,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Flip) Reset() {
+	*x = Flip{}
+	mi := &file_healthchecks_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Flip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Flip) ProtoMessage() {}
+
+func (x *Flip) ProtoReflect() protoreflect.Message {
+	mi := &file_healthchecks_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Flip.ProtoReflect.Descriptor instead.
+func (*Flip) Descriptor() ([]byte, []int) {
+	return file_healthchecks_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Flip) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Flip) GetUp() bool {
+	if x != nil {
+		return x.Up
+	}
+	return false
+}
+
+type ListFlipsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flips         []*Flip                `protobuf:"bytes,1,rep,name=flips,proto3" json:"flips,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFlipsResponse) Reset() {
+	*x = ListFlipsResponse{}
+	mi := &file_healthchecks_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFlipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFlipsResponse) ProtoMessage() {}
+
+func (x *ListFlipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_healthchecks_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFlipsResponse.ProtoReflect.Descriptor instead.
+func (*ListFlipsResponse) Descriptor() ([]byte, []int) {
+	return file_healthchecks_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListFlipsResponse) GetFlips() []*Flip {
+	if x != nil {
+		return x.Flips
+	}
+	return nil
+}
+
+type PingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A check's UUID, or its slug when the gateway has a ping key
+	Check         string           `protobuf:"bytes,1,opt,name=check,proto3" json:"check,omitempty"`
+	Kind          PingRequest_Kind `protobuf:"varint,2,opt,name=kind,proto3,enum=healthchecks.v1.PingRequest_Kind" json:"kind,omitempty"`
+	Body          []byte           `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PingRequest) Reset() {
+	*x = PingRequest{}
+	mi := &file_healthchecks_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingRequest) ProtoMessage() {}
+
+func (x *PingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_healthchecks_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return file_healthchecks_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PingRequest) GetCheck() string {
+	if x != nil {
+		return x.Check
+	}
+	return ""
+}
+
+func (x *PingRequest) GetKind() PingRequest_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return PingRequest_KIND_SUCCESS
+}
+
+func (x *PingRequest) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type PingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PingResponse) Reset() {
+	*x = PingResponse{}
+	mi := &file_healthchecks_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingResponse) ProtoMessage() {}
+
+func (x *PingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_healthchecks_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
+func (*PingResponse) Descriptor() ([]byte, []int) {
+	return file_healthchecks_proto_rawDescGZIP(), []int{14}
+}
+
+var File_healthchecks_proto protoreflect.FileDescriptor
+
+const file_healthchecks_proto_rawDesc = "" +
+	"\n" +
+	"\x12healthchecks.proto\x12\x0fhealthchecks.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8a\x04\n" +
+	"\x05Check\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12\x12\n" +
+	"\x04desc\x18\x05 \x01(\tR\x04desc\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12'\n" +
+	"\x0ftimeout_seconds\x18\a \x01(\x03R\x0etimeoutSeconds\x12#\n" +
+	"\rgrace_seconds\x18\b \x01(\x03R\fgraceSeconds\x12\x1a\n" +
+	"\bschedule\x18\t \x01(\tR\bschedule\x12\x1a\n" +
+	"\btimezone\x18\n" +
+	" \x01(\tR\btimezone\x12\x17\n" +
+	"\an_pings\x18\v \x01(\x03R\x06nPings\x127\n" +
+	"\tlast_ping\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\blastPing\x127\n" +
+	"\tnext_ping\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\bnextPing\x12#\n" +
+	"\rmanual_resume\x18\x0e \x01(\bR\fmanualResume\x12\x18\n" +
+	"\amethods\x18\x0f \x01(\tR\amethods\x12\x19\n" +
+	"\bping_url\x18\x10 \x01(\tR\apingUrl\x12\x1a\n" +
+	"\bchannels\x18\x11 \x03(\tR\bchannels\";\n" +
+	"\x11ListChecksRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\"D\n" +
+	"\x12ListChecksResponse\x12.\n" +
+	"\x06checks\x18\x01 \x03(\v2\x16.healthchecks.v1.CheckR\x06checks\"'\n" +
+	"\x0fGetCheckRequest\x12\x14\n" +
+	"\x05check\x18\x01 \x01(\tR\x05check\"$\n" +
+	"\fCheckRequest\x12\x14\n" +
+	"\x05check\x18\x01 \x01(\tR\x05check\"\xbe\x02\n" +
+	"\vCheckFields\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12\x12\n" +
+	"\x04desc\x18\x04 \x01(\tR\x04desc\x12'\n" +
+	"\x0ftimeout_seconds\x18\x05 \x01(\x03R\x0etimeoutSeconds\x12#\n" +
+	"\rgrace_seconds\x18\x06 \x01(\x03R\fgraceSeconds\x12\x1a\n" +
+	"\bschedule\x18\a \x01(\tR\bschedule\x12\x1a\n" +
+	"\btimezone\x18\b \x01(\tR\btimezone\x12#\n" +
+	"\rmanual_resume\x18\t \x01(\bR\fmanualResume\x12\x18\n" +
+	"\amethods\x18\n" +
+	" \x01(\tR\amethods\x12\x1a\n" +
+	"\bchannels\x18\v \x03(\tR\bchannels\"`\n" +
+	"\x12CreateCheckRequest\x122\n" +
+	"\x05check\x18\x01 \x01(\v2\x1c.healthchecks.v1.CheckFieldsR\x05check\x12\x16\n" +
+	"\x06unique\x18\x02 \x03(\tR\x06unique\"`\n" +
+	"\x12UpdateCheckRequest\x12\x14\n" +
+	"\x05check\x18\x01 \x01(\tR\x05check\x124\n" +
+	"\x06update\x18\x02 \x01(\v2\x1c.healthchecks.v1.CheckFieldsR\x06update\"\xf3\x01\n" +
+	"\x04Ping\x12\f\n" +
+	"\x01n\x18\x01 \x01(\x03R\x01n\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12.\n" +
+	"\x04date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x16\n" +
+	"\x06scheme\x18\x04 \x01(\tR\x06scheme\x12\x1f\n" +
+	"\vremote_addr\x18\x05 \x01(\tR\n" +
+	"remoteAddr\x12\x16\n" +
+	"\x06method\x18\x06 \x01(\tR\x06method\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\a \x01(\tR\tuserAgent\x12)\n" +
+	"\x10duration_seconds\x18\b \x01(\x01R\x0fdurationSeconds\"@\n" +
+	"\x11ListPingsResponse\x12+\n" +
+	"\x05pings\x18\x01 \x03(\v2\x15.healthchecks.v1.PingR\x05pings\"\x88\x01\n" +
+	"\x10ListFlipsRequest\x12\x14\n" +
+	"\x05check\x18\x01 \x01(\tR\x05check\x120\n" +
+	"\x05start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\"P\n" +
+	"\x04Flip\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x0e\n" +
+	"\x02up\x18\x02 \x01(\bR\x02up\"@\n" +
+	"\x11ListFlipsResponse\x12+\n" +
+	"\x05flips\x18\x01 \x03(\v2\x15.healthchecks.v1.FlipR\x05flips\"\xb5\x01\n" +
+	"\vPingRequest\x12\x14\n" +
+	"\x05check\x18\x01 \x01(\tR\x05check\x125\n" +
+	"\x04kind\x18\x02 \x01(\x0e2!.healthchecks.v1.PingRequest.KindR\x04kind\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\fR\x04body\"E\n" +
+	"\x04Kind\x12\x10\n" +
+	"\fKIND_SUCCESS\x10\x00\x12\x0e\n" +
+	"\n" +
+	"KIND_START\x10\x01\x12\r\n" +
+	"\tKIND_FAIL\x10\x02\x12\f\n" +
+	"\bKIND_LOG\x10\x03\"\x0e\n" +
+	"\fPingResponse2\xfd\x05\n" +
+	"\fHealthchecks\x12U\n" +
+	"\n" +
+	"ListChecks\x12\".healthchecks.v1.ListChecksRequest\x1a#.healthchecks.v1.ListChecksResponse\x12D\n" +
+	"\bGetCheck\x12 .healthchecks.v1.GetCheckRequest\x1a\x16.healthchecks.v1.Check\x12J\n" +
+	"\vCreateCheck\x12#.healthchecks.v1.CreateCheckRequest\x1a\x16.healthchecks.v1.Check\x12J\n" +
+	"\vUpdateCheck\x12#.healthchecks.v1.UpdateCheckRequest\x1a\x16.healthchecks.v1.Check\x12D\n" +
+	"\vDeleteCheck\x12\x1d.healthchecks.v1.CheckRequest\x1a\x16.healthchecks.v1.Check\x12C\n" +
+	"\n" +
+	"PauseCheck\x12\x1d.healthchecks.v1.CheckRequest\x1a\x16.healthchecks.v1.Check\x12D\n" +
+	"\vResumeCheck\x12\x1d.healthchecks.v1.CheckRequest\x1a\x16.healthchecks.v1.Check\x12N\n" +
+	"\tListPings\x12\x1d.healthchecks.v1.CheckRequest\x1a\".healthchecks.v1.ListPingsResponse\x12R\n" +
+	"\tListFlips\x12!.healthchecks.v1.ListFlipsRequest\x1a\".healthchecks.v1.ListFlipsResponse\x12C\n" +
+	"\x04Ping\x12\x1c.healthchecks.v1.PingRequest\x1a\x1d.healthchecks.v1.PingResponseBLZJgithub.com/adamdecaf/go-healthchecksio/pkg/healthchecksgrpc/healthchecksv1b\x06proto3"
+
+var (
+	file_healthchecks_proto_rawDescOnce sync.Once
+	file_healthchecks_proto_rawDescData []byte
+)
+
+func file_healthchecks_proto_rawDescGZIP() []byte {
+	file_healthchecks_proto_rawDescOnce.Do(func() {
+		file_healthchecks_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_healthchecks_proto_rawDesc), len(file_healthchecks_proto_rawDesc)))
+	})
+	return file_healthchecks_proto_rawDescData
+}
+
+var file_healthchecks_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_healthchecks_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_healthchecks_proto_goTypes = []any{
+	(PingRequest_Kind)(0),         // 0: healthchecks.v1.PingRequest.Kind
+	(*Check)(nil),                 // 1: healthchecks.v1.Check
+	(*ListChecksRequest)(nil),     // 2: healthchecks.v1.ListChecksRequest
+	(*ListChecksResponse)(nil),    // 3: healthchecks.v1.ListChecksResponse
+	(*GetCheckRequest)(nil),       // 4: healthchecks.v1.GetCheckRequest
+	(*CheckRequest)(nil),          // 5: healthchecks.v1.CheckRequest
+	(*CheckFields)(nil),           // 6: healthchecks.v1.CheckFields
+	(*CreateCheckRequest)(nil),    // 7: healthchecks.v1.CreateCheckRequest
+	(*UpdateCheckRequest)(nil),    // 8: healthchecks.v1.UpdateCheckRequest
+	(*Ping)(nil),                  // 9: healthchecks.v1.Ping
+	(*ListPingsResponse)(nil),     // 10: healthchecks.v1.ListPingsResponse
+	(*ListFlipsRequest)(nil),      // 11: healthchecks.v1.ListFlipsRequest
+	(*Flip)(nil),                  // 12: healthchecks.v1.Flip
+	(*ListFlipsResponse)(nil),     // 13: healthchecks.v1.ListFlipsResponse
+	(*PingRequest)(nil),           // 14: healthchecks.v1.PingRequest
+	(*PingResponse)(nil),          // 15: healthchecks.v1.PingResponse
+	(*timestamppb.Timestamp)(nil), // 16: google.protobuf.Timestamp
+}
+var file_healthchecks_proto_depIdxs = []int32{
+	16, // 0: healthchecks.v1.Check.last_ping:type_name -> google.protobuf.Timestamp
+	16, // 1: healthchecks.v1.Check.next_ping:type_name -> google.protobuf.Timestamp
+	1,  // 2: healthchecks.v1.ListChecksResponse.checks:type_name -> healthchecks.v1.Check
+	6,  // 3: healthchecks.v1.CreateCheckRequest.check:type_name -> healthchecks.v1.CheckFields
+	6,  // 4: healthchecks.v1.UpdateCheckRequest.update:type_name -> healthchecks.v1.CheckFields
+	16, // 5: healthchecks.v1.Ping.date:type_name -> google.protobuf.Timestamp
+	9,  // 6: healthchecks.v1.ListPingsResponse.pings:type_name -> healthchecks.v1.Ping
+	16, // 7: healthchecks.v1.ListFlipsRequest.start:type_name -> google.protobuf.Timestamp
+	16, // 8: healthchecks.v1.ListFlipsRequest.end:type_name -> google.protobuf.Timestamp
+	16, // 9: healthchecks.v1.Flip.timestamp:type_name -> google.protobuf.Timestamp
+	12, // 10: healthchecks.v1.ListFlipsResponse.flips:type_name -> healthchecks.v1.Flip
+	0,  // 11: healthchecks.v1.PingRequest.kind:type_name -> healthchecks.v1.PingRequest.Kind
+	2,  // 12: healthchecks.v1.Healthchecks.ListChecks:input_type -> healthchecks.v1.ListChecksRequest
+	4,  // 13: healthchecks.v1.Healthchecks.GetCheck:input_type -> healthchecks.v1.GetCheckRequest
+	7,  // 14: healthchecks.v1.Healthchecks.CreateCheck:input_type -> healthchecks.v1.CreateCheckRequest
+	8,  // 15: healthchecks.v1.Healthchecks.UpdateCheck:input_type -> healthchecks.v1.UpdateCheckRequest
+	5,  // 16: healthchecks.v1.Healthchecks.DeleteCheck:input_type -> healthchecks.v1.CheckRequest
+	5,  // 17: healthchecks.v1.Healthchecks.PauseCheck:input_type -> healthchecks.v1.CheckRequest
+	5,  // 18: healthchecks.v1.Healthchecks.ResumeCheck:input_type -> healthchecks.v1.CheckRequest
+	5,  // 19: healthchecks.v1.Healthchecks.ListPings:input_type -> healthchecks.v1.CheckRequest
+	11, // 20: healthchecks.v1.Healthchecks.ListFlips:input_type -> healthchecks.v1.ListFlipsRequest
+	14, // 21: healthchecks.v1.Healthchecks.Ping:input_type -> healthchecks.v1.PingRequest
+	3,  // 22: healthchecks.v1.Healthchecks.ListChecks:output_type -> healthchecks.v1.ListChecksResponse
+	1,  // 23: healthchecks.v1.Healthchecks.GetCheck:output_type -> healthchecks.v1.Check
+	1,  // 24: healthchecks.v1.Healthchecks.CreateCheck:output_type -> healthchecks.v1.Check
+	1,  // 25: healthchecks.v1.Healthchecks.UpdateCheck:output_type -> healthchecks.v1.Check
+	1,  // 26: healthchecks.v1.Healthchecks.DeleteCheck:output_type -> healthchecks.v1.Check
+	1,  // 27: healthchecks.v1.Healthchecks.PauseCheck:output_type -> healthchecks.v1.Check
+	1,  // 28: healthchecks.v1.Healthchecks.ResumeCheck:output_type -> healthchecks.v1.Check
+	10, // 29: healthchecks.v1.Healthchecks.ListPings:output_type -> healthchecks.v1.ListPingsResponse
+	13, // 30: healthchecks.v1.Healthchecks.ListFlips:output_type -> healthchecks.v1.ListFlipsResponse
+	15, // 31: healthchecks.v1.Healthchecks.Ping:output_type -> healthchecks.v1.PingResponse
+	22, // [22:32] is the sub-list for method output_type
+	12, // [12:22] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_healthchecks_proto_init() }
+func file_healthchecks_proto_init() {
+	if File_healthchecks_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_healthchecks_proto_rawDesc), len(file_healthchecks_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_healthchecks_proto_goTypes,
+		DependencyIndexes: file_healthchecks_proto_depIdxs,
+		EnumInfos:         file_healthchecks_proto_enumTypes,
+		MessageInfos:      file_healthchecks_proto_msgTypes,
+	}.Build()
+	File_healthchecks_proto = out.File
+	file_healthchecks_proto_goTypes = nil
+	file_healthchecks_proto_depIdxs = nil
+}
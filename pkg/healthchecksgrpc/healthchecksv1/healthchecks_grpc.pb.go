@@ -0,0 +1,466 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: healthchecks.proto
+
+// Healthchecks exposes a healthchecks.io project through one credentialed gateway, so
+// services manage checks and send pings without holding API keys.
+
+package healthchecksv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Healthchecks_ListChecks_FullMethodName  = "/healthchecks.v1.Healthchecks/ListChecks"
+	Healthchecks_GetCheck_FullMethodName    = "/healthchecks.v1.Healthchecks/GetCheck"
+	Healthchecks_CreateCheck_FullMethodName = "/healthchecks.v1.Healthchecks/CreateCheck"
+	Healthchecks_UpdateCheck_FullMethodName = "/healthchecks.v1.Healthchecks/UpdateCheck"
+	Healthchecks_DeleteCheck_FullMethodName = "/healthchecks.v1.Healthchecks/DeleteCheck"
+	Healthchecks_PauseCheck_FullMethodName  = "/healthchecks.v1.Healthchecks/PauseCheck"
+	Healthchecks_ResumeCheck_FullMethodName = "/healthchecks.v1.Healthchecks/ResumeCheck"
+	Healthchecks_ListPings_FullMethodName   = "/healthchecks.v1.Healthchecks/ListPings"
+	Healthchecks_ListFlips_FullMethodName   = "/healthchecks.v1.Healthchecks/ListFlips"
+	Healthchecks_Ping_FullMethodName        = "/healthchecks.v1.Healthchecks/Ping"
+)
+
+// HealthchecksClient is the client API for Healthchecks service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HealthchecksClient interface {
+	ListChecks(ctx context.Context, in *ListChecksRequest, opts ...grpc.CallOption) (*ListChecksResponse, error)
+	GetCheck(ctx context.Context, in *GetCheckRequest, opts ...grpc.CallOption) (*Check, error)
+	CreateCheck(ctx context.Context, in *CreateCheckRequest, opts ...grpc.CallOption) (*Check, error)
+	UpdateCheck(ctx context.Context, in *UpdateCheckRequest, opts ...grpc.CallOption) (*Check, error)
+	DeleteCheck(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*Check, error)
+	PauseCheck(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*Check, error)
+	ResumeCheck(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*Check, error)
+	ListPings(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*ListPingsResponse, error)
+	ListFlips(ctx context.Context, in *ListFlipsRequest, opts ...grpc.CallOption) (*ListFlipsResponse, error)
+	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+}
+
+type healthchecksClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHealthchecksClient(cc grpc.ClientConnInterface) HealthchecksClient {
+	return &healthchecksClient{cc}
+}
+
+func (c *healthchecksClient) ListChecks(ctx context.Context, in *ListChecksRequest, opts ...grpc.CallOption) (*ListChecksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChecksResponse)
+	err := c.cc.Invoke(ctx, Healthchecks_ListChecks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthchecksClient) GetCheck(ctx context.Context, in *GetCheckRequest, opts ...grpc.CallOption) (*Check, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Check)
+	err := c.cc.Invoke(ctx, Healthchecks_GetCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthchecksClient) CreateCheck(ctx context.Context, in *CreateCheckRequest, opts ...grpc.CallOption) (*Check, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Check)
+	err := c.cc.Invoke(ctx, Healthchecks_CreateCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthchecksClient) UpdateCheck(ctx context.Context, in *UpdateCheckRequest, opts ...grpc.CallOption) (*Check, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Check)
+	err := c.cc.Invoke(ctx, Healthchecks_UpdateCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthchecksClient) DeleteCheck(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*Check, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Check)
+	err := c.cc.Invoke(ctx, Healthchecks_DeleteCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthchecksClient) PauseCheck(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*Check, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Check)
+	err := c.cc.Invoke(ctx, Healthchecks_PauseCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthchecksClient) ResumeCheck(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*Check, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Check)
+	err := c.cc.Invoke(ctx, Healthchecks_ResumeCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthchecksClient) ListPings(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*ListPingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPingsResponse)
+	err := c.cc.Invoke(ctx, Healthchecks_ListPings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthchecksClient) ListFlips(ctx context.Context, in *ListFlipsRequest, opts ...grpc.CallOption) (*ListFlipsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFlipsResponse)
+	err := c.cc.Invoke(ctx, Healthchecks_ListFlips_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthchecksClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PingResponse)
+	err := c.cc.Invoke(ctx, Healthchecks_Ping_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HealthchecksServer is the server API for Healthchecks service.
+// All implementations must embed UnimplementedHealthchecksServer
+// for forward compatibility.
+type HealthchecksServer interface {
+	ListChecks(context.Context, *ListChecksRequest) (*ListChecksResponse, error)
+	GetCheck(context.Context, *GetCheckRequest) (*Check, error)
+	CreateCheck(context.Context, *CreateCheckRequest) (*Check, error)
+	UpdateCheck(context.Context, *UpdateCheckRequest) (*Check, error)
+	DeleteCheck(context.Context, *CheckRequest) (*Check, error)
+	PauseCheck(context.Context, *CheckRequest) (*Check, error)
+	ResumeCheck(context.Context, *CheckRequest) (*Check, error)
+	ListPings(context.Context, *CheckRequest) (*ListPingsResponse, error)
+	ListFlips(context.Context, *ListFlipsRequest) (*ListFlipsResponse, error)
+	Ping(context.Context, *PingRequest) (*PingResponse, error)
+	mustEmbedUnimplementedHealthchecksServer()
+}
+
+// UnimplementedHealthchecksServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedHealthchecksServer struct{}
+
+func (UnimplementedHealthchecksServer) ListChecks(context.Context, *ListChecksRequest) (*ListChecksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListChecks not implemented")
+}
+func (UnimplementedHealthchecksServer) GetCheck(context.Context, *GetCheckRequest) (*Check, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCheck not implemented")
+}
+func (UnimplementedHealthchecksServer) CreateCheck(context.Context, *CreateCheckRequest) (*Check, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCheck not implemented")
+}
+func (UnimplementedHealthchecksServer) UpdateCheck(context.Context, *UpdateCheckRequest) (*Check, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCheck not implemented")
+}
+func (UnimplementedHealthchecksServer) DeleteCheck(context.Context, *CheckRequest) (*Check, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCheck not implemented")
+}
+func (UnimplementedHealthchecksServer) PauseCheck(context.Context, *CheckRequest) (*Check, error) {
+	return nil, status.Error(codes.Unimplemented, "method PauseCheck not implemented")
+}
+func (UnimplementedHealthchecksServer) ResumeCheck(context.Context, *CheckRequest) (*Check, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumeCheck not implemented")
+}
+func (UnimplementedHealthchecksServer) ListPings(context.Context, *CheckRequest) (*ListPingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPings not implemented")
+}
+func (UnimplementedHealthchecksServer) ListFlips(context.Context, *ListFlipsRequest) (*ListFlipsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFlips not implemented")
+}
+func (UnimplementedHealthchecksServer) Ping(context.Context, *PingRequest) (*PingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Ping not implemented")
+}
+func (UnimplementedHealthchecksServer) mustEmbedUnimplementedHealthchecksServer() {}
+func (UnimplementedHealthchecksServer) testEmbeddedByValue()                      {}
+
+// UnsafeHealthchecksServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HealthchecksServer will
+// result in compilation errors.
+type UnsafeHealthchecksServer interface {
+	mustEmbedUnimplementedHealthchecksServer()
+}
+
+func RegisterHealthchecksServer(s grpc.ServiceRegistrar, srv HealthchecksServer) {
+	// If the following call panics, it indicates UnimplementedHealthchecksServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Healthchecks_ServiceDesc, srv)
+}
+
+func _Healthchecks_ListChecks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChecksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthchecksServer).ListChecks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Healthchecks_ListChecks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthchecksServer).ListChecks(ctx, req.(*ListChecksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Healthchecks_GetCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthchecksServer).GetCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Healthchecks_GetCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthchecksServer).GetCheck(ctx, req.(*GetCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Healthchecks_CreateCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthchecksServer).CreateCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Healthchecks_CreateCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthchecksServer).CreateCheck(ctx, req.(*CreateCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Healthchecks_UpdateCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthchecksServer).UpdateCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Healthchecks_UpdateCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthchecksServer).UpdateCheck(ctx, req.(*UpdateCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Healthchecks_DeleteCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthchecksServer).DeleteCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Healthchecks_DeleteCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthchecksServer).DeleteCheck(ctx, req.(*CheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Healthchecks_PauseCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthchecksServer).PauseCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Healthchecks_PauseCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthchecksServer).PauseCheck(ctx, req.(*CheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Healthchecks_ResumeCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthchecksServer).ResumeCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Healthchecks_ResumeCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthchecksServer).ResumeCheck(ctx, req.(*CheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Healthchecks_ListPings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthchecksServer).ListPings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Healthchecks_ListPings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthchecksServer).ListPings(ctx, req.(*CheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Healthchecks_ListFlips_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFlipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthchecksServer).ListFlips(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Healthchecks_ListFlips_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthchecksServer).ListFlips(ctx, req.(*ListFlipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Healthchecks_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthchecksServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Healthchecks_Ping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthchecksServer).Ping(ctx, req.(*PingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Healthchecks_ServiceDesc is the grpc.ServiceDesc for Healthchecks service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Healthchecks_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "healthchecks.v1.Healthchecks",
+	HandlerType: (*HealthchecksServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListChecks",
+			Handler:    _Healthchecks_ListChecks_Handler,
+		},
+		{
+			MethodName: "GetCheck",
+			Handler:    _Healthchecks_GetCheck_Handler,
+		},
+		{
+			MethodName: "CreateCheck",
+			Handler:    _Healthchecks_CreateCheck_Handler,
+		},
+		{
+			MethodName: "UpdateCheck",
+			Handler:    _Healthchecks_UpdateCheck_Handler,
+		},
+		{
+			MethodName: "DeleteCheck",
+			Handler:    _Healthchecks_DeleteCheck_Handler,
+		},
+		{
+			MethodName: "PauseCheck",
+			Handler:    _Healthchecks_PauseCheck_Handler,
+		},
+		{
+			MethodName: "ResumeCheck",
+			Handler:    _Healthchecks_ResumeCheck_Handler,
+		},
+		{
+			MethodName: "ListPings",
+			Handler:    _Healthchecks_ListPings_Handler,
+		},
+		{
+			MethodName: "ListFlips",
+			Handler:    _Healthchecks_ListFlips_Handler,
+		},
+		{
+			MethodName: "Ping",
+			Handler:    _Healthchecks_Ping_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "healthchecks.proto",
+}
@@ -0,0 +1,281 @@
+// Package healthchecksgrpc serves a healthchecksio.Client as the healthchecks.v1.Healthchecks
+// gRPC service, defined in healthchecksv1/healthchecks.proto. Services in any language can
+// then manage checks and send pings through one gateway holding the API key.
+//
+// The gateway authenticates as itself; put an interceptor in front of it to decide which
+// callers may use which methods.
+package healthchecksgrpc
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksgrpc/healthchecksv1"
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//go:generate protoc -I healthchecksv1 --go_out=healthchecksv1 --go_opt=paths=source_relative --go-grpc_out=healthchecksv1 --go-grpc_opt=paths=source_relative healthchecks.proto
+
+// ServerOptions configures a Server
+type ServerOptions struct {
+	// PingURL is where pings are sent, defaults to https://hc-ping.com
+	PingURL string
+
+	// PingKey is the project's ping key, needed to ping checks by slug
+	PingKey string
+}
+
+// Server implements healthchecksv1.HealthchecksServer, register it with
+// healthchecksv1.RegisterHealthchecksServer
+type Server struct {
+	healthchecksv1.UnimplementedHealthchecksServer
+
+	client healthchecksio.Client
+	opts   ServerOptions
+}
+
+var _ healthchecksv1.HealthchecksServer = (*Server)(nil)
+
+// NewServer creates a Server calling the API with client
+func NewServer(client healthchecksio.Client, opts ServerOptions) *Server {
+	if opts.PingURL == "" {
+		opts.PingURL = "https://hc-ping.com"
+	}
+	return &Server{client: client, opts: opts}
+}
+
+func (s *Server) ListChecks(ctx context.Context, req *healthchecksv1.ListChecksRequest) (*healthchecksv1.ListChecksResponse, error) {
+	list, err := s.client.GetChecks(ctx, healthchecksio.GetChecks{Slug: req.GetSlug(), Tags: req.GetTags()})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &healthchecksv1.ListChecksResponse{Checks: make([]*healthchecksv1.Check, 0, len(list.Checks))}
+	for _, check := range list.Checks {
+		resp.Checks = append(resp.Checks, toProtoCheck(check))
+	}
+	return resp, nil
+}
+
+func (s *Server) GetCheck(ctx context.Context, req *healthchecksv1.GetCheckRequest) (*healthchecksv1.Check, error) {
+	if req.GetCheck() == "" {
+		return nil, status.Error(codes.InvalidArgument, "check is required")
+	}
+	check, err := s.lookup(ctx, req.GetCheck())
+	if errors.Is(err, healthchecksio.ErrNotFound) {
+		// Read-only unique keys aren't slugs or UUIDs, but GetCheck understands them
+		check, err = s.client.GetCheck(ctx, req.GetCheck())
+	}
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toProtoCheck(*check), nil
+}
+
+func (s *Server) CreateCheck(ctx context.Context, req *healthchecksv1.CreateCheckRequest) (*healthchecksv1.Check, error) {
+	fields := req.GetCheck()
+	if fields == nil {
+		return nil, status.Error(codes.InvalidArgument, "check is required")
+	}
+	create := toCreateCheck(fields)
+	create.Unique = req.GetUnique()
+
+	check, err := s.client.CreateCheck(ctx, &create)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toProtoCheck(*check), nil
+}
+
+func (s *Server) UpdateCheck(ctx context.Context, req *healthchecksv1.UpdateCheckRequest) (*healthchecksv1.Check, error) {
+	id, err := s.resolve(ctx, req.GetCheck())
+	if err != nil {
+		return nil, err
+	}
+	update := toUpdateCheck(req.GetUpdate())
+
+	check, err := s.client.UpdateCheck(ctx, id, &update)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toProtoCheck(*check), nil
+}
+
+func (s *Server) DeleteCheck(ctx context.Context, req *healthchecksv1.CheckRequest) (*healthchecksv1.Check, error) {
+	return s.modify(ctx, req, s.client.DeleteCheck)
+}
+
+func (s *Server) PauseCheck(ctx context.Context, req *healthchecksv1.CheckRequest) (*healthchecksv1.Check, error) {
+	return s.modify(ctx, req, s.client.PauseCheck)
+}
+
+func (s *Server) ResumeCheck(ctx context.Context, req *healthchecksv1.CheckRequest) (*healthchecksv1.Check, error) {
+	return s.modify(ctx, req, s.client.ResumeCheck)
+}
+
+func (s *Server) modify(ctx context.Context, req *healthchecksv1.CheckRequest, fn func(context.Context, string, ...healthchecksio.CallOption) (*healthchecksio.Check, error)) (*healthchecksv1.Check, error) {
+	id, err := s.resolve(ctx, req.GetCheck())
+	if err != nil {
+		return nil, err
+	}
+	check, err := fn(ctx, id)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toProtoCheck(*check), nil
+}
+
+func (s *Server) ListPings(ctx context.Context, req *healthchecksv1.CheckRequest) (*healthchecksv1.ListPingsResponse, error) {
+	id, err := s.resolve(ctx, req.GetCheck())
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.client.GetPings(ctx, id)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &healthchecksv1.ListPingsResponse{Pings: make([]*healthchecksv1.Ping, 0, len(list.Pings))}
+	for _, ping := range list.Pings {
+		resp.Pings = append(resp.Pings, toProtoPing(ping))
+	}
+	return resp, nil
+}
+
+func (s *Server) ListFlips(ctx context.Context, req *healthchecksv1.ListFlipsRequest) (*healthchecksv1.ListFlipsResponse, error) {
+	id, err := s.resolve(ctx, req.GetCheck())
+	if err != nil {
+		return nil, err
+	}
+	var params healthchecksio.GetFlipsRequest
+	if req.GetStart() != nil {
+		params.Start = req.GetStart().AsTime().Unix()
+	}
+	if req.GetEnd() != nil {
+		params.End = req.GetEnd().AsTime().Unix()
+	}
+
+	list, err := s.client.GetFlips(ctx, id, params)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &healthchecksv1.ListFlipsResponse{Flips: make([]*healthchecksv1.Flip, 0, len(list.Flips))}
+	for _, flip := range list.Flips {
+		converted, err := toProtoFlip(flip)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "parsing flip: %v", err)
+		}
+		resp.Flips = append(resp.Flips, converted)
+	}
+	return resp, nil
+}
+
+func (s *Server) Ping(ctx context.Context, req *healthchecksv1.PingRequest) (*healthchecksv1.PingResponse, error) {
+	address, err := s.pingAddress(req.GetCheck())
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []healthchecksio.PingOption
+	switch req.GetKind() {
+	case healthchecksv1.PingRequest_KIND_START:
+		opts = append(opts, healthchecksio.WithStart())
+	case healthchecksv1.PingRequest_KIND_FAIL:
+		opts = append(opts, healthchecksio.WithFail())
+	case healthchecksv1.PingRequest_KIND_LOG:
+		opts = append(opts, healthchecksio.WithLog())
+	}
+	if _, err := s.client.Ping(ctx, address, string(req.GetBody()), opts...); err != nil {
+		return nil, toStatus(err)
+	}
+	return &healthchecksv1.PingResponse{}, nil
+}
+
+func (s *Server) pingAddress(check string) (string, error) {
+	if check == "" {
+		return "", status.Error(codes.InvalidArgument, "check is required")
+	}
+	base, err := url.Parse(s.opts.PingURL)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "parsing ping url: %v", err)
+	}
+	if _, err := uuid.Parse(check); err == nil {
+		return base.JoinPath(check).String(), nil
+	}
+	if s.opts.PingKey == "" {
+		return "", status.Error(codes.FailedPrecondition, "pinging by slug needs the gateway to have a ping key")
+	}
+	return base.JoinPath(s.opts.PingKey, check).String(), nil
+}
+
+// resolve returns the UUID of a check given its UUID or slug
+func (s *Server) resolve(ctx context.Context, identifier string) (string, error) {
+	if identifier == "" {
+		return "", status.Error(codes.InvalidArgument, "check is required")
+	}
+	if _, err := uuid.Parse(identifier); err == nil {
+		return identifier, nil
+	}
+	check, err := healthchecksio.GetChecksBySlugExact(ctx, s.client, identifier)
+	if err != nil {
+		return "", toStatus(err)
+	}
+	return check.UUID, nil
+}
+
+func (s *Server) lookup(ctx context.Context, identifier string) (*healthchecksio.Check, error) {
+	if _, err := uuid.Parse(identifier); err == nil {
+		return s.client.GetCheck(ctx, identifier)
+	}
+	return healthchecksio.GetChecksBySlugExact(ctx, s.client, identifier)
+}
+
+// toStatus converts client errors into gRPC statuses
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, healthchecksio.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	code := codes.Unavailable
+	switch apiStatus(err) {
+	case 0:
+	case 400, 422:
+		code = codes.InvalidArgument
+	case 401:
+		code = codes.Unauthenticated
+	case 403:
+		code = codes.PermissionDenied
+	case 404:
+		code = codes.NotFound
+	case 409:
+		code = codes.AlreadyExists
+	case 429:
+		code = codes.ResourceExhausted
+	default:
+		code = codes.Internal
+	}
+	return status.Error(code, err.Error())
+}
+
+// apiStatus finds the HTTP status in errors like "get check failed with 404: ...", zero without one
+func apiStatus(err error) int {
+	_, rest, found := strings.Cut(err.Error(), " failed with ")
+	if !found || len(rest) < 3 {
+		return 0
+	}
+	code, err := strconv.Atoi(rest[:3])
+	if err != nil {
+		return 0
+	}
+	return code
+}
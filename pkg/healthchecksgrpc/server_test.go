@@ -0,0 +1,127 @@
+package healthchecksgrpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksgrpc/healthchecksv1"
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const backupUUID = "6f1a4e38-9a2f-4c6b-9b5e-0c1d2e3f4a5b"
+
+func newTestClient(t *testing.T, api http.Handler) healthchecksv1.HealthchecksClient {
+	t.Helper()
+
+	srv := httptest.NewServer(api)
+	t.Cleanup(srv.Close)
+
+	client := healthchecksio.NewClient("key", healthchecksio.WithBaseURL(srv.URL), healthchecksio.WithRetryEngine(healthchecksio.NoRetries()))
+	server := grpc.NewServer()
+	healthchecksv1.RegisterHealthchecksServer(server, NewServer(client, ServerOptions{PingURL: srv.URL + "/ping", PingKey: "pk"}))
+
+	listener := bufconn.Listen(1 << 20)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return healthchecksv1.NewHealthchecksClient(conn)
+}
+
+const backupJSON = `{"uuid":"` + backupUUID + `","name":"Backup","slug":"backup","tags":"db prod","status":"up","timeout":86400,"last_ping":"2025-03-01T12:00:00+00:00","channels":"a, b"}`
+
+func TestServerChecks(t *testing.T) {
+	var paused string
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/checks/":
+			if r.URL.Query().Get("slug") == "missing" {
+				w.Write([]byte(`{"checks":[]}`))
+				return
+			}
+			w.Write([]byte(`{"checks":[` + backupJSON + `]}`))
+		case "/checks/" + backupUUID + "/pause":
+			paused = r.Method
+			w.Write([]byte(backupJSON))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	ctx := context.Background()
+
+	list, err := client.ListChecks(ctx, &healthchecksv1.ListChecksRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Checks, 1)
+
+	check := list.Checks[0]
+	require.Equal(t, backupUUID, check.Uuid)
+	require.Equal(t, []string{"db", "prod"}, check.Tags)
+	require.Equal(t, []string{"a", "b"}, check.Channels)
+	require.EqualValues(t, 86400, check.TimeoutSeconds)
+	require.Equal(t, int64(1740830400), check.LastPing.AsTime().Unix())
+
+	got, err := client.GetCheck(ctx, &healthchecksv1.GetCheckRequest{Check: "backup"})
+	require.NoError(t, err)
+	require.Equal(t, "Backup", got.Name)
+
+	_, err = client.PauseCheck(ctx, &healthchecksv1.CheckRequest{Check: "backup"})
+	require.NoError(t, err)
+	require.Equal(t, "POST", paused)
+
+	_, err = client.DeleteCheck(ctx, &healthchecksv1.CheckRequest{Check: "missing"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = client.GetCheck(ctx, &healthchecksv1.GetCheckRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServerPing(t *testing.T) {
+	var path, body string
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		bs, _ := io.ReadAll(r.Body)
+		body = string(bs)
+	}))
+	ctx := context.Background()
+
+	_, err := client.Ping(ctx, &healthchecksv1.PingRequest{Check: "backup", Kind: healthchecksv1.PingRequest_KIND_FAIL, Body: []byte("exit 1")})
+	require.NoError(t, err)
+	require.Equal(t, "/ping/pk/backup/fail", path)
+	require.Equal(t, "exit 1", body)
+
+	_, err = client.Ping(ctx, &healthchecksv1.PingRequest{Check: backupUUID})
+	require.NoError(t, err)
+	require.Equal(t, "/ping/"+backupUUID, path)
+}
+
+func TestToStatus(t *testing.T) {
+	cases := map[string]codes.Code{
+		"get check failed with 401: wrong api key":  codes.Unauthenticated,
+		"create check failed with 400: bad request": codes.InvalidArgument,
+		"get checks failed with 429: slow down":     codes.ResourceExhausted,
+		"get checks failed with 500: ":              codes.Internal,
+		"get checks: connection refused":            codes.Unavailable,
+	}
+	for msg, want := range cases {
+		require.Equal(t, want, status.Code(toStatus(errors.New(msg))), msg)
+	}
+	require.Equal(t, codes.NotFound, status.Code(toStatus(healthchecksio.ErrNotFound)))
+}
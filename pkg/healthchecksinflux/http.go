@@ -0,0 +1,54 @@
+package healthchecksinflux
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// HTTPWriter sends each Write as a batch of points to an InfluxDB write endpoint.
+//
+// URL is the full write address, for InfluxDB 2.x and 3.x e.g.
+// http://influxdb:8086/api/v2/write?org=ops&bucket=healthchecks&precision=ns and for 1.x
+// http://influxdb:8086/write?db=healthchecks
+type HTTPWriter struct {
+	URL string
+
+	// Token is sent as "Authorization: Token <token>" when set
+	Token string
+
+	// Client defaults to an http.Client with a 10 second timeout
+	Client *http.Client
+}
+
+var defaultHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+// Write POSTs p, which must be whole lines of line protocol
+func (h *HTTPWriter) Write(p []byte) (int, error) {
+	req, err := http.NewRequest("POST", h.URL, bytes.NewReader(p))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	if h.Token != "" {
+		req.Header.Set("Authorization", "Token "+h.Token)
+	}
+
+	client := h.Client
+	if client == nil {
+		client = defaultHTTPClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("influxdb write: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return 0, fmt.Errorf("influxdb write failed with %d: %s", resp.StatusCode, bytes.TrimSpace(msg))
+	}
+	return len(p), nil
+}
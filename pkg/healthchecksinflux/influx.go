@@ -0,0 +1,148 @@
+// Package healthchecksinflux writes check state as InfluxDB line protocol, either to any
+// io.Writer (a file tailed by Telegraf, stdout for an exec input) or to a write endpoint.
+package healthchecksinflux
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+)
+
+// Measurement is the name points are written under
+const Measurement = "healthchecks_check"
+
+// WriteChecks writes one point per check, timestamped at. Tags are check, uuid, slug and
+// status. Fields are:
+//
+//	up             1 when up, in its grace period or started, 0 when down, omitted otherwise
+//	n_pings        pings the check has received
+//	grace          grace period in seconds
+//	last_ping_age  seconds since the last ping, omitted before the first ping
+//	last_duration  seconds the last measured run took, omitted when unknown
+func WriteChecks(w io.Writer, checks []healthchecksio.Check, at time.Time) error {
+	var buf bytes.Buffer
+	for _, check := range checks {
+		appendPoint(&buf, check, at)
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func appendPoint(buf *bytes.Buffer, check healthchecksio.Check, at time.Time) {
+	buf.WriteString(Measurement)
+
+	// Tags are sorted by key, which is what InfluxDB stores them as
+	appendTag(buf, "check", check.Name)
+	appendTag(buf, "slug", check.Slug)
+	appendTag(buf, "status", check.Status)
+	appendTag(buf, "uuid", check.UUID)
+
+	fields := make([]string, 0, 5)
+	switch healthchecksio.CheckStatus(check.Status) {
+	case healthchecksio.StatusUp, healthchecksio.StatusGrace, healthchecksio.StatusStarted:
+		fields = append(fields, "up=1i")
+	case healthchecksio.StatusDown:
+		fields = append(fields, "up=0i")
+	}
+	fields = append(fields, "n_pings="+strconv.Itoa(check.NPings)+"i", "grace="+strconv.Itoa(check.Grace)+"i")
+	if last, pinged := check.LastPingTime(); pinged {
+		age := at.Sub(last).Seconds()
+		fields = append(fields, "last_ping_age="+strconv.FormatFloat(max(age, 0), 'f', -1, 64))
+	}
+	if check.LastDuration > 0 {
+		fields = append(fields, "last_duration="+strconv.Itoa(int(check.LastDuration))+"i")
+	}
+
+	buf.WriteByte(' ')
+	buf.WriteString(strings.Join(fields, ","))
+	buf.WriteByte(' ')
+	buf.WriteString(strconv.FormatInt(at.UnixNano(), 10))
+	buf.WriteByte('\n')
+}
+
+// tagEscaper escapes tag values. Newlines can't be escaped in line protocol so they're flattened.
+var tagEscaper = strings.NewReplacer(`,`, `\,`, `=`, `\=`, ` `, `\ `, "\n", `\ `, `\`, `\\`)
+
+func appendTag(buf *bytes.Buffer, key, value string) {
+	if value == "" {
+		return // empty tag values aren't allowed
+	}
+	buf.WriteByte(',')
+	buf.WriteString(key)
+	buf.WriteByte('=')
+	buf.WriteString(tagEscaper.Replace(value))
+}
+
+// EmitterOptions configures an Emitter
+type EmitterOptions struct {
+	// Interval between writes, defaults to one minute
+	Interval time.Duration
+
+	// Filter limits which checks are written
+	Filter healthchecksio.GetChecks
+
+	// OnError is called when fetching or writing fails. Run keeps going afterwards.
+	OnError func(error)
+}
+
+// Emitter periodically writes the state of checks to an io.Writer
+type Emitter struct {
+	client healthchecksio.CheckReader
+	w      io.Writer
+	opts   EmitterOptions
+	now    func() time.Time
+}
+
+// NewEmitter creates an Emitter writing checks matching opts.Filter to w
+func NewEmitter(client healthchecksio.CheckReader, w io.Writer, opts EmitterOptions) *Emitter {
+	if opts.Interval <= 0 {
+		opts.Interval = time.Minute
+	}
+	return &Emitter{
+		client: client,
+		w:      w,
+		opts:   opts,
+		now:    time.Now,
+	}
+}
+
+// Emit fetches checks and writes them once
+func (e *Emitter) Emit(ctx context.Context) error {
+	list, err := e.client.GetChecks(ctx, e.opts.Filter)
+	if err != nil {
+		return fmt.Errorf("emit: %w", err)
+	}
+	if err := WriteChecks(e.w, list.Checks, e.now()); err != nil {
+		return fmt.Errorf("emit: %w", err)
+	}
+	return nil
+}
+
+// Run emits every Interval until ctx is cancelled
+func (e *Emitter) Run(ctx context.Context) error {
+	ticker := time.NewTicker(e.opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := e.Emit(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if e.opts.OnError != nil {
+				e.opts.OnError(err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
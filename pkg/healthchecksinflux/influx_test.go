@@ -0,0 +1,68 @@
+package healthchecksinflux
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteChecks(t *testing.T) {
+	at := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+	checks := []healthchecksio.Check{
+		{UUID: "1", Name: "nightly backup, db=main", Slug: "backup", Status: "up", NPings: 12, Grace: 60, LastPing: "2025-03-01T11:59:30+00:00", LastDuration: 42},
+		{UUID: "2", Name: "reports", Status: "down", Grace: 300},
+		{UUID: "3", Name: "new", Status: "new"},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteChecks(&buf, checks, at))
+	require.Equal(t, `healthchecks_check,check=nightly\ backup\,\ db\=main,slug=backup,status=up,uuid=1 up=1i,n_pings=12i,grace=60i,last_ping_age=30,last_duration=42i 1740830400000000000
+healthchecks_check,check=reports,status=down,uuid=2 up=0i,n_pings=0i,grace=300i 1740830400000000000
+healthchecks_check,check=new,status=new,uuid=3 n_pings=0i,grace=0i 1740830400000000000
+`, buf.String())
+}
+
+func TestEmitterHTTP(t *testing.T) {
+	var body, auth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v2/write", r.URL.Path)
+		require.Equal(t, "healthchecks", r.URL.Query().Get("bucket"))
+		auth = r.Header.Get("Authorization")
+		bs, _ := io.ReadAll(r.Body)
+		body = string(bs)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"checks":[{"uuid":"1","name":"backup","status":"up"}]}`))
+	}))
+	defer api.Close()
+	client := healthchecksio.NewClient("key", healthchecksio.WithBaseURL(api.URL))
+
+	writer := &HTTPWriter{URL: srv.URL + "/api/v2/write?org=ops&bucket=healthchecks", Token: "secret"}
+	emitter := NewEmitter(client, writer, EmitterOptions{})
+	emitter.now = func() time.Time { return time.Unix(10, 0) }
+
+	require.NoError(t, emitter.Emit(context.Background()))
+	require.Equal(t, "Token secret", auth)
+	require.Equal(t, "healthchecks_check,check=backup,status=up,uuid=1 up=1i,n_pings=0i,grace=0i 10000000000\n", body)
+}
+
+func TestHTTPWriterError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"code":"unauthorized"}`, http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+
+	_, err := (&HTTPWriter{URL: srv.URL}).Write([]byte("m v=1i\n"))
+	require.ErrorContains(t, err, "influxdb write failed with 401")
+}
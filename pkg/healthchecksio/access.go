@@ -0,0 +1,51 @@
+package healthchecksio
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Access declares which operations a client may perform
+type Access string
+
+const (
+	// AccessFull allows every call, the default
+	AccessFull Access = "full"
+
+	// AccessReadOnly allows reading checks, pings, flips and channels
+	AccessReadOnly Access = "read-only"
+
+	// AccessPingOnly allows sending pings
+	AccessPingOnly Access = "ping-only"
+)
+
+// ErrNotAllowed is returned by calls the client's Access doesn't allow
+var ErrNotAllowed = errors.New("healthchecksio: operation not allowed")
+
+// WithAccess limits the client to the operations access allows. Other calls fail with
+// ErrNotAllowed before anything is sent, whatever the permissions of the API key, so
+// automation which only reads or pings can't change checks by mistake. WithSelfMonitor
+// both reads and pings, so it needs AccessFull.
+func WithAccess(access Access) ClientOption {
+	return func(c *client) {
+		c.access = access
+	}
+}
+
+// checkAccess reports an error wrapping ErrNotAllowed when the client's Access doesn't allow req
+func (c *client) checkAccess(req *http.Request, endpoint string) error {
+	var allowed bool
+	switch c.access {
+	case "", AccessFull:
+		return nil
+	case AccessReadOnly:
+		allowed = cachedEndpoints[endpoint] || (endpoint == "do" && req.Method == http.MethodGet)
+	case AccessPingOnly:
+		allowed = endpoint == "ping"
+	}
+	if allowed {
+		return nil
+	}
+	return fmt.Errorf("%w: %s with %s access", ErrNotAllowed, endpoint, c.access)
+}
@@ -0,0 +1,84 @@
+package healthchecksio_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithAccess(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Write([]byte(`{"checks":[],"uuid":"1"}`))
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	write := func(client healthchecksio.Client) error {
+		_, err := client.DeleteCheck(ctx, "1")
+		return err
+	}
+	read := func(client healthchecksio.Client) error {
+		_, err := client.GetChecks(ctx, healthchecksio.GetChecks{})
+		return err
+	}
+	ping := func(client healthchecksio.Client) error {
+		_, err := client.Ping(ctx, srv.URL+"/1", "")
+		return err
+	}
+
+	cases := []struct {
+		access            healthchecksio.Access
+		write, read, ping bool
+		doGet, doPost     bool
+	}{
+		{access: healthchecksio.AccessFull, write: true, read: true, ping: true, doGet: true, doPost: true},
+		{access: healthchecksio.AccessReadOnly, read: true, doGet: true},
+		{access: healthchecksio.AccessPingOnly, ping: true},
+		{access: "bogus"},
+	}
+	for _, tc := range cases {
+		t.Run(string(tc.access), func(t *testing.T) {
+			requests.Store(0)
+			client := healthchecksio.NewClient("read-write-key",
+				healthchecksio.WithBaseURL(srv.URL),
+				healthchecksio.WithAccess(tc.access),
+			)
+			check := func(allowed bool, err error) {
+				t.Helper()
+				if allowed {
+					require.NoError(t, err)
+				} else {
+					require.ErrorIs(t, err, healthchecksio.ErrNotAllowed)
+				}
+			}
+			check(tc.write, write(client))
+			check(tc.read, read(client))
+			check(tc.ping, ping(client))
+			check(tc.doGet, client.Do(ctx, "GET", "/checks/", nil, nil))
+			check(tc.doPost, client.Do(ctx, "POST", "/checks/", map[string]string{"name": "backup"}, nil))
+
+			// Rejected calls never reach the network
+			var allowed int32
+			for _, ok := range []bool{tc.write, tc.read, tc.ping, tc.doGet, tc.doPost} {
+				if ok {
+					allowed++
+				}
+			}
+			require.Equal(t, allowed, requests.Load())
+		})
+	}
+}
+
+func TestWithAccessError(t *testing.T) {
+	client := healthchecksio.NewClient("key", healthchecksio.WithAccess(healthchecksio.AccessReadOnly))
+	_, err := client.DeleteCheck(context.Background(), "1")
+	require.EqualError(t, err, "delete check: healthchecksio: operation not allowed: delete-check with read-only access")
+}
@@ -0,0 +1,118 @@
+package healthchecksio
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+)
+
+// PingStats summarizes a check's ping history
+type PingStats struct {
+	// Successes and Failures count completion pings
+	Successes int
+	Failures  int
+
+	// SuccessRate is Successes over all completion pings, zero when there are none
+	SuccessRate float64
+
+	// CurrentFailureStreak is the number of failures since the most recent success
+	CurrentFailureStreak int
+
+	// LongestFailureStreak is the most consecutive failures seen
+	LongestFailureStreak int
+
+	// Intervals summarizes the time between consecutive completion pings
+	Intervals DurationStats
+
+	// Durations summarizes measured run times (start to success/fail ping)
+	Durations DurationStats
+}
+
+// DurationStats summarizes a set of durations
+type DurationStats struct {
+	Count int
+	Min   time.Duration
+	P50   time.Duration
+	P95   time.Duration
+	Max   time.Duration
+}
+
+// AnalyzePings computes statistics from pings in any order, as returned by GetPings.
+// Only success and fail pings are counted, their durations come from the API (measured from
+// the start ping before them), so start, log and ignored pings are skipped.
+func AnalyzePings(pings []Ping) PingStats {
+	sorted := slices.SortedFunc(slices.Values(pings), func(a, b Ping) int {
+		return cmp.Or(a.Date.Compare(b.Date), cmp.Compare(a.N, b.N))
+	})
+
+	var stats PingStats
+	var intervals, durations []time.Duration
+	var previous time.Time
+	for _, ping := range sorted {
+		switch ping.Type {
+		case "success":
+			stats.Successes++
+			stats.CurrentFailureStreak = 0
+		case "fail":
+			stats.Failures++
+			stats.CurrentFailureStreak++
+			stats.LongestFailureStreak = max(stats.LongestFailureStreak, stats.CurrentFailureStreak)
+		default:
+			continue
+		}
+
+		if !previous.IsZero() {
+			intervals = append(intervals, ping.Date.Sub(previous))
+		}
+		previous = ping.Date
+
+		if ping.Duration > 0 {
+			durations = append(durations, pingDuration(ping))
+		}
+	}
+
+	if total := stats.Successes + stats.Failures; total > 0 {
+		stats.SuccessRate = float64(stats.Successes) / float64(total)
+	}
+	stats.Intervals = summarizeDurations(intervals)
+	stats.Durations = summarizeDurations(durations)
+
+	return stats
+}
+
+// AnalyzeCheckPings fetches the pings of a check by UUID or unique_key and analyzes them
+func AnalyzeCheckPings(ctx context.Context, client CheckReader, identifier string) (*PingStats, error) {
+	pings, err := client.GetPings(ctx, identifier)
+	if err != nil {
+		return nil, fmt.Errorf("analyze pings: %w", err)
+	}
+	stats := AnalyzePings(pings.Pings)
+	return &stats, nil
+}
+
+func pingDuration(ping Ping) time.Duration {
+	return time.Duration(math.Round(ping.Duration * float64(time.Second)))
+}
+
+func summarizeDurations(values []time.Duration) DurationStats {
+	if len(values) == 0 {
+		return DurationStats{}
+	}
+	sorted := slices.Sorted(slices.Values(values))
+	return DurationStats{
+		Count: len(sorted),
+		Min:   sorted[0],
+		P50:   percentile(sorted, 0.50),
+		P95:   percentile(sorted, 0.95),
+		Max:   sorted[len(sorted)-1],
+	}
+}
+
+// percentile returns the nearest-rank percentile p (0-1) of sorted values
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	return sorted[max(rank, 0)]
+}
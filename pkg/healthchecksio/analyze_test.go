@@ -0,0 +1,55 @@
+package healthchecksio_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzePings(t *testing.T) {
+	start := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	ping := func(n int, typ string, at time.Duration, duration float64) healthchecksio.Ping {
+		return healthchecksio.Ping{N: n, Type: typ, Date: start.Add(at), Duration: duration}
+	}
+
+	// Newest first, like the API returns them
+	pings := []healthchecksio.Ping{
+		ping(9, "fail", 5*time.Hour, 0),
+		ping(8, "success", 4*time.Hour, 30),
+		ping(7, "start", 4*time.Hour-30*time.Second, 0),
+		ping(6, "fail", 3*time.Hour, 0),
+		ping(5, "fail", 2*time.Hour, 0),
+		ping(4, "log", 90*time.Minute, 0),
+		ping(3, "success", time.Hour, 10),
+		ping(2, "success", 0, 20),
+		ping(1, "success", -2*time.Hour, 600),
+	}
+
+	stats := healthchecksio.AnalyzePings(pings)
+	require.Equal(t, 4, stats.Successes)
+	require.Equal(t, 3, stats.Failures)
+	require.InDelta(t, 4.0/7.0, stats.SuccessRate, 0.0001)
+	require.Equal(t, 1, stats.CurrentFailureStreak)
+	require.Equal(t, 2, stats.LongestFailureStreak)
+
+	require.Equal(t, healthchecksio.DurationStats{
+		Count: 6,
+		Min:   time.Hour,
+		P50:   time.Hour,
+		P95:   2 * time.Hour,
+		Max:   2 * time.Hour,
+	}, stats.Intervals)
+
+	require.Equal(t, healthchecksio.DurationStats{
+		Count: 4,
+		Min:   10 * time.Second,
+		P50:   20 * time.Second,
+		P95:   10 * time.Minute,
+		Max:   10 * time.Minute,
+	}, stats.Durations)
+
+	require.Equal(t, healthchecksio.PingStats{}, healthchecksio.AnalyzePings(nil))
+}
@@ -0,0 +1,83 @@
+package healthchecksio
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// AnomalyOptions configures DetectDurationAnomalies
+type AnomalyOptions struct {
+	// Window is how many preceding runs the rolling median covers, defaults to 10
+	Window int
+
+	// Factor is how many times longer (or shorter) than the median a run must be to be flagged, defaults to 3
+	Factor float64
+
+	// MinSamples is how many preceding runs are needed before flagging, defaults to 3
+	MinSamples int
+}
+
+// DurationAnomaly is a run whose duration deviated from the rolling median
+type DurationAnomaly struct {
+	Ping     Ping
+	Duration time.Duration
+	Median   time.Duration
+
+	// Ratio is Duration divided by Median
+	Ratio float64
+}
+
+// DetectDurationAnomalies flags runs whose measured duration is more than opts.Factor times
+// longer or shorter than the median of the runs before it, whether the run succeeded or not.
+// Pings may be in any order, anomalies are returned oldest first.
+func DetectDurationAnomalies(pings []Ping, opts AnomalyOptions) []DurationAnomaly {
+	if opts.Window <= 0 {
+		opts.Window = 10
+	}
+	if opts.Factor <= 1 {
+		opts.Factor = 3
+	}
+	if opts.MinSamples <= 0 {
+		opts.MinSamples = 3
+	}
+
+	var runs []Ping
+	for _, ping := range pings {
+		if ping.Duration > 0 && (ping.Type == "success" || ping.Type == "fail") {
+			runs = append(runs, ping)
+		}
+	}
+	slices.SortFunc(runs, func(a, b Ping) int {
+		return cmp.Or(a.Date.Compare(b.Date), cmp.Compare(a.N, b.N))
+	})
+
+	var anomalies []DurationAnomaly
+	for i, run := range runs {
+		previous := runs[max(0, i-opts.Window):i]
+		if len(previous) < opts.MinSamples {
+			continue
+		}
+
+		durations := make([]time.Duration, len(previous))
+		for j := range previous {
+			durations[j] = pingDuration(previous[j])
+		}
+		median := percentile(slices.Sorted(slices.Values(durations)), 0.5)
+		if median <= 0 {
+			continue
+		}
+
+		duration := pingDuration(run)
+		ratio := float64(duration) / float64(median)
+		if ratio >= opts.Factor || ratio <= 1/opts.Factor {
+			anomalies = append(anomalies, DurationAnomaly{
+				Ping:     run,
+				Duration: duration,
+				Median:   median,
+				Ratio:    ratio,
+			})
+		}
+	}
+	return anomalies
+}
@@ -0,0 +1,45 @@
+package healthchecksio_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectDurationAnomalies(t *testing.T) {
+	start := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	var pings []healthchecksio.Ping
+	for i, seconds := range []float64{100, 110, 400, 95, 105, 20, 120, 480} {
+		typ := "success"
+		if i == 7 {
+			typ = "fail"
+		}
+		pings = append(pings, healthchecksio.Ping{
+			N:        i + 1,
+			Type:     typ,
+			Date:     start.Add(time.Duration(i) * 24 * time.Hour),
+			Duration: seconds,
+		})
+	}
+	// Pings without durations are ignored
+	pings = append(pings, healthchecksio.Ping{N: 9, Type: "start", Date: start.Add(200 * time.Hour)})
+
+	anomalies := healthchecksio.DetectDurationAnomalies(pings, healthchecksio.AnomalyOptions{
+		Window: 4,
+	})
+	require.Len(t, anomalies, 2)
+
+	// The 400s run has too few earlier runs to be judged
+	require.Equal(t, 6, anomalies[0].Ping.N)
+	require.Equal(t, 20*time.Second, anomalies[0].Duration)
+	require.Equal(t, 105*time.Second, anomalies[0].Median)
+
+	require.Equal(t, 8, anomalies[1].Ping.N)
+	require.Equal(t, 8*time.Minute, anomalies[1].Duration)
+	require.Equal(t, 95*time.Second, anomalies[1].Median)
+	require.InDelta(t, 5.05, anomalies[1].Ratio, 0.01)
+}
@@ -0,0 +1,105 @@
+// Code generated by apigen from testdata/apispec.json. DO NOT EDIT.
+
+package healthchecksio
+
+// documentedFields are the JSON fields of each object documented at https://healthchecks.io/docs/api/,
+// keyed by the Go type modeling it
+var documentedFields = map[string]map[string]string{
+	"Channel": {
+		"id":   "string",
+		"kind": "string",
+		"name": "string",
+	},
+	"Check": {
+		"badge_url":           "string",
+		"channels":            "string",
+		"desc":                "string",
+		"failure_kw":          "string",
+		"filter_body":         "boolean",
+		"filter_default_fail": "boolean",
+		"filter_http_body":    "boolean",
+		"filter_subject":      "boolean",
+		"grace":               "integer",
+		"last_duration":       "integer",
+		"last_ping":           "timestamp|null",
+		"manual_resume":       "boolean",
+		"methods":             "string",
+		"n_pings":             "integer",
+		"name":                "string",
+		"next_ping":           "timestamp|null",
+		"pause_url":           "string",
+		"ping_url":            "string",
+		"resume_url":          "string",
+		"schedule":            "string",
+		"slug":                "string",
+		"start_kw":            "string",
+		"started":             "boolean",
+		"status":              "string",
+		"subject":             "string",
+		"subject_fail":        "string",
+		"success_kw":          "string",
+		"tags":                "string",
+		"timeout":             "integer",
+		"tz":                  "string",
+		"unique_key":          "string",
+		"update_url":          "string",
+		"uuid":                "string",
+	},
+	"CreateCheck": {
+		"channels":            "string",
+		"desc":                "string",
+		"failure_kw":          "string",
+		"filter_body":         "boolean",
+		"filter_default_fail": "boolean",
+		"filter_http_body":    "boolean",
+		"filter_subject":      "boolean",
+		"grace":               "integer",
+		"manual_resume":       "boolean",
+		"methods":             "string",
+		"name":                "string",
+		"schedule":            "string",
+		"slug":                "string",
+		"start_kw":            "string",
+		"success_kw":          "string",
+		"tags":                "string",
+		"timeout":             "integer",
+		"tz":                  "string",
+		"unique":              "array",
+	},
+	"Flip": {
+		"timestamp": "timestamp",
+		"up":        "integer",
+	},
+	"Ping": {
+		"body_url":    "string|null",
+		"date":        "timestamp",
+		"duration":    "number",
+		"method":      "string",
+		"n":           "integer",
+		"remote_addr": "string",
+		"rid":         "string|null",
+		"scheme":      "string",
+		"type":        "string",
+		"ua":          "string",
+	},
+	"UpdateCheck": {
+		"channels":            "string",
+		"desc":                "string",
+		"failure_kw":          "string",
+		"filter_body":         "boolean",
+		"filter_default_fail": "boolean",
+		"filter_http_body":    "boolean",
+		"filter_subject":      "boolean",
+		"grace":               "integer",
+		"manual_resume":       "boolean",
+		"methods":             "string",
+		"name":                "string",
+		"schedule":            "string",
+		"slug":                "string",
+		"start_kw":            "string",
+		"success_kw":          "string",
+		"tags":                "string",
+		"timeout":             "integer",
+		"tz":                  "string",
+	},
+}
@@ -0,0 +1,165 @@
+package healthchecksio
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// modeledTypes maps the objects in testdata/apispec.json to the types decoding them
+var modeledTypes = map[string]reflect.Type{
+	"Check":       reflect.TypeFor[checkFields](),
+	"CreateCheck": reflect.TypeFor[CreateCheck](),
+	"UpdateCheck": reflect.TypeFor[UpdateCheck](),
+	"Ping":        reflect.TypeFor[Ping](),
+	"Flip":        reflect.TypeFor[Flip](),
+	"Channel":     reflect.TypeFor[Channel](),
+}
+
+func TestDocumentedFieldsModeled(t *testing.T) {
+	for object, fields := range documentedFields {
+		typ, exists := modeledTypes[object]
+		require.True(t, exists, "%s isn't modeled", object)
+
+		tags := make(map[string]reflect.Type)
+		for i := 0; i < typ.NumField(); i++ {
+			name, _, _ := strings.Cut(typ.Field(i).Tag.Get("json"), ",")
+			tags[name] = typ.Field(i).Type
+		}
+		for field, kind := range fields {
+			goType, exists := tags[field]
+			if !assertFieldType(t, goType, kind) {
+				t.Errorf("%s.%s is documented as %s, modeled as %v (exists=%v)", object, field, kind, goType, exists)
+			}
+		}
+	}
+}
+
+func assertFieldType(t *testing.T, goType reflect.Type, kind string) bool {
+	t.Helper()
+
+	if goType == nil {
+		return false
+	}
+	if goType.Kind() == reflect.Interface {
+		return true
+	}
+
+	kind, nullable := strings.CutSuffix(kind, "|null")
+	if goType.Kind() == reflect.Pointer {
+		if !nullable {
+			return false
+		}
+		goType = goType.Elem()
+	}
+	switch kind {
+	case "string":
+		return goType.Kind() == reflect.String
+	case "integer":
+		return goType.Kind() == reflect.Int || goType.Kind() == reflect.Int64
+	case "number":
+		return goType.Kind() == reflect.Float64
+	case "boolean":
+		return goType.Kind() == reflect.Bool
+	case "timestamp":
+		return goType == reflect.TypeFor[time.Time]() || goType.Kind() == reflect.String
+	case "array":
+		return goType.Kind() == reflect.Slice
+	}
+	return false
+}
+
+func TestFixturesRoundTrip(t *testing.T) {
+	cases := map[string]func() any{
+		"checks.json":          func() any { return &CheckListResponse{} },
+		"checks_readonly.json": func() any { return &CheckListResponse{} },
+		"pings.json":           func() any { return &PingListResponse{} },
+		"flips.json":           func() any { return &[]Flip{} },
+		"channels.json":        func() any { return &ChannelListResponse{} },
+	}
+	for name, newValue := range cases {
+		t.Run(name, func(t *testing.T) {
+			fixture, err := os.ReadFile(filepath.Join("testdata", "fixtures", name))
+			require.NoError(t, err)
+
+			dec := json.NewDecoder(strings.NewReader(string(fixture)))
+			dec.DisallowUnknownFields()
+			v := newValue()
+			require.NoError(t, dec.Decode(v))
+			require.Empty(t, unknownFields(v), "unknown fields")
+
+			encoded, err := json.Marshal(v)
+			require.NoError(t, err)
+			require.Equal(t, emptyNulls(normalizeJSON(t, fixture), stringNulls), normalizeJSON(t, encoded))
+		})
+	}
+}
+
+func TestFlipListResponseArray(t *testing.T) {
+	fixture, err := os.ReadFile(filepath.Join("testdata", "fixtures", "flips.json"))
+	require.NoError(t, err)
+
+	var list FlipListResponse
+	require.NoError(t, json.Unmarshal(fixture, &list))
+	require.Len(t, list.Flips, 2)
+	require.Equal(t, 1, list.Flips[0].Up)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"flips":[{"timestamp":"2020-03-23T10:18:23+00:00","up":0}]}`), &list))
+	require.Len(t, list.Flips, 1)
+}
+
+// stringNulls are nullable fields kept as plain strings, so null reads as ""
+var stringNulls = map[string]bool{"rid": true}
+
+// emptyNulls replaces null values of keys with ""
+func emptyNulls(v any, keys map[string]bool) any {
+	switch vv := v.(type) {
+	case map[string]any:
+		for key, value := range vv {
+			if value == nil && keys[key] {
+				vv[key] = ""
+				continue
+			}
+			vv[key] = emptyNulls(value, keys)
+		}
+	case []any:
+		for i, value := range vv {
+			vv[i] = emptyNulls(value, keys)
+		}
+	}
+	return v
+}
+
+// normalizeJSON decodes bs and rewrites timestamps to UTC, since time.Time encodes
+// "+00:00" offsets as "Z"
+func normalizeJSON(t *testing.T, bs []byte) any {
+	t.Helper()
+
+	var v any
+	require.NoError(t, json.Unmarshal(bs, &v))
+	return normalizeTimes(v)
+}
+
+func normalizeTimes(v any) any {
+	switch vv := v.(type) {
+	case map[string]any:
+		for key, value := range vv {
+			vv[key] = normalizeTimes(value)
+		}
+	case []any:
+		for i, value := range vv {
+			vv[i] = normalizeTimes(value)
+		}
+	case string:
+		if at, err := time.Parse(time.RFC3339Nano, vv); err == nil {
+			return at.UTC().Format(time.RFC3339Nano)
+		}
+	}
+	return v
+}
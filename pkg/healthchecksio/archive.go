@@ -0,0 +1,112 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// archivedTag prefixes the tag recording the day a check was archived by ArchiveCheck
+const archivedTag = "archived:"
+
+// archivedDate is the layout of the date in an archived tag
+const archivedDate = "2006-01-02"
+
+// ArchiveCheck soft-deletes a check: it's tagged archived:<YYYY-MM-DD> and paused, so it can
+// be brought back with RestoreCheck until PurgeArchived deletes it. The API's delete can't
+// be undone. Any PauseCheckFor token is dropped so ResumeExpired leaves the check alone.
+func ArchiveCheck(ctx context.Context, client ManagementClient, uuid string) (*Check, error) {
+	today := time.Now().UTC().Format(archivedDate)
+	_, err := editTags(ctx, client, uuid, func(current []string) []string {
+		current = slices.DeleteFunc(current, func(tag string) bool {
+			return isArchivedTag(tag) || isPausedUntilTag(tag)
+		})
+		return append(current, archivedTag+today)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("archive check: %w", err)
+	}
+
+	check, err := client.PauseCheck(ctx, uuid)
+	if err != nil {
+		return nil, fmt.Errorf("archive check: %w", err)
+	}
+	return check, nil
+}
+
+// ArchivedAt returns the day a check was archived by ArchiveCheck
+func ArchivedAt(check Check) (time.Time, bool) {
+	for _, tag := range strings.Fields(check.Tags) {
+		if !isArchivedTag(tag) {
+			continue
+		}
+		at, err := time.Parse(archivedDate, strings.TrimPrefix(tag, archivedTag))
+		if err == nil {
+			return at, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func isArchivedTag(tag string) bool {
+	return strings.HasPrefix(tag, archivedTag)
+}
+
+// RestoreCheck undoes ArchiveCheck, removing the archived tag and resuming the check.
+// The tag is removed first so a failed resume can't leave an active check for PurgeArchived.
+func RestoreCheck(ctx context.Context, client ManagementClient, uuid string) (*Check, error) {
+	_, err := editTags(ctx, client, uuid, func(current []string) []string {
+		return slices.DeleteFunc(current, isArchivedTag)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("restore check: %w", err)
+	}
+
+	check, err := client.ResumeCheck(ctx, uuid)
+	if err != nil {
+		return nil, fmt.Errorf("restore check: %w", err)
+	}
+	return check, nil
+}
+
+// PurgeOptions configures PurgeArchived
+type PurgeOptions struct {
+	// Filter limits which checks are considered
+	Filter GetChecks
+
+	// OlderThan is how long ago a check must have been archived to be deleted
+	OlderThan time.Duration
+
+	// ConfirmDeletes confirms deleting archived checks for WithDeleteProtection's RequireConfirmation
+	ConfirmDeletes bool
+}
+
+// PurgeArchived deletes checks which were archived more than opts.OlderThan ago. Checks which
+// aren't paused anymore (a ping resumes a check) are kept, since something still uses them.
+// The deleted checks are returned, all of them are attempted and failures are joined.
+func PurgeArchived(ctx context.Context, client ManagementClient, opts PurgeOptions) ([]Check, error) {
+	checks, err := client.GetChecks(ctx, opts.Filter)
+	if err != nil {
+		return nil, fmt.Errorf("purge archived: %w", err)
+	}
+
+	cutoff := time.Now().Add(-opts.OlderThan)
+
+	var purged []Check
+	var errs []error
+	for _, check := range checks.Checks {
+		archived, exists := ArchivedAt(check)
+		if !exists || !archived.Before(cutoff) || CheckStatus(check.Status) != StatusPaused {
+			continue
+		}
+		if _, err := client.DeleteCheck(ctx, check.UUID, confirmDelete(opts.ConfirmDeletes, check)...); err != nil {
+			errs = append(errs, fmt.Errorf("purge %s: %w", check.Slug, err))
+			continue
+		}
+		purged = append(purged, check)
+	}
+	return purged, errors.Join(errs...)
+}
@@ -0,0 +1,77 @@
+package healthchecksio
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveAndRestore(t *testing.T) {
+	client := &memoryClient{checks: []Check{
+		{UUID: "1", Slug: "backup", Tags: "db paused-until:100", Status: "up"},
+	}}
+	ctx := context.Background()
+
+	check, err := ArchiveCheck(ctx, client, "1")
+	require.NoError(t, err)
+	require.Equal(t, "paused", check.Status)
+
+	today := time.Now().UTC().Format(archivedDate)
+	require.Equal(t, "db archived:"+today, client.checks[0].Tags)
+	at, exists := ArchivedAt(client.checks[0])
+	require.True(t, exists)
+	require.Equal(t, today, at.Format(archivedDate))
+
+	check, err = RestoreCheck(ctx, client, "1")
+	require.NoError(t, err)
+	require.Equal(t, "new", check.Status)
+	require.Equal(t, "db", client.checks[0].Tags)
+	require.Equal(t, []string{"update backup", "pause backup", "update backup", "resume backup"}, client.calls)
+
+	_, err = ArchiveCheck(ctx, client, "missing")
+	require.ErrorContains(t, err, "404")
+}
+
+func TestPurgeArchived(t *testing.T) {
+	old := archivedTag + time.Now().AddDate(0, 0, -40).Format(archivedDate)
+	recent := archivedTag + time.Now().AddDate(0, 0, -2).Format(archivedDate)
+
+	client := &memoryClient{checks: []Check{
+		{UUID: "1", Slug: "old", Tags: "db " + old, Status: "paused"},
+		{UUID: "2", Slug: "recent", Tags: recent, Status: "paused"},
+		{UUID: "3", Slug: "pinged", Tags: old, Status: "up"},
+		{UUID: "4", Slug: "paused", Status: "paused"},
+	}}
+
+	purged, err := PurgeArchived(context.Background(), client, PurgeOptions{OlderThan: 30 * 24 * time.Hour})
+	require.NoError(t, err)
+	require.Len(t, purged, 1)
+	require.Equal(t, "old", purged[0].Slug)
+	require.Equal(t, []string{"delete old"}, client.calls)
+	require.Len(t, client.checks, 3)
+}
+
+func TestArchiveCheck_Sync(t *testing.T) {
+	client := &memoryClient{checks: []Check{
+		{UUID: "1", Slug: "backup", Tags: "db", Status: "up"},
+	}}
+	ctx := context.Background()
+
+	_, err := ArchiveCheck(ctx, client, "1")
+	require.NoError(t, err)
+	today := time.Now().UTC().Format(archivedDate)
+
+	// A manifest setting the tags keeps the archived tag, so PurgeArchived still finds the check
+	desired := []CreateCheck{{Slug: "backup", Tags: "db nightly"}}
+	_, err = Sync(ctx, client, desired, SyncOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "db nightly archived:"+today, client.checks[0].Tags)
+	_, exists := ArchivedAt(client.checks[0])
+	require.True(t, exists)
+
+	plan, err := PlanSync(ctx, client, desired, SyncOptions{})
+	require.NoError(t, err)
+	require.Empty(t, plan.Pending())
+}
@@ -0,0 +1,173 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// attemptTracker follows the attempts of one call so its span can explain retries
+type attemptTracker struct {
+	attempts   int
+	firstStart time.Time
+	lastStart  time.Time
+	lastEnd    time.Time
+}
+
+type attemptTrackerKey struct{}
+
+// trackAttempts attaches an attemptTracker to req when its span is recording,
+// the returned func sets the total attempts on the span once the call is done.
+func trackAttempts(req *http.Request) (*http.Request, func()) {
+	span := spanFromContext(req.Context())
+	if !span.IsRecording() {
+		return req, func() {}
+	}
+
+	tracker := attemptTrackerFrom(req.Context())
+	if tracker == nil {
+		tracker = &attemptTracker{}
+		req = req.WithContext(context.WithValue(req.Context(), attemptTrackerKey{}, tracker))
+	}
+	return req, func() {
+		span.SetAttributes(attr("http.attempts", tracker.attempts))
+	}
+}
+
+func attemptTrackerFrom(ctx context.Context) *attemptTracker {
+	tracker, _ := ctx.Value(attemptTrackerKey{}).(*attemptTracker)
+	return tracker
+}
+
+// beforeAttempt runs before each attempt of req is sent, attempt is zero for the first try
+func (c *client) beforeAttempt(req *http.Request, attempt int) {
+	c.stats.countRetries(attempt)
+
+	tracker := attemptTrackerFrom(req.Context())
+	if tracker == nil {
+		return
+	}
+	tracker.attempts = attempt + 1
+	tracker.lastStart = time.Now()
+	if attempt == 0 {
+		tracker.firstStart = tracker.lastStart
+	}
+	if attempt > 0 {
+		spanFromContext(req.Context()).AddEvent("retry",
+			attr("http.attempt", tracker.attempts),
+			attr("http.retry_wait", time.Since(tracker.lastEnd).String()),
+		)
+	}
+}
+
+// recordAttempt adds the outcome of an attempt to the call's span
+func recordAttempt(ctx context.Context, resp *http.Response, err error) {
+	tracker := attemptTrackerFrom(ctx)
+	if tracker == nil {
+		return
+	}
+	tracker.lastEnd = time.Now()
+
+	attrs := []Attribute{
+		attr("http.attempt", tracker.attempts),
+	}
+	if err != nil {
+		attrs = append(attrs, attr("error", err.Error()))
+	}
+	if resp != nil {
+		attrs = append(attrs, attr("http.status_code", resp.StatusCode))
+	}
+	spanFromContext(ctx).AddEvent("attempt", attrs...)
+}
+
+// AttemptError wraps the error of a failed call with how many times its request was sent.
+// Attempts is zero when the call failed before anything was sent, e.g. when ctx was already done.
+type AttemptError struct {
+	Attempts int
+	Err      error
+
+	// RequestID is the X-Request-Id the call was sent with, empty for pings and calls which were never sent
+	RequestID string
+}
+
+// callError wraps err of a call sent with requestID in an AttemptError
+func (t *attemptTracker) callError(requestID string, err error) error {
+	if t.attempts == 0 {
+		requestID = ""
+	}
+	return &AttemptError{Attempts: t.attempts, RequestID: requestID, Err: err}
+}
+
+func (e *AttemptError) Error() string {
+	var details []string
+	if e.Attempts > 1 {
+		details = append(details, fmt.Sprintf("after %d attempts", e.Attempts))
+	}
+	if e.RequestID != "" {
+		details = append(details, "request "+e.RequestID)
+	}
+	if len(details) == 0 {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%v (%s)", e.Err, strings.Join(details, ", "))
+}
+
+func (e *AttemptError) Unwrap() error {
+	return e.Err
+}
+
+// Attempts returns how many times the request of the failed call which returned err was sent
+func Attempts(err error) (int, bool) {
+	var attemptErr *AttemptError
+	if errors.As(err, &attemptErr) {
+		return attemptErr.Attempts, true
+	}
+	return 0, false
+}
+
+// RequestID returns the X-Request-Id of the failed call which returned err, to quote to support
+func RequestID(err error) (string, bool) {
+	var attemptErr *AttemptError
+	if errors.As(err, &attemptErr) && attemptErr.RequestID != "" {
+		return attemptErr.RequestID, true
+	}
+	return "", false
+}
+
+// PingResult describes how a ping was delivered, so agents can log delivery latency
+// and notice degrading connectivity to the ping endpoint
+type PingResult struct {
+	// URL is the address the ping was sent to, including its kind (e.g. /fail)
+	URL string
+
+	// SentAt is when the first attempt was sent
+	SentAt time.Time
+
+	// Latency is the round trip time of the last attempt, until its response headers arrived
+	Latency time.Duration
+
+	// Attempts is how many times the ping was sent, more than one after retries and
+	// zero when it never left the client (e.g. WithDryRun or a closed client)
+	Attempts int
+
+	// StatusCode is the last response's status, zero when no response was received
+	StatusCode int
+}
+
+func (t *attemptTracker) pingResult(address string, resp *http.Response) *PingResult {
+	result := &PingResult{
+		URL:      address,
+		SentAt:   t.firstStart,
+		Attempts: t.attempts,
+	}
+	if t.attempts > 0 && !t.lastEnd.IsZero() {
+		result.Latency = t.lastEnd.Sub(t.lastStart)
+	}
+	if resp != nil {
+		result.StatusCode = resp.StatusCode
+	}
+	return result
+}
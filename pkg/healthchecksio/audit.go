@@ -0,0 +1,135 @@
+package healthchecksio
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+)
+
+// AuditEvent records one successful mutating call
+type AuditEvent struct {
+	Time time.Time `json:"time"`
+
+	// Actor identifies who made the change, as given to WithAudit
+	Actor string `json:"actor,omitempty"`
+
+	// Action is the operation performed: create-check, update-check, delete-check, pause-check or resume-check
+	Action string `json:"action"`
+
+	CheckUUID string `json:"check_uuid"`
+
+	// Before is the check prior to the change, nil for creates or when it couldn't be read
+	Before *Check `json:"before,omitempty"`
+
+	// After is the check returned by the API, nil for deletes
+	After *Check `json:"after,omitempty"`
+
+	// Changed lists the JSON names of fields which differ between Before and After, nil for deletes
+	Changed []string `json:"changed,omitempty"`
+}
+
+// WithAudit calls record after every successful CreateCheck, UpdateCheck, DeleteCheck, PauseCheck
+// and ResumeCheck (including their Raw variants). The check is read before it's changed so events
+// can include a before/after diff, which costs one extra request per update, delete, pause and resume.
+func WithAudit(actor string, record func(AuditEvent)) ClientOption {
+	return func(c *client) {
+		c.audit = &auditor{
+			actor:  actor,
+			record: record,
+		}
+	}
+}
+
+// AuditJSONLines returns an audit recorder which writes each event to w as a line of JSON
+func AuditJSONLines(w io.Writer) func(AuditEvent) {
+	var mu sync.Mutex
+	return func(event AuditEvent) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		json.NewEncoder(w).Encode(event)
+	}
+}
+
+type auditor struct {
+	actor  string
+	record func(AuditEvent)
+}
+
+// volatileCheckFields change on their own and are left out of audit and snapshot diffs
+var volatileCheckFields = []string{"last_ping", "next_ping", "n_pings", "last_duration", "started"}
+
+func (c *client) sendAudited(req *http.Request, endpoint string) (*http.Response, error) {
+	event := AuditEvent{
+		Actor:  c.audit.actor,
+		Action: endpoint,
+	}
+	if endpoint != "create-check" {
+		event.CheckUUID = checkIDFromPath(req.URL.Path)
+		if before, err := c.GetCheck(req.Context(), event.CheckUUID, WithAPIKey(req.Header.Get("X-Api-Key")), WithoutCache()); err == nil {
+			before.Raw, before.Unknown = nil, nil
+			event.Before = before
+		}
+	}
+
+	resp, err := c.roundTrip(req, endpoint)
+	if err != nil || resp.StatusCode >= 300 {
+		return resp, err
+	}
+
+	// Buffer the body so it can be decoded here and by the caller
+	bs, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(bs))
+
+	event.Time = time.Now()
+	if endpoint != "delete-check" {
+		var after Check
+		if json.Unmarshal(bs, (*checkFields)(&after)) == nil {
+			event.After = &after
+			if event.CheckUUID == "" {
+				event.CheckUUID = after.UUID
+			}
+		}
+	}
+	event.Changed = auditChanges(event.Before, event.After)
+
+	c.audit.record(event)
+
+	return resp, nil
+}
+
+// auditChanges returns the JSON names of fields which differ between before and after
+func auditChanges(before, after *Check) []string {
+	if after == nil {
+		return nil
+	}
+	fieldsOf := func(check *Check) map[string]json.RawMessage {
+		out := make(map[string]json.RawMessage)
+		if check != nil {
+			bs, _ := json.Marshal(checkFields(*check))
+			json.Unmarshal(bs, &out)
+		}
+		for _, name := range volatileCheckFields {
+			delete(out, name)
+		}
+		return out
+	}
+	b, a := fieldsOf(before), fieldsOf(after)
+
+	var changed []string
+	for _, name := range slices.Sorted(maps.Keys(knownCheckFields)) {
+		if !bytes.Equal(b[name], a[name]) {
+			changed = append(changed, name)
+		}
+	}
+	return changed
+}
@@ -0,0 +1,83 @@
+package healthchecksio_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithAudit(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /checks/":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"uuid":"new","name":"Reports","status":"new"}`))
+		case "GET /checks/abc":
+			w.Write([]byte(`{"uuid":"abc","name":"Backup","grace":60,"n_pings":4,"status":"up"}`))
+		case "POST /checks/abc":
+			w.Write([]byte(`{"uuid":"abc","name":"Backup","grace":300,"n_pings":5,"status":"up"}`))
+		case "DELETE /checks/abc":
+			w.Write([]byte(`{"uuid":"abc","name":"Backup","grace":60,"status":"up"}`))
+		case "POST /checks/abc/pause":
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"error":"read-only key"}`))
+		}
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	client := healthchecksio.NewClient("key",
+		healthchecksio.WithBaseURL(srv.URL),
+		healthchecksio.WithAudit("deployer", healthchecksio.AuditJSONLines(&buf)),
+	)
+	ctx := context.Background()
+
+	created, err := client.CreateCheck(ctx, &healthchecksio.CreateCheck{Name: "Reports"})
+	require.NoError(t, err)
+	require.Equal(t, "new", created.UUID)
+
+	updated, err := client.UpdateCheck(ctx, "abc", &healthchecksio.UpdateCheck{Grace: 300})
+	require.NoError(t, err)
+	require.Equal(t, 300, updated.Grace)
+
+	_, err = client.DeleteCheck(ctx, "abc")
+	require.NoError(t, err)
+
+	// Failed calls aren't recorded
+	_, err = client.PauseCheck(ctx, "abc")
+	require.Error(t, err)
+
+	var events []healthchecksio.AuditEvent
+	dec := json.NewDecoder(&buf)
+	for dec.More() {
+		var event healthchecksio.AuditEvent
+		require.NoError(t, dec.Decode(&event))
+		events = append(events, event)
+	}
+	require.Len(t, events, 3)
+
+	require.Equal(t, "create-check", events[0].Action)
+	require.Equal(t, "deployer", events[0].Actor)
+	require.Equal(t, "new", events[0].CheckUUID)
+	require.Nil(t, events[0].Before)
+	require.Contains(t, events[0].Changed, "name")
+
+	require.Equal(t, "update-check", events[1].Action)
+	require.Equal(t, "abc", events[1].CheckUUID)
+	require.Equal(t, 60, events[1].Before.Grace)
+	require.Equal(t, 300, events[1].After.Grace)
+	require.Equal(t, []string{"grace"}, events[1].Changed)
+	require.False(t, events[1].Time.IsZero())
+
+	require.Equal(t, "delete-check", events[2].Action)
+	require.Equal(t, "Backup", events[2].Before.Name)
+	require.Nil(t, events[2].After)
+	require.Empty(t, events[2].Changed)
+}
@@ -0,0 +1,109 @@
+package healthchecksio
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+// PingRequest is a single ping sent by a PingBatcher
+type PingRequest struct {
+	URL     string
+	Body    string
+	Options []PingOption
+}
+
+// PingBatcherOptions configures a PingBatcher
+type PingBatcherOptions struct {
+	// Window is the period pings are spread evenly across. Zero sends them as fast as Concurrency allows.
+	Window time.Duration
+
+	// Jitter is the maximum random delay added to each ping's scheduled time
+	Jitter time.Duration
+
+	// Concurrency is the maximum number of in-flight pings, defaults to 4
+	Concurrency int
+}
+
+// PingBatcher sends bursts of pings paced across a window so fleets of checks
+// don't all hit the ping endpoint at the same instant.
+type PingBatcher struct {
+	client Pinger
+	opts   PingBatcherOptions
+}
+
+// NewPingBatcher creates a PingBatcher which sends pings with client
+func NewPingBatcher(client Pinger, opts PingBatcherOptions) *PingBatcher {
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 4
+	}
+	return &PingBatcher{
+		client: client,
+		opts:   opts,
+	}
+}
+
+// Send delivers every ping and blocks until they have all completed.
+// The returned errors are in the same order as pings, nil for successful pings.
+func (b *PingBatcher) Send(ctx context.Context, pings []PingRequest) []error {
+	errs := make([]error, len(pings))
+	if len(pings) == 0 {
+		return errs
+	}
+
+	start := time.Now()
+	spacing := b.opts.Window / time.Duration(len(pings))
+	sem := make(chan struct{}, b.opts.Concurrency)
+
+	var wg sync.WaitGroup
+	for i := range pings {
+		delay := time.Duration(i) * spacing
+		if b.opts.Jitter > 0 {
+			delay += rand.N(b.opts.Jitter)
+		}
+		err := sleepUntil(ctx, start.Add(delay))
+		if err == nil {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				err = ctx.Err()
+			}
+		}
+		if err != nil {
+			for j := i; j < len(pings); j++ {
+				errs[j] = err
+			}
+			break
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			_, errs[i] = b.client.Ping(ctx, pings[i].URL, pings[i].Body, pings[i].Options...)
+		}(i)
+	}
+	wg.Wait()
+
+	return errs
+}
+
+func sleepUntil(ctx context.Context, at time.Time) error {
+	wait := time.Until(at)
+	if wait <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,91 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type mockPingClient struct {
+	Client
+
+	mu       sync.Mutex
+	pings    []string
+	inFlight atomic.Int32
+	maxSeen  atomic.Int32
+	delay    time.Duration
+	fail     map[string]error
+}
+
+func (m *mockPingClient) Ping(ctx context.Context, pingURL, body string, opts ...PingOption) (*PingResult, error) {
+	n := m.inFlight.Add(1)
+	defer m.inFlight.Add(-1)
+	for {
+		max := m.maxSeen.Load()
+		if n <= max || m.maxSeen.CompareAndSwap(max, n) {
+			break
+		}
+	}
+	time.Sleep(m.delay)
+
+	m.mu.Lock()
+	m.pings = append(m.pings, pingURL)
+	m.mu.Unlock()
+
+	return &PingResult{URL: pingURL}, m.fail[pingURL]
+}
+
+func TestPingBatcher_Send(t *testing.T) {
+	mock := &mockPingClient{
+		delay: 10 * time.Millisecond,
+		fail: map[string]error{
+			"https://hc-ping.com/3": errors.New("bad ping"),
+		},
+	}
+	batcher := NewPingBatcher(mock, PingBatcherOptions{
+		Window:      50 * time.Millisecond,
+		Jitter:      time.Millisecond,
+		Concurrency: 2,
+	})
+
+	var pings []PingRequest
+	for i := range 10 {
+		pings = append(pings, PingRequest{URL: fmt.Sprintf("https://hc-ping.com/%d", i)})
+	}
+
+	start := time.Now()
+	errs := batcher.Send(context.Background(), pings)
+	require.GreaterOrEqual(t, time.Since(start), 45*time.Millisecond)
+
+	require.Len(t, errs, 10)
+	for i, err := range errs {
+		if i == 3 {
+			require.ErrorContains(t, err, "bad ping")
+		} else {
+			require.NoError(t, err)
+		}
+	}
+	require.Len(t, mock.pings, 10)
+	require.LessOrEqual(t, mock.maxSeen.Load(), int32(2))
+}
+
+func TestPingBatcher_Cancelled(t *testing.T) {
+	mock := &mockPingClient{}
+	batcher := NewPingBatcher(mock, PingBatcherOptions{
+		Window: time.Hour,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	errs := batcher.Send(ctx, []PingRequest{{URL: "a"}, {URL: "b"}, {URL: "c"}})
+	require.NoError(t, errs[0])
+	require.ErrorIs(t, errs[1], context.DeadlineExceeded)
+	require.ErrorIs(t, errs[2], context.DeadlineExceeded)
+}
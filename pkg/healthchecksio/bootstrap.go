@@ -0,0 +1,59 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Bootstrapper creates checks and pings them
+type Bootstrapper interface {
+	ManagementClient
+	Pinger
+}
+
+// BootstrapOptions configures BootstrapCheck
+type BootstrapOptions struct {
+	// Body is sent with the first ping, defaults to "check created"
+	Body string
+
+	// Wait blocks until the API reports the check as up, see WaitForStatus
+	Wait bool
+
+	// WaitOptions configures polling when Wait is set
+	WaitOptions WaitOptions
+}
+
+// BootstrapCheck creates a check and sends it a success ping, so it doesn't sit in the "new"
+// state and alert as never pinged before its job first runs. An existing check returned
+// because of create.Unique is only pinged while it's new, a real status is never overwritten.
+// When the ping or wait fails the created check is returned with the error, don't create it again.
+func BootstrapCheck(ctx context.Context, client Bootstrapper, create *CreateCheck, opts BootstrapOptions) (*Check, error) {
+	if opts.Body == "" {
+		opts.Body = "check created"
+	}
+
+	check, err := client.CreateCheck(ctx, create)
+	if err != nil {
+		return nil, fmt.Errorf("bootstrap check: %w", err)
+	}
+	if CheckStatus(check.Status) != StatusNew {
+		return check, nil
+	}
+	if check.PingURL == "" {
+		return check, errors.New("bootstrap check: the API returned no ping URL")
+	}
+
+	if _, err := client.Ping(ctx, check.PingURL, opts.Body); err != nil {
+		return check, fmt.Errorf("bootstrap check: %s: %w", check.Slug, err)
+	}
+	if !opts.Wait {
+		return check, nil
+	}
+
+	up, err := WaitForStatus(ctx, client, check.UUID, StatusUp, opts.WaitOptions)
+	if err != nil {
+		return check, fmt.Errorf("bootstrap check: %s: %w", check.Slug, err)
+	}
+	return up, nil
+}
@@ -0,0 +1,84 @@
+package healthchecksio_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+// bootstrapServer is a check API where pinging the check marks it up
+func bootstrapServer(t *testing.T, status string) (*httptest.Server, *[]string) {
+	t.Helper()
+
+	var mu sync.Mutex
+	var requests []string
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/checks/":
+			if status == "new" {
+				w.WriteHeader(http.StatusCreated)
+			}
+			w.Write([]byte(`{"uuid":"abc","slug":"backup","status":"` + status + `","ping_url":"` + srv.URL + `/ping/abc"}`))
+		case r.URL.Path == "/ping/abc":
+			require.Equal(t, "check created", string(body))
+			status = "up"
+			w.Write([]byte("OK"))
+		case r.URL.Path == "/checks/abc":
+			w.Write([]byte(`{"uuid":"abc","slug":"backup","status":"` + status + `"}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+func TestBootstrapCheck(t *testing.T) {
+	srv, requests := bootstrapServer(t, "new")
+
+	client := healthchecksio.NewClient("key", healthchecksio.WithBaseURL(srv.URL))
+	check, err := healthchecksio.BootstrapCheck(context.Background(), client, &healthchecksio.CreateCheck{Slug: "backup"}, healthchecksio.BootstrapOptions{
+		Wait:        true,
+		WaitOptions: healthchecksio.WaitOptions{Interval: 5 * time.Millisecond},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "up", check.Status)
+	require.Equal(t, []string{"POST /checks/", "POST /ping/abc", "GET /checks/abc"}, *requests)
+}
+
+func TestBootstrapCheck_NoWait(t *testing.T) {
+	srv, requests := bootstrapServer(t, "new")
+
+	client := healthchecksio.NewClient("key", healthchecksio.WithBaseURL(srv.URL))
+	check, err := healthchecksio.BootstrapCheck(context.Background(), client, &healthchecksio.CreateCheck{Slug: "backup"}, healthchecksio.BootstrapOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "abc", check.UUID)
+	require.Equal(t, []string{"POST /checks/", "POST /ping/abc"}, *requests)
+}
+
+func TestBootstrapCheck_Existing(t *testing.T) {
+	// A check matched by unique which is already down isn't pinged up
+	srv, requests := bootstrapServer(t, "down")
+
+	client := healthchecksio.NewClient("key", healthchecksio.WithBaseURL(srv.URL))
+	check, err := healthchecksio.BootstrapCheck(context.Background(), client, &healthchecksio.CreateCheck{
+		Slug:   "backup",
+		Unique: []string{"slug"},
+	}, healthchecksio.BootstrapOptions{Wait: true})
+	require.NoError(t, err)
+	require.Equal(t, "down", check.Status)
+	require.Equal(t, []string{"POST /checks/"}, *requests)
+}
@@ -0,0 +1,126 @@
+package healthchecksio
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// maxDurationPrefix starts the description line holding a check's duration budget
+const maxDurationPrefix = "Max duration: "
+
+// MaxDurationOf returns the duration budget recorded on check as a "Max duration: 45m" line
+// of its description, false when there's none or it can't be parsed
+func MaxDurationOf(check Check) (time.Duration, bool) {
+	for line := range strings.Lines(check.Desc) {
+		value, found := strings.CutPrefix(strings.TrimSpace(line), maxDurationPrefix)
+		if !found {
+			continue
+		}
+		d, err := time.ParseDuration(strings.TrimSpace(value))
+		return d, err == nil && d > 0
+	}
+	return 0, false
+}
+
+// ApplyMaxDuration returns check with its duration budget recorded in the description,
+// replacing any budget it already declared. A zero budget removes it.
+func ApplyMaxDuration(check CreateCheck, budget time.Duration) CreateCheck {
+	var lines []string
+	for line := range strings.Lines(check.Description) {
+		if !strings.HasPrefix(strings.TrimSpace(line), maxDurationPrefix) {
+			lines = append(lines, strings.TrimRight(line, "\n"))
+		}
+	}
+	if budget > 0 {
+		lines = append(lines, maxDurationPrefix+budget.String())
+	}
+	check.Description = strings.TrimLeft(strings.Join(lines, "\n"), "\n")
+	return check
+}
+
+// BudgetOptions configures CheckBudgets
+type BudgetOptions struct {
+	// Filter limits which checks are compared with their budget
+	Filter GetChecks
+
+	// Budgets are max durations by check slug or UUID, replacing the budgets in descriptions
+	Budgets map[string]time.Duration
+
+	// Threshold is the fraction of the budget a run may take before it's reported, e.g. 0.8
+	// to hear about jobs approaching their budget. Defaults to 1.
+	Threshold float64
+
+	// Pings also compares the 95th percentile of the durations in each budgeted check's
+	// ping history, which costs a GetPings call per check
+	Pings bool
+}
+
+// BudgetBreach is a check whose runs take longer than its budget allows
+type BudgetBreach struct {
+	Check  Check         `json:"check"`
+	Budget time.Duration `json:"budget"`
+
+	// Last is the duration of the check's last measured run
+	Last time.Duration `json:"last"`
+
+	// P95 is the 95th percentile of the durations in the ping history, with BudgetOptions.Pings
+	P95 time.Duration `json:"p95,omitempty"`
+
+	// Ratio is the longer of Last and P95 over Budget
+	Ratio float64 `json:"ratio"`
+}
+
+// CheckBudgets compares the run times of checks matching opts.Filter with their duration
+// budgets, reporting jobs which still succeed but are dangerously slow. Breaches are ordered
+// by Ratio, worst first. Checks without a budget are skipped, errors fetching pings are joined.
+func CheckBudgets(ctx context.Context, client CheckReader, opts BudgetOptions) ([]BudgetBreach, error) {
+	if opts.Threshold <= 0 {
+		opts.Threshold = 1
+	}
+
+	checks, err := client.GetChecks(ctx, opts.Filter)
+	if err != nil {
+		return nil, fmt.Errorf("check budgets: %w", err)
+	}
+
+	var breaches []BudgetBreach
+	var errs []error
+	for _, check := range checks.Checks {
+		budget, exists := opts.budget(check)
+		if !exists {
+			continue
+		}
+		breach := BudgetBreach{Check: check, Budget: budget, Last: check.LastDuration.Duration()}
+
+		if opts.Pings {
+			stats, err := AnalyzeCheckPings(ctx, client, check.UUID)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("check budgets: %s: %w", check.Slug, err))
+			} else {
+				breach.P95 = stats.Durations.P95
+			}
+		}
+
+		breach.Ratio = max(breach.Last, breach.P95).Seconds() / budget.Seconds()
+		if breach.Ratio > opts.Threshold {
+			breaches = append(breaches, breach)
+		}
+	}
+	slices.SortStableFunc(breaches, func(a, b BudgetBreach) int { return cmp.Compare(b.Ratio, a.Ratio) })
+	return breaches, errors.Join(errs...)
+}
+
+// budget returns the check's budget from Budgets, or its description
+func (o BudgetOptions) budget(check Check) (time.Duration, bool) {
+	for _, key := range []string{check.Slug, check.UUID} {
+		if d, exists := o.Budgets[key]; exists && key != "" {
+			return d, d > 0
+		}
+	}
+	return MaxDurationOf(check)
+}
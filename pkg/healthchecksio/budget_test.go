@@ -0,0 +1,72 @@
+package healthchecksio
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxDuration(t *testing.T) {
+	create := ApplyMaxDuration(CreateCheck{Description: "Nightly backup\nMax duration: 10m\nRunbook: https://wiki/backup"}, 45*time.Minute)
+	require.Equal(t, "Nightly backup\nRunbook: https://wiki/backup\nMax duration: 45m0s", create.Description)
+
+	budget, ok := MaxDurationOf(Check{Desc: create.Description})
+	require.True(t, ok)
+	require.Equal(t, 45*time.Minute, budget)
+
+	// Ownership and budgets share the description
+	require.Equal(t, "https://wiki/backup", OwnershipOf(Check{Desc: create.Description}).Runbook)
+
+	require.Equal(t, "Nightly backup\nRunbook: https://wiki/backup", ApplyMaxDuration(create, 0).Description)
+
+	_, ok = MaxDurationOf(Check{Desc: "Max duration: soon"})
+	require.False(t, ok)
+	_, ok = MaxDurationOf(Check{Desc: "no budget"})
+	require.False(t, ok)
+}
+
+func TestCheckBudgets(t *testing.T) {
+	now := time.Now()
+	client := &memoryClient{
+		checks: []Check{
+			{UUID: "1", Slug: "backup", Desc: "Max duration: 1h", LastDuration: 4500},
+			{UUID: "2", Slug: "report", Desc: "Max duration: 10m", LastDuration: 360},
+			{UUID: "3", Slug: "cleanup", LastDuration: 9000},
+			{UUID: "4", Slug: "etl", Desc: "Max duration: 1h", LastDuration: 60},
+		},
+		pings: map[string][]Ping{
+			"2": {
+				{Type: "success", Date: now.Add(-2 * time.Hour), Duration: 300},
+				{Type: "success", Date: now.Add(-time.Hour), Duration: 700},
+			},
+		},
+	}
+	ctx := context.Background()
+
+	breaches, err := CheckBudgets(ctx, client, BudgetOptions{})
+	require.NoError(t, err)
+	require.Len(t, breaches, 1)
+	require.Equal(t, "backup", breaches[0].Check.Slug)
+	require.Equal(t, time.Hour, breaches[0].Budget)
+	require.Equal(t, 75*time.Minute, breaches[0].Last)
+	require.InDelta(t, 1.25, breaches[0].Ratio, 1e-9)
+
+	// Local budgets replace descriptions, the ping history catches slow runs before the last one
+	breaches, err = CheckBudgets(ctx, client, BudgetOptions{
+		Budgets: map[string]time.Duration{"backup": 2 * time.Hour, "3": 3 * time.Hour},
+		Pings:   true,
+	})
+	require.NoError(t, err)
+	require.Len(t, breaches, 1)
+	require.Equal(t, "report", breaches[0].Check.Slug)
+	require.Equal(t, 700*time.Second, breaches[0].P95)
+
+	// A threshold reports jobs approaching their budget
+	breaches, err = CheckBudgets(ctx, client, BudgetOptions{Threshold: 0.5})
+	require.NoError(t, err)
+	require.Len(t, breaches, 2)
+	require.Equal(t, "backup", breaches[0].Check.Slug)
+	require.Equal(t, "report", breaches[1].Check.Slug)
+}
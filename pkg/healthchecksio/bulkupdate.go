@@ -0,0 +1,145 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// BulkUpdateOptions configures BulkUpdate
+type BulkUpdateOptions struct {
+	// Rate is the most updates sent per second, defaults to 5
+	Rate float64
+
+	// BatchSize is how many updates are in flight at once, defaults to 10
+	BatchSize int
+
+	// Progress is called after each check is attempted, from the goroutine which updated it
+	Progress func(BulkProgress)
+}
+
+// BulkProgress reports on a running BulkUpdate
+type BulkProgress struct {
+	Check Check
+	Err   error
+
+	Done   int
+	Failed int
+	Total  int
+}
+
+// BulkFailure is a check BulkUpdate couldn't update
+type BulkFailure struct {
+	Check Check
+	Err   error
+}
+
+// BulkResult summarizes a BulkUpdate
+type BulkResult struct {
+	Updated []Check
+	Skipped []Check
+	Failed  []BulkFailure
+
+	// Remaining are the checks which weren't attempted because ctx was done
+	Remaining []Check
+}
+
+// Pending returns the failed and remaining checks, pass them to BulkUpdate to resume
+func (r *BulkResult) Pending() []Check {
+	var out []Check
+	for _, failure := range r.Failed {
+		out = append(out, failure.Check)
+	}
+	return append(out, r.Remaining...)
+}
+
+// Err joins the per-check failures, or returns nil when every check was updated
+func (r *BulkResult) Err() error {
+	var errs []error
+	for _, failure := range r.Failed {
+		errs = append(errs, fmt.Errorf("update %s: %w", failure.Check.Name, failure.Err))
+	}
+	if len(r.Remaining) > 0 {
+		errs = append(errs, fmt.Errorf("bulk update: %d checks not attempted", len(r.Remaining)))
+	}
+	return errors.Join(errs...)
+}
+
+// BulkUpdate applies the update built by fn to every check, sending at most opts.Rate
+// updates per second in batches of opts.BatchSize. fn returning a nil update skips the check
+// and returning an error records it as failed. Every check is attempted until ctx is done;
+// the returned error is BulkResult.Err and BulkResult.Pending lists what to retry.
+func BulkUpdate(ctx context.Context, client CheckWriter, checks []Check, fn func(Check) (*UpdateCheck, error), opts BulkUpdateOptions) (*BulkResult, error) {
+	if opts.Rate <= 0 {
+		opts.Rate = 5
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 10
+	}
+	interval := time.Duration(float64(time.Second) / opts.Rate)
+
+	var (
+		mu     sync.Mutex
+		result = &BulkResult{}
+		done   int
+		next   = time.Now()
+	)
+	finish := func(check Check, updated *Check, skipped bool, err error) {
+		mu.Lock()
+		switch {
+		case err != nil:
+			result.Failed = append(result.Failed, BulkFailure{Check: check, Err: err})
+		case skipped:
+			result.Skipped = append(result.Skipped, check)
+		default:
+			result.Updated = append(result.Updated, *updated)
+		}
+		done++
+		progress := BulkProgress{Check: check, Err: err, Done: done, Failed: len(result.Failed), Total: len(checks)}
+		mu.Unlock()
+
+		if opts.Progress != nil {
+			opts.Progress(progress)
+		}
+	}
+
+	for start := 0; start < len(checks); start += opts.BatchSize {
+		if ctx.Err() != nil {
+			result.Remaining = append(result.Remaining, checks[start:]...)
+			break
+		}
+		batch := checks[start:min(start+opts.BatchSize, len(checks))]
+
+		var wg sync.WaitGroup
+		for _, check := range batch {
+			update, err := fn(check)
+			if err != nil || update == nil {
+				finish(check, nil, update == nil, err)
+				continue
+			}
+
+			// Space out the start of each update to stay under the rate
+			at := next
+			if now := time.Now(); now.After(at) {
+				at = now
+			}
+			next = at.Add(interval)
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				if err := sleepUntil(ctx, at); err != nil {
+					finish(check, nil, false, err)
+					return
+				}
+				updated, err := client.UpdateCheck(ctx, check.UUID, update)
+				finish(check, updated, false, err)
+			}()
+		}
+		wg.Wait()
+	}
+	return result, result.Err()
+}
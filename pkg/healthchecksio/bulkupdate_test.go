@@ -0,0 +1,87 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkUpdate(t *testing.T) {
+	client := &memoryClient{
+		checks: []Check{
+			{UUID: "1", Name: "backup", Slug: "backup", Grace: 60},
+			{UUID: "2", Name: "report", Slug: "report", Grace: 60},
+			{UUID: "3", Name: "cleanup", Slug: "cleanup", Grace: 300},
+			{UUID: "4", Name: "sync", Slug: "sync", Grace: 60},
+		},
+	}
+	checks := append(append([]Check(nil), client.checks...), Check{UUID: "missing", Name: "gone"})
+
+	var mu sync.Mutex
+	var progress []BulkProgress
+	grace := func(check Check) (*UpdateCheck, error) {
+		switch {
+		case check.Grace == 300:
+			return nil, nil
+		case check.Name == "sync":
+			return nil, errors.New("not allowed")
+		}
+		return &UpdateCheck{Grace: 300}, nil
+	}
+
+	started := time.Now()
+	result, err := BulkUpdate(context.Background(), client, checks, grace, BulkUpdateOptions{
+		Rate:      20,
+		BatchSize: 2,
+		Progress: func(p BulkProgress) {
+			mu.Lock()
+			progress = append(progress, p)
+			mu.Unlock()
+		},
+	})
+	require.ErrorContains(t, err, "update sync: not allowed")
+	require.ErrorContains(t, err, "update gone: update check failed with 404")
+
+	// Three updates were sent, spaced out by the rate
+	require.GreaterOrEqual(t, time.Since(started), 100*time.Millisecond)
+	require.Len(t, result.Updated, 2)
+	require.Equal(t, 300, client.checks[0].Grace)
+	require.Equal(t, 300, client.checks[1].Grace)
+	require.Equal(t, []Check{checks[2]}, result.Skipped)
+	require.Len(t, result.Failed, 2)
+	require.Empty(t, result.Remaining)
+
+	require.Len(t, progress, 5)
+	require.Equal(t, 5, progress[4].Done)
+	require.Equal(t, 2, progress[4].Failed)
+	require.Equal(t, 5, progress[4].Total)
+
+	// Resuming only retries what failed
+	client.calls = nil
+	result, err = BulkUpdate(context.Background(), client, result.Pending(), func(Check) (*UpdateCheck, error) {
+		return &UpdateCheck{Grace: 300}, nil
+	}, BulkUpdateOptions{Rate: 1000})
+	require.ErrorContains(t, err, "update gone")
+	require.Equal(t, []string{"update sync"}, client.calls)
+	require.Len(t, result.Updated, 1)
+}
+
+func TestBulkUpdateCancelled(t *testing.T) {
+	client := &memoryClient{
+		checks: []Check{{UUID: "1", Name: "backup"}, {UUID: "2", Name: "report"}},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result, err := BulkUpdate(ctx, client, client.checks, func(Check) (*UpdateCheck, error) {
+		return &UpdateCheck{Grace: 300}, nil
+	}, BulkUpdateOptions{})
+	require.ErrorContains(t, err, "2 checks not attempted")
+	require.Equal(t, client.checks, result.Remaining)
+	require.Equal(t, client.checks, result.Pending())
+	require.Empty(t, client.calls)
+}
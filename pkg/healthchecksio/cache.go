@@ -0,0 +1,187 @@
+package healthchecksio
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// CacheStore keeps cached API responses. Stores shared between processes, such as Redis,
+// give a fleet of workers one cached view of check data instead of each calling the API.
+type CacheStore interface {
+	// Get returns the value stored under key, false when it's missing or expired
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+
+	// Set stores value under key for ttl
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+
+	// Delete removes key
+	Delete(ctx context.Context, key string) error
+}
+
+// WithCache serves GetChecks, GetCheck, GetPings, GetPingBody, GetFlips and GetChannels
+// from store for up to ttl after the API was last asked. Any change made through a client
+// sharing the store (create, update, delete, pause, resume or a non-GET Do) invalidates
+// every cached response for its API key. Errors from the store fall back to calling the API.
+func WithCache(store CacheStore, ttl time.Duration) ClientOption {
+	return func(c *client) {
+		c.cache = store
+		c.cacheTTL = ttl
+	}
+}
+
+// WithoutCache fetches a response from the API instead of the client's cache, the fresh
+// response is still cached for later calls
+func WithoutCache() CallOption {
+	return func(o *callOptions) {
+		o.noCache = true
+	}
+}
+
+// cachedEndpoints are the read endpoints WithCache serves
+var cachedEndpoints = map[string]bool{
+	"get-checks":    true,
+	"get-check":     true,
+	"get-pings":     true,
+	"get-ping-body": true,
+	"get-flips":     true,
+	"get-channels":  true,
+}
+
+type noCacheKey struct{}
+
+// cacheNamespace is the prefix of every cache key for the request's API key and base URL,
+// the API key is hashed so it's never written to the store
+func (c *client) cacheNamespace(req *http.Request) string {
+	sum := sha256.Sum256([]byte(c.baseURL + "\x00" + req.Header.Get("X-Api-Key")))
+	return "healthchecksio:" + hex.EncodeToString(sum[:12])
+}
+
+// cacheGeneration returns the namespace's current generation, changed to invalidate every key in it
+func (c *client) cacheGeneration(ctx context.Context, namespace string) (string, error) {
+	gen, found, err := c.cache.Get(ctx, namespace+":generation")
+	if err != nil || !found {
+		return "0", err
+	}
+	return string(gen), nil
+}
+
+// sendCached serves req from the cache when it can, or sends it and caches a successful response
+func (c *client) sendCached(req *http.Request, endpoint string) (*http.Response, error) {
+	ctx := req.Context()
+	namespace := c.cacheNamespace(req)
+	gen, err := c.cacheGeneration(ctx, namespace)
+	if err != nil {
+		return c.roundTrip(req, endpoint)
+	}
+	key := namespace + ":" + gen + ":" + req.URL.RequestURI()
+
+	if noCache, _ := ctx.Value(noCacheKey{}).(bool); !noCache {
+		if body, found, err := c.cache.Get(ctx, key); err == nil && found {
+			c.stats.update(func(st *Stats) { st.CacheHits++ })
+			return &http.Response{
+				StatusCode:    http.StatusOK,
+				Status:        "200 OK",
+				Header:        http.Header{"Content-Type": []string{"application/json"}},
+				Body:          io.NopCloser(bytes.NewReader(body)),
+				ContentLength: int64(len(body)),
+				Request:       req,
+			}, nil
+		}
+	}
+	c.stats.update(func(st *Stats) { st.CacheMisses++ })
+
+	resp, err := c.roundTrip(req, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		// Don't keep serving what the API no longer has, e.g. after a WithoutCache call saw a 404
+		if resp.StatusCode == http.StatusNotFound {
+			c.cache.Delete(ctx, key)
+		}
+		return resp, nil
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	c.cache.Set(ctx, key, body, c.cacheTTL)
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// invalidateCache starts a new generation for req's namespace once a change succeeded.
+// The generation outlives the cached responses so an expired one can't resurrect them.
+func (c *client) invalidateCache(req *http.Request) {
+	gen := strconv.FormatInt(time.Now().UnixNano(), 36)
+	c.cache.Set(req.Context(), c.cacheNamespace(req)+":generation", []byte(gen), 2*c.cacheTTL+time.Hour)
+}
+
+// MemoryCache is an in-process CacheStore
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]memoryCacheEntry
+	now     func() time.Time
+}
+
+type memoryCacheEntry struct {
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryCache creates an empty MemoryCache
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{
+		entries: make(map[string]memoryCacheEntry),
+		now:     time.Now,
+	}
+}
+
+// Get returns the value under key unless it has expired
+func (m *MemoryCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, exists := m.entries[key]
+	if !exists {
+		return nil, false, nil
+	}
+	if !m.now().Before(entry.expires) {
+		delete(m.entries, key)
+		return nil, false, nil
+	}
+	return entry.value, true, nil
+}
+
+// Set stores value under key for ttl, dropping any entries which have expired
+func (m *MemoryCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	for k, entry := range m.entries {
+		if !now.Before(entry.expires) {
+			delete(m.entries, k)
+		}
+	}
+	m.entries[key] = memoryCacheEntry{value: bytes.Clone(value), expires: now.Add(ttl)}
+	return nil
+}
+
+// Delete removes key
+func (m *MemoryCache) Delete(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.entries, key)
+	return nil
+}
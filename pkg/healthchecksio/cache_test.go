@@ -0,0 +1,98 @@
+package healthchecksio_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithCache(t *testing.T) {
+	var requests atomic.Int32
+	var grace atomic.Int32
+	grace.Store(60)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		switch {
+		case r.Method == "POST":
+			grace.Store(300)
+			w.Write([]byte(`{"uuid":"1","name":"backup","grace":300}`))
+		case r.URL.Path == "/checks/missing":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":"not found"}`))
+		default:
+			w.Write([]byte(`{"checks":[{"uuid":"1","name":"backup","grace":` + strconv.Itoa(int(grace.Load())) + `}]}`))
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	store := healthchecksio.NewMemoryCache()
+	worker1 := healthchecksio.NewClient("key", healthchecksio.WithBaseURL(srv.URL), healthchecksio.WithCache(store, time.Minute))
+	worker2 := healthchecksio.NewClient("key", healthchecksio.WithBaseURL(srv.URL), healthchecksio.WithCache(store, time.Minute))
+
+	list, err := worker1.GetChecks(ctx, healthchecksio.GetChecks{})
+	require.NoError(t, err)
+	require.Equal(t, 60, list.Checks[0].Grace)
+
+	// A second worker sharing the store doesn't call the API
+	list, err = worker2.GetChecks(ctx, healthchecksio.GetChecks{})
+	require.NoError(t, err)
+	require.Equal(t, 60, list.Checks[0].Grace)
+	require.Equal(t, int32(1), requests.Load())
+	require.Equal(t, uint64(1), worker2.Stats().CacheHits)
+	require.Zero(t, worker2.Stats().Requests["get-checks"])
+
+	// Other queries and API keys are cached separately
+	_, err = worker2.GetChecks(ctx, healthchecksio.GetChecks{Tags: []string{"prod"}})
+	require.NoError(t, err)
+	_, err = worker2.GetChecks(ctx, healthchecksio.GetChecks{}, healthchecksio.WithAPIKey("other"))
+	require.NoError(t, err)
+	_, err = worker2.GetChecks(ctx, healthchecksio.GetChecks{}, healthchecksio.WithoutCache())
+	require.NoError(t, err)
+	require.Equal(t, int32(4), requests.Load())
+	require.Equal(t, uint64(3), worker2.Stats().CacheMisses)
+
+	// Errors aren't cached
+	_, err = worker1.GetCheck(ctx, "missing")
+	require.ErrorContains(t, err, "404")
+	_, err = worker1.GetCheck(ctx, "missing")
+	require.ErrorContains(t, err, "404")
+	require.Equal(t, int32(6), requests.Load())
+
+	// A change by either worker invalidates what the other sees
+	_, err = worker1.UpdateCheck(ctx, "1", &healthchecksio.UpdateCheck{Grace: 300})
+	require.NoError(t, err)
+	list, err = worker2.GetChecks(ctx, healthchecksio.GetChecks{})
+	require.NoError(t, err)
+	require.Equal(t, 300, list.Checks[0].Grace)
+	require.Equal(t, int32(8), requests.Load())
+}
+
+func TestMemoryCache(t *testing.T) {
+	ctx := context.Background()
+	cache := healthchecksio.NewMemoryCache()
+
+	require.NoError(t, cache.Set(ctx, "a", []byte("1"), time.Minute))
+	require.NoError(t, cache.Set(ctx, "b", []byte("2"), time.Millisecond))
+
+	value, found, err := cache.Get(ctx, "a")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, []byte("1"), value)
+
+	time.Sleep(5 * time.Millisecond)
+	_, found, _ = cache.Get(ctx, "b")
+	require.False(t, found)
+
+	require.NoError(t, cache.Delete(ctx, "a"))
+	_, found, _ = cache.Get(ctx, "a")
+	require.False(t, found)
+}
@@ -0,0 +1,125 @@
+package healthchecksio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Channel is an integration which receives notifications (from API responses)
+type Channel struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+}
+
+type ChannelListResponse struct {
+	Channels []Channel `json:"channels"`
+}
+
+// GetChannels lists the project's integrations (notification channels)
+func (c *client) GetChannels(ctx context.Context, opts ...CallOption) (*ChannelListResponse, error) {
+	return doJSON[ChannelListResponse](ctx, c, apiRequest{
+		name:     "get channels",
+		endpoint: "get-channels",
+		method:   "GET",
+		path:     []string{"/channels/"},
+		opts:     opts,
+	})
+}
+
+// ChannelAssigner can resolve integrations and update the checks they're assigned to
+type ChannelAssigner interface {
+	ManagementClient
+	ChannelReader
+}
+
+// AssignChannels adds integrations, given by name or ID, to the check's current ones
+func AssignChannels(ctx context.Context, client ChannelAssigner, uuid string, channels ...string) (*Check, error) {
+	check, err := setChannels(ctx, client, uuid, channels, true)
+	if err != nil {
+		return nil, fmt.Errorf("assign channels: %w", err)
+	}
+	return check, nil
+}
+
+// ReplaceChannels makes the integrations, given by name or ID, the only ones assigned to the check.
+// Passing no channels unassigns every integration.
+func ReplaceChannels(ctx context.Context, client ChannelAssigner, uuid string, channels ...string) (*Check, error) {
+	check, err := setChannels(ctx, client, uuid, channels, false)
+	if err != nil {
+		return nil, fmt.Errorf("replace channels: %w", err)
+	}
+	return check, nil
+}
+
+func setChannels(ctx context.Context, client ChannelAssigner, uuid string, channels []string, merge bool) (*Check, error) {
+	list, err := client.GetChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := resolveChannels(list.Channels, channels)
+	if err != nil {
+		return nil, err
+	}
+
+	if merge {
+		check, err := client.GetCheck(ctx, uuid)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range splitChannels(check.Channels) {
+			if !slices.Contains(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+	}
+	slices.Sort(ids)
+
+	// Sent raw so unassigning every integration still sends "channels": ""
+	body, err := json.Marshal(map[string]string{"channels": strings.Join(ids, ",")})
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateCheckRaw(ctx, uuid, body)
+}
+
+// resolveChannels maps each channel name or ID to the channel's ID
+func resolveChannels(available []Channel, channels []string) ([]string, error) {
+	var ids []string
+	for _, want := range channels {
+		idx := slices.IndexFunc(available, func(ch Channel) bool { return ch.ID == want })
+		if idx < 0 {
+			var matches int
+			for i := range available {
+				if available[i].Name == want {
+					idx = i
+					matches++
+				}
+			}
+			if matches > 1 {
+				return nil, fmt.Errorf("channel name %q is ambiguous, use its ID", want)
+			}
+		}
+		if idx < 0 {
+			return nil, fmt.Errorf("channel %q not found", want)
+		}
+		if id := available[idx].ID; !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// splitChannels splits the comma separated channel IDs of a Check
+func splitChannels(channels string) []string {
+	var out []string
+	for _, id := range strings.Split(channels, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			out = append(out, id)
+		}
+	}
+	return out
+}
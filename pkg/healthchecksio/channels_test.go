@@ -0,0 +1,39 @@
+package healthchecksio
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssignChannels(t *testing.T) {
+	ctx := context.Background()
+	mock := &memoryClient{
+		checks: []Check{{UUID: "1", Slug: "nightly", Channels: "c-email"}},
+		channels: []Channel{
+			{ID: "c-email", Name: "ops@example.com", Kind: "email"},
+			{ID: "c-slack", Name: "#alerts", Kind: "slack"},
+			{ID: "c-pd-1", Name: "PagerDuty", Kind: "pd"},
+			{ID: "c-pd-2", Name: "PagerDuty", Kind: "pd"},
+		},
+	}
+
+	check, err := AssignChannels(ctx, mock, "1", "#alerts", "c-pd-2")
+	require.NoError(t, err)
+	require.Equal(t, "c-email,c-pd-2,c-slack", check.Channels)
+
+	check, err = ReplaceChannels(ctx, mock, "1", "#alerts")
+	require.NoError(t, err)
+	require.Equal(t, "c-slack", check.Channels)
+
+	check, err = ReplaceChannels(ctx, mock, "1")
+	require.NoError(t, err)
+	require.Empty(t, check.Channels)
+
+	_, err = AssignChannels(ctx, mock, "1", "PagerDuty")
+	require.EqualError(t, err, `assign channels: channel name "PagerDuty" is ambiguous, use its ID`)
+
+	_, err = AssignChannels(ctx, mock, "1", "#missing")
+	require.EqualError(t, err, `assign channels: channel "#missing" not found`)
+}
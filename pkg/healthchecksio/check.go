@@ -0,0 +1,94 @@
+package healthchecksio
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+//go:generate go run ./internal/apigen -spec testdata/apispec.json -out apifields_gen_test.go
+
+// checkFields is Check without its custom (un)marshaling methods
+type checkFields Check
+
+// knownCheckFields are the JSON keys modeled on Check
+var knownCheckFields = func() map[string]bool {
+	out := make(map[string]bool)
+
+	t := reflect.TypeFor[checkFields]()
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			out[name] = true
+		}
+	}
+	return out
+}()
+
+// UnmarshalJSON decodes a Check while keeping the raw object and any unmodeled fields
+func (c *Check) UnmarshalJSON(data []byte) error {
+	var fields checkFields
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return err
+	}
+	for key := range all {
+		if knownCheckFields[key] {
+			delete(all, key)
+		}
+	}
+	if len(all) > 0 {
+		fields.Unknown = all
+	}
+	fields.Raw = append(json.RawMessage(nil), data...)
+
+	*c = Check(fields)
+	return nil
+}
+
+// MarshalJSON encodes a Check including any unmodeled fields it was decoded with
+func (c Check) MarshalJSON() ([]byte, error) {
+	bs, err := json.Marshal(checkFields(c))
+	if err != nil {
+		return nil, err
+	}
+	if len(c.Unknown) == 0 && len(c.Raw) == 0 {
+		return bs, nil
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(bs, &all); err != nil {
+		return nil, err
+	}
+	for key, value := range c.Unknown {
+		if _, exists := all[key]; !exists {
+			all[key] = value
+		}
+	}
+
+	// The API leaves fields out depending on the check's kind and the API key (timeout for
+	// cron checks, uuid and the URLs for read-only keys). Don't add them back while unset.
+	if len(c.Raw) > 0 {
+		var decoded map[string]json.RawMessage
+		if err := json.Unmarshal(c.Raw, &decoded); err == nil {
+			for key, value := range all {
+				if _, exists := decoded[key]; !exists && isZeroJSON(value) {
+					delete(all, key)
+				}
+			}
+		}
+	}
+	return json.Marshal(all)
+}
+
+func isZeroJSON(value json.RawMessage) bool {
+	switch string(value) {
+	case `""`, `0`, `false`, `null`:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+package healthchecksio_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheck_UnknownFields(t *testing.T) {
+	input := `{"name":"backup","uuid":"abc","grace":60,"future_field":{"a":1},"other":"x"}`
+
+	var check healthchecksio.Check
+	require.NoError(t, json.Unmarshal([]byte(input), &check))
+
+	require.Equal(t, "backup", check.Name)
+	require.Equal(t, 60, check.Grace)
+	require.JSONEq(t, input, string(check.Raw))
+
+	require.Len(t, check.Unknown, 2)
+	require.JSONEq(t, `{"a":1}`, string(check.Unknown["future_field"]))
+
+	// Unknown fields survive a round trip
+	bs, err := json.Marshal(check)
+	require.NoError(t, err)
+
+	var out map[string]any
+	require.NoError(t, json.Unmarshal(bs, &out))
+	require.Equal(t, "backup", out["name"])
+	require.Equal(t, "x", out["other"])
+	require.Equal(t, map[string]any{"a": float64(1)}, out["future_field"])
+
+	// Modeled fields win over stale unknown values
+	check.Unknown["name"] = json.RawMessage(`"stale"`)
+	bs, err = json.Marshal(check)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(bs, &out))
+	require.Equal(t, "backup", out["name"])
+}
+
+func TestCheckListResponse_Raw(t *testing.T) {
+	input := `{"checks":[{"name":"one"},{"name":"two","extra":true}]}`
+
+	var list healthchecksio.CheckListResponse
+	require.NoError(t, json.Unmarshal([]byte(input), &list))
+	require.Len(t, list.Checks, 2)
+
+	require.Empty(t, list.Checks[0].Unknown)
+	require.JSONEq(t, `{"name":"one"}`, string(list.Checks[0].Raw))
+	require.JSONEq(t, `true`, string(list.Checks[1].Unknown["extra"]))
+}
+
+func TestCheck_Schedule(t *testing.T) {
+	input := `{"name":"nightly","schedule":"0 3 * * *","tz":"America/Chicago","grace":3600}`
+
+	var check healthchecksio.Check
+	require.NoError(t, json.Unmarshal([]byte(input), &check))
+	require.Equal(t, "0 3 * * *", check.Schedule)
+	require.Equal(t, "America/Chicago", check.Timezone)
+	require.Empty(t, check.Unknown)
+}
+
+func TestCheck_LastDuration(t *testing.T) {
+	var check healthchecksio.Check
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"backup","last_duration":125}`), &check))
+	require.Equal(t, healthchecksio.Seconds(125), check.LastDuration)
+	require.Equal(t, 2*time.Minute+5*time.Second, check.LastDuration.Duration())
+	require.Empty(t, check.Unknown)
+}
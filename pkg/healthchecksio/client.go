@@ -5,79 +5,241 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
-
-	"github.com/moov-io/base/telemetry"
-
-	"github.com/hashicorp/go-retryablehttp"
-	"go.opentelemetry.io/otel/attribute"
-	"go.opentelemetry.io/otel/trace"
 )
 
+// Client is the full Healthchecks.io v3 API. Consumers needing only part of it
+// should accept the smaller CheckReader, CheckWriter or Pinger interfaces.
 type Client interface {
-	// CreateCheck creates a new check
-	CreateCheck(ctx context.Context, check *CreateCheck) (*Check, error)
+	ManagementClient
+	ChannelReader
+	Pinger
 
-	// GetChecks lists all checks (supports query params: slug, tags)
-	GetChecks(ctx context.Context, req GetChecks) (*CheckListResponse, error)
+	// Do sends a request to any v3 API path, for endpoints this package doesn't support yet
+	Do(ctx context.Context, method, path string, body, out any, opts ...CallOption) error
 
-	// GetCheck retrieves a single check by UUID or unique_key
-	GetCheck(ctx context.Context, identifier string) (*Check, error)
+	// Stats returns a snapshot of the client's request counters
+	Stats() Stats
 
-	// UpdateCheck updates an existing check by UUID
-	UpdateCheck(ctx context.Context, uuid string, updates *UpdateCheck) (*Check, error)
+	// Close releases connections and background work, later calls return ErrClientClosed
+	Close() error
+}
 
-	// DeleteCheck deletes a check by UUID
-	DeleteCheck(ctx context.Context, uuid string) (*Check, error)
+// ManagementClient reads and modifies checks through the management API
+type ManagementClient interface {
+	CheckReader
+	CheckWriter
+}
 
-	// PauseCheck pauses a check by UUID
-	PauseCheck(ctx context.Context, uuid string) (*Check, error)
+// CheckReader reads checks and their history
+type CheckReader interface {
+	// GetChecks lists all checks (supports query params: slug, tag)
+	GetChecks(ctx context.Context, req GetChecks, opts ...CallOption) (*CheckListResponse, error)
 
-	// ResumeCheck resumes a paused check by UUID
-	ResumeCheck(ctx context.Context, uuid string) (*Check, error)
+	// GetCheck retrieves a single check by UUID or unique_key
+	GetCheck(ctx context.Context, identifier string, opts ...CallOption) (*Check, error)
 
 	// GetPings lists pings for a check by UUID or unique_key
-	GetPings(ctx context.Context, identifier string) (*PingListResponse, error)
+	GetPings(ctx context.Context, identifier string, opts ...CallOption) (*PingListResponse, error)
 
 	// GetPingBody retrieves the body of a specific ping by UUID, ping number (n), and unique_key if needed
-	GetPingBody(ctx context.Context, uuid string, n int) (string, error)
+	GetPingBody(ctx context.Context, uuid string, n int, opts ...CallOption) (string, error)
 
 	// GetFlips lists status flips for a check by UUID or unique_key (supports query params: seconds, start, end)
-	GetFlips(ctx context.Context, identifier string, params GetFlipsRequest) (*FlipListResponse, error)
+	GetFlips(ctx context.Context, identifier string, params GetFlipsRequest, opts ...CallOption) (*FlipListResponse, error)
+}
+
+// ChannelReader lists the project's integrations
+type ChannelReader interface {
+	// GetChannels lists the project's integrations (notification channels)
+	GetChannels(ctx context.Context, opts ...CallOption) (*ChannelListResponse, error)
+}
 
+// CheckWriter creates and modifies checks
+type CheckWriter interface {
+	// CreateCheck creates a new check
+	CreateCheck(ctx context.Context, check *CreateCheck, opts ...CallOption) (*Check, error)
+
+	// CreateCheckRaw creates a new check from an already encoded JSON body
+	CreateCheckRaw(ctx context.Context, body json.RawMessage, opts ...CallOption) (*Check, error)
+
+	// UpdateCheck updates an existing check by UUID
+	UpdateCheck(ctx context.Context, uuid string, updates *UpdateCheck, opts ...CallOption) (*Check, error)
+
+	// UpdateCheckRaw updates an existing check by UUID from an already encoded JSON body
+	UpdateCheckRaw(ctx context.Context, uuid string, body json.RawMessage, opts ...CallOption) (*Check, error)
+
+	// DeleteCheck deletes a check by UUID
+	DeleteCheck(ctx context.Context, uuid string, opts ...CallOption) (*Check, error)
+
+	// PauseCheck pauses a check by UUID
+	PauseCheck(ctx context.Context, uuid string, opts ...CallOption) (*Check, error)
+
+	// ResumeCheck resumes a paused check by UUID
+	ResumeCheck(ctx context.Context, uuid string, opts ...CallOption) (*Check, error)
+}
+
+// Pinger sends pings to checks
+type Pinger interface {
 	// Ping sends a ping to a check (success by default; supports hc-ping.com UUID or /api/v3/ping/<unique_key>)
-	Ping(ctx context.Context, checkURL string, body string, opts ...PingOption) error
+	Ping(ctx context.Context, checkURL string, body string, opts ...PingOption) (*PingResult, error)
+
+	// PingTarget parses a ping URL once for repeated pings to the same check
+	PingTarget(pingURL string) (*PingTarget, error)
 }
 
 // client is a Healthchecks.io v3 API client
 type client struct {
 	apiKey     string
 	baseURL    string // https://healthchecks.io/api/v3
-	httpClient *retryablehttp.Client
+	httpClient *http.Client
+	retry      RetryEngine
+
+	strictDecoding bool
+	tracer         Tracer
+	spanPrefix     string
+	spanNames      map[string]string
+	codec          Codec
+	dryRun         bool
+	access         Access
+
+	deleteProtection *DeleteProtection
+
+	maxResponseBytes     int64
+	maxPingResponseBytes int64
+	audit                *auditor
+	retryPolicies        map[int]RetryPolicy
+	responseHooks        []ResponseHook
+
+	stats clientStats
+
+	closed  atomic.Bool
+	closeMu sync.Mutex
+	closers []func() error
+
+	pingMetadata map[string]string
+
+	cache    CacheStore
+	cacheTTL time.Duration
+
+	selfMonitorURL      string
+	selfMonitorInterval time.Duration
+	lastAPISuccess      atomic.Int64 // unix nanoseconds
 }
 
 var _ Client = (&client{})
 
+// ClientOption configures a Client
+type ClientOption func(*client)
+
+// WithStrictDecoding makes API responses fail to decode when they contain fields
+// this package does not model. Intended for tests which need to notice API schema drift.
+func WithStrictDecoding() ClientOption {
+	return func(c *client) {
+		c.strictDecoding = true
+	}
+}
+
+// WithBaseURL sets the address of the v3 API, for self-hosted Healthchecks instances
+func WithBaseURL(address string) ClientOption {
+	return func(c *client) {
+		c.baseURL = address
+	}
+}
+
 // NewClient creates a new Healthchecks.io v3 client
 // apiKey: your API key (read-write or read-only)
-func NewClient(apiKey string) Client {
-	retryClient := retryablehttp.NewClient()
-	retryClient.RetryMax = 5
-	retryClient.RetryWaitMin = 500 * time.Millisecond
-	retryClient.RetryWaitMax = 4 * time.Second
-	retryClient.Logger = nil // silence logs in production
-
-	return &client{
+func NewClient(apiKey string, opts ...ClientOption) Client {
+	c := &client{
 		apiKey:     apiKey,
 		baseURL:    "https://healthchecks.io/api/v3",
-		httpClient: retryClient,
+		httpClient: &http.Client{Transport: newTransport()},
+		retry:      ExponentialBackoff(5, 500*time.Millisecond, 4*time.Second),
+		spanPrefix: defaultSpanPrefix,
+
+		maxResponseBytes:     DefaultMaxResponseBytes,
+		maxPingResponseBytes: DefaultMaxPingResponseBytes,
+	}
+	for i := range opts {
+		opts[i](c)
+	}
+	c.buildSpanNames()
+
+	c.startSelfMonitor()
+
+	if len(c.responseHooks) > 0 {
+		next := c.httpClient.Transport
+		if next == nil {
+			next = http.DefaultTransport
+		}
+		limit := max(c.maxResponseBytes, c.maxPingResponseBytes)
+		if c.maxResponseBytes <= 0 || c.maxPingResponseBytes <= 0 {
+			limit = 0
+		}
+		c.httpClient.Transport = &hookTransport{
+			next:  next,
+			hooks: c.responseHooks,
+			limit: limit,
+		}
+	}
+	return c
+}
+
+// New creates a Healthchecks.io v3 client like NewClient, but returns an error
+// when apiKey or the configured base URL are invalid instead of failing on first use.
+func New(apiKey string, opts ...ClientOption) (Client, error) {
+	if err := validateAPIKey(apiKey); err != nil {
+		return nil, err
+	}
+
+	c := NewClient(apiKey, opts...).(*client)
+	if err := validateBaseURL(c.baseURL); err != nil {
+		c.Close() // stops the goroutines NewClient started, e.g. WithSelfMonitor's
+		return nil, err
 	}
+	return c, nil
+}
+
+// validateBaseURL checks address is an absolute http or https URL
+func validateBaseURL(address string) error {
+	base, err := url.Parse(address)
+	if err != nil {
+		return fmt.Errorf("invalid base url: %w", err)
+	}
+	if base.Scheme != "http" && base.Scheme != "https" {
+		return fmt.Errorf("invalid base url %q: scheme must be http or https", address)
+	}
+	if base.Host == "" {
+		return fmt.Errorf("invalid base url %q: missing host", address)
+	}
+	return nil
+}
+
+// validateAPIKey checks apiKey looks like a Healthchecks API key (32 URL-safe characters)
+func validateAPIKey(apiKey string) error {
+	if apiKey == "" {
+		return errors.New("invalid api key: empty")
+	}
+	if len(apiKey) != 32 {
+		return fmt.Errorf("invalid api key: expected 32 characters, got %d", len(apiKey))
+	}
+	for _, r := range apiKey {
+		isURLSafe := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '-' || r == '_'
+		if !isURLSafe {
+			return fmt.Errorf("invalid api key: unexpected character %q", r)
+		}
+	}
+	return nil
 }
 
 func (c *client) buildAddress(slugs ...string) (*url.URL, error) {
@@ -164,15 +326,37 @@ type Check struct {
 	FilterDefaultFail bool   `json:"filter_default_fail"`
 	BadgeURL          string `json:"badge_url"`
 	UUID              string `json:"uuid"`
+	UniqueKey         string `json:"unique_key,omitempty"` // replaces UUID and the URLs for read-only API keys
 	PingURL           string `json:"ping_url"`
 	UpdateURL         string `json:"update_url"`
 	PauseURL          string `json:"pause_url"`
 	ResumeURL         string `json:"resume_url"`
 	Channels          string `json:"channels"`
 	Timeout           int    `json:"timeout"`
+	Schedule          string `json:"schedule,omitempty"`
+	Timezone          string `json:"tz,omitempty"`
+
+	// LastDuration is how long the last measured run (start to success/fail ping) took
+	LastDuration Seconds `json:"last_duration,omitempty"`
+
+	// Raw is the JSON object this Check was decoded from
+	Raw json.RawMessage `json:"-"`
+
+	// Unknown holds fields from the API response which are not modeled on Check.
+	// They are written back out by MarshalJSON so data survives read-modify-write flows.
+	Unknown map[string]json.RawMessage `json:"-"`
 }
 
-// CheckListResponse wraps the list of checks
+// Seconds is a duration the API reports in whole seconds
+type Seconds int
+
+// Duration converts s into a time.Duration
+func (s Seconds) Duration() time.Duration {
+	return time.Duration(s) * time.Second
+}
+
+// CheckListResponse wraps the list of checks. GetChecks follows pagination when the API
+// splits the list into pages, so it always holds every matching check.
 type CheckListResponse struct {
 	Checks []Check `json:"checks"`
 }
@@ -186,11 +370,24 @@ type Ping struct {
 	RemoteAddr string    `json:"remote_addr"`
 	Method     string    `json:"method"`
 	Ua         string    `json:"ua"`
-	Rid        string    `json:"rid"`
+	Rid        string    `json:"rid"` // empty unless the ping had a run ID, the API sends null
 	Duration   float64   `json:"duration,omitempty"`
 	BodyURL    *string   `json:"body_url"`
 }
 
+// PingType is the kind of a Ping, as found in Ping.Type
+type PingType string
+
+const (
+	PingSuccess PingType = "success"
+	PingStart   PingType = "start"
+	PingFail    PingType = "fail"
+	PingLog     PingType = "log"
+
+	// PingIgnored is a ping which matched none of the check's keywords
+	PingIgnored PingType = "ign"
+)
+
 // PingListResponse wraps the list of pings
 type PingListResponse struct {
 	Pings []Ping `json:"pings"`
@@ -207,365 +404,193 @@ type FlipListResponse struct {
 	Flips []Flip `json:"flips"`
 }
 
-// CreateCheck creates a new check
-func (c *client) CreateCheck(ctx context.Context, check *CreateCheck) (*Check, error) {
-	ctx, span := telemetry.StartSpan(ctx, "healthchecksio-api-create-check", trace.WithAttributes(
-		attribute.String("check.name", check.Name),
-		attribute.String("check.slug", check.Slug),
-	))
-	defer span.End()
-
-	reqBody, err := json.Marshal(check)
-	if err != nil {
-		return nil, err
+// UnmarshalJSON accepts the API's bare array of flips as well as {"flips": [...]}
+func (r *FlipListResponse) UnmarshalJSON(data []byte) error {
+	if trimmed := bytes.TrimLeft(data, " \t\r\n"); len(trimmed) > 0 && trimmed[0] == '[' {
+		return json.Unmarshal(data, &r.Flips)
 	}
+	type wrapped FlipListResponse
+	return json.Unmarshal(data, (*wrapped)(r))
+}
 
-	address, err := c.buildAddress("/checks/")
-	if err != nil {
-		return nil, fmt.Errorf("get checks: %v", err)
-	}
-
-	req, err := retryablehttp.NewRequestWithContext(ctx, "POST", address.String(), bytes.NewReader(reqBody))
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("X-Api-Key", c.apiKey)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+// CreateCheck creates a new check
+func (c *client) CreateCheck(ctx context.Context, check *CreateCheck, opts ...CallOption) (*Check, error) {
+	return c.createCheck(ctx, check, opts,
+		attr("check.name", check.Name),
+		attr("check.slug", check.Slug),
+	)
+}
 
-	if resp.StatusCode != http.StatusCreated {
-		var err2 Error
-		json.NewDecoder(resp.Body).Decode(&err2)
-		return nil, fmt.Errorf("create check failed with %d: %v", resp.StatusCode, err2)
-	}
+// CreateCheckRaw creates a new check from an already encoded JSON body
+func (c *client) CreateCheckRaw(ctx context.Context, body json.RawMessage, opts ...CallOption) (*Check, error) {
+	return c.createCheck(ctx, body, opts)
+}
 
-	var created Check
-	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
-		return nil, err
-	}
-	return &created, nil
+func (c *client) createCheck(ctx context.Context, body any, opts []CallOption, attrs ...Attribute) (*Check, error) {
+	return doJSON[Check](ctx, c, apiRequest{
+		name:     "create check",
+		endpoint: "create-check",
+		attrs:    attrs,
+		method:   "POST",
+		path:     []string{"/checks/"},
+		body:     body,
+		// no status: 201 for a new check, 200 when unique matched an existing one
+		opts: opts,
+	})
 }
 
 type GetChecks struct {
 	Slug string
-	Tags string
-}
-
-// GetChecks lists all checks (supports query params: slug, tags)
-func (c *client) GetChecks(ctx context.Context, params GetChecks) (*CheckListResponse, error) {
-	ctx, span := telemetry.StartSpan(ctx, "healthchecksio-api-get-checks", trace.WithAttributes(
-		attribute.String("check.slug", params.Slug),
-		attribute.String("check.tags", params.Tags),
-	))
-	defer span.End()
 
-	address, err := c.buildAddress("/checks/")
-	if err != nil {
-		return nil, fmt.Errorf("get checks: %v", err)
-	}
+	// Tags limits the list to checks having every one of these tags
+	Tags []string
+}
 
+// GetChecks lists all checks (supports query params: slug, tag)
+func (c *client) GetChecks(ctx context.Context, params GetChecks, opts ...CallOption) (*CheckListResponse, error) {
 	q := make(url.Values)
 	if params.Slug != "" {
 		q.Set("slug", params.Slug)
 	}
-	if params.Tags != "" {
-		q.Set("tags", params.Tags)
-	}
-	address.RawQuery = q.Encode()
-
-	req, err := retryablehttp.NewRequestWithContext(ctx, "GET", address.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("X-Api-Key", c.apiKey)
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		var err2 Error
-		json.NewDecoder(resp.Body).Decode(&err2)
-		return nil, fmt.Errorf("get checks failed with %d: %v", resp.StatusCode, err2)
-	}
-
-	var list CheckListResponse
-	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
-		return nil, err
-	}
-	return &list, nil
+	// The API ANDs repeated tag params together
+	for _, tag := range params.Tags {
+		q.Add("tag", tag)
+	}
+
+	return c.getCheckPages(ctx, apiRequest{
+		name:     "get checks",
+		endpoint: "get-checks",
+		attrs: []Attribute{
+			attr("check.slug", params.Slug),
+			attr("check.tags", strings.Join(params.Tags, " ")),
+		},
+		method: "GET",
+		path:   []string{"/checks/"},
+		query:  q,
+		status: http.StatusOK,
+		opts:   opts,
+	})
 }
 
 // GetCheck retrieves a single check by UUID or unique_key
-func (c *client) GetCheck(ctx context.Context, identifier string) (*Check, error) {
-	ctx, span := telemetry.StartSpan(ctx, "healthchecksio-api-get-check", trace.WithAttributes(
-		attribute.String("check.identifier", identifier),
-	))
-	defer span.End()
-
-	address, err := c.buildAddress("/checks/", identifier)
-	if err != nil {
-		return nil, fmt.Errorf("get checks: %v", err)
-	}
-
-	req, err := retryablehttp.NewRequestWithContext(ctx, "GET", address.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("X-Api-Key", c.apiKey)
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		var err2 Error
-		json.NewDecoder(resp.Body).Decode(&err2)
-		return nil, fmt.Errorf("get check failed with %d: %v", resp.StatusCode, err2)
-	}
-
-	var ch Check
-	if err := json.NewDecoder(resp.Body).Decode(&ch); err != nil {
-		return nil, err
-	}
-	return &ch, nil
+func (c *client) GetCheck(ctx context.Context, identifier string, opts ...CallOption) (*Check, error) {
+	return doJSON[Check](ctx, c, apiRequest{
+		name:     "get check",
+		endpoint: "get-check",
+		attrs: []Attribute{
+			attr("check.identifier", identifier),
+		},
+		method: "GET",
+		path:   []string{"/checks/", identifier},
+		status: http.StatusOK,
+		opts:   opts,
+	})
 }
 
 // UpdateCheck updates an existing check by UUID
-func (c *client) UpdateCheck(ctx context.Context, uuid string, update *UpdateCheck) (*Check, error) {
-	ctx, span := telemetry.StartSpan(ctx, "healthchecksio-api-update-check", trace.WithAttributes(
-		attribute.String("check.uuid", uuid),
-		attribute.String("check.name", update.Name),
-		attribute.String("check.slug", update.Slug),
-	))
-	defer span.End()
-
-	reqBody, err := json.Marshal(update)
-	if err != nil {
-		return nil, err
-	}
-
-	address, err := c.buildAddress("/checks/", uuid)
-	if err != nil {
-		return nil, fmt.Errorf("get checks: %v", err)
-	}
-
-	req, err := retryablehttp.NewRequestWithContext(ctx, "POST", address.String(), bytes.NewReader(reqBody))
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("X-Api-Key", c.apiKey)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+func (c *client) UpdateCheck(ctx context.Context, uuid string, update *UpdateCheck, opts ...CallOption) (*Check, error) {
+	return c.updateCheck(ctx, uuid, update, opts,
+		attr("check.name", update.Name),
+		attr("check.slug", update.Slug),
+	)
+}
 
-	if resp.StatusCode != http.StatusOK {
-		var err2 Error
-		json.NewDecoder(resp.Body).Decode(&err2)
-		return nil, fmt.Errorf("update check failed with %d: %v", resp.StatusCode, err2)
-	}
+// UpdateCheckRaw updates an existing check by UUID from an already encoded JSON body
+func (c *client) UpdateCheckRaw(ctx context.Context, uuid string, body json.RawMessage, opts ...CallOption) (*Check, error) {
+	return c.updateCheck(ctx, uuid, body, opts)
+}
 
-	var updated Check
-	if err := json.NewDecoder(resp.Body).Decode(&updated); err != nil {
-		return nil, err
-	}
-	return &updated, nil
+func (c *client) updateCheck(ctx context.Context, uuid string, body any, opts []CallOption, attrs ...Attribute) (*Check, error) {
+	return doJSON[Check](ctx, c, apiRequest{
+		name:     "update check",
+		endpoint: "update-check",
+		attrs:    append([]Attribute{attr("check.uuid", uuid)}, attrs...),
+		method:   "POST",
+		path:     []string{"/checks/", uuid},
+		body:     body,
+		status:   http.StatusOK,
+		opts:     opts,
+	})
 }
 
 // DeleteCheck deletes a check by UUID
-func (c *client) DeleteCheck(ctx context.Context, uuid string) (*Check, error) {
-	ctx, span := telemetry.StartSpan(ctx, "healthchecksio-api-delete-check", trace.WithAttributes(
-		attribute.String("check.uuid", uuid),
-	))
-	defer span.End()
-
-	address, err := c.buildAddress("/checks/", uuid)
-	if err != nil {
-		return nil, fmt.Errorf("get checks: %v", err)
-	}
-
-	req, err := retryablehttp.NewRequestWithContext(ctx, "DELETE", address.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("X-Api-Key", c.apiKey)
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
+func (c *client) DeleteCheck(ctx context.Context, uuid string, opts ...CallOption) (*Check, error) {
+	if err := c.guardDelete(ctx, uuid, opts); err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		var err2 Error
-		json.NewDecoder(resp.Body).Decode(&err2)
-		return nil, fmt.Errorf("delete check failed with %d: %v", resp.StatusCode, err2)
-	}
-
-	var deleted Check
-	if err := json.NewDecoder(resp.Body).Decode(&deleted); err != nil {
-		return nil, err
-	}
-	return &deleted, nil
+	return c.checkAction(ctx, "delete", "DELETE", uuid, nil, opts)
 }
 
 // PauseCheck pauses a check by UUID
-func (c *client) PauseCheck(ctx context.Context, uuid string) (*Check, error) {
-	ctx, span := telemetry.StartSpan(ctx, "healthchecksio-api-pause-check", trace.WithAttributes(
-		attribute.String("check.uuid", uuid),
-	))
-	defer span.End()
-
-	address, err := c.buildAddress("/checks/", uuid, "/pause")
-	if err != nil {
-		return nil, fmt.Errorf("get checks: %v", err)
-	}
-
-	req, err := retryablehttp.NewRequestWithContext(ctx, "POST", address.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("X-Api-Key", c.apiKey)
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		var err2 Error
-		json.NewDecoder(resp.Body).Decode(&err2)
-		return nil, fmt.Errorf("pause check failed with %d: %v", resp.StatusCode, err2)
-	}
-
-	var paused Check
-	if err := json.NewDecoder(resp.Body).Decode(&paused); err != nil {
-		return nil, err
-	}
-	return &paused, nil
+func (c *client) PauseCheck(ctx context.Context, uuid string, opts ...CallOption) (*Check, error) {
+	return c.checkAction(ctx, "pause", "POST", uuid, []string{"/pause"}, opts)
 }
 
 // ResumeCheck resumes a paused check by UUID
-func (c *client) ResumeCheck(ctx context.Context, uuid string) (*Check, error) {
-	ctx, span := telemetry.StartSpan(ctx, "healthchecksio-api-resume-check", trace.WithAttributes(
-		attribute.String("check.uuid", uuid),
-	))
-	defer span.End()
-
-	address, err := c.buildAddress("/checks/", uuid, "/resume")
-	if err != nil {
-		return nil, fmt.Errorf("get checks: %v", err)
-	}
-
-	req, err := retryablehttp.NewRequestWithContext(ctx, "POST", address.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("X-Api-Key", c.apiKey)
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		var err2 Error
-		json.NewDecoder(resp.Body).Decode(&err2)
-		return nil, fmt.Errorf("resume check failed with %d: %v", resp.StatusCode, err2)
-	}
+func (c *client) ResumeCheck(ctx context.Context, uuid string, opts ...CallOption) (*Check, error) {
+	return c.checkAction(ctx, "resume", "POST", uuid, []string{"/resume"}, opts)
+}
 
-	var resumed Check
-	if err := json.NewDecoder(resp.Body).Decode(&resumed); err != nil {
-		return nil, err
-	}
-	return &resumed, nil
+// checkAction performs a bodyless request on a single check which returns the check
+func (c *client) checkAction(ctx context.Context, action, method, uuid string, suffix []string, opts []CallOption) (*Check, error) {
+	return doJSON[Check](ctx, c, apiRequest{
+		name:     action + " check",
+		endpoint: action + "-check",
+		attrs: []Attribute{
+			attr("check.uuid", uuid),
+		},
+		method: method,
+		path:   append([]string{"/checks/", uuid}, suffix...),
+		status: http.StatusOK,
+		opts:   opts,
+	})
 }
 
 // GetPings lists pings for a check by UUID or unique_key
-func (c *client) GetPings(ctx context.Context, identifier string) (*PingListResponse, error) {
-	ctx, span := telemetry.StartSpan(ctx, "healthchecksio-api-get-pings", trace.WithAttributes(
-		attribute.String("check.identifier", identifier),
-	))
-	defer span.End()
-
-	address, err := c.buildAddress("/checks/", identifier, "/pings/")
-	if err != nil {
-		return nil, fmt.Errorf("get checks: %v", err)
-	}
-
-	req, err := retryablehttp.NewRequestWithContext(ctx, "GET", address.String(), nil)
+func (c *client) GetPings(ctx context.Context, identifier string, opts ...CallOption) (*PingListResponse, error) {
+	pings, err := doJSON[PingListResponse](ctx, c, apiRequest{
+		name:     "get pings",
+		endpoint: "get-pings",
+		attrs: []Attribute{
+			attr("check.identifier", identifier),
+		},
+		method: "GET",
+		path:   []string{"/checks/", identifier, "/pings/"},
+		status: http.StatusOK,
+		opts:   opts,
+	})
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("X-Api-Key", c.apiKey)
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
+	if types := c.callOptions(opts).pingTypes; len(types) > 0 {
+		pings.Pings = slices.DeleteFunc(pings.Pings, func(p Ping) bool {
+			return !slices.Contains(types, PingType(p.Type))
+		})
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		var err2 Error
-		json.NewDecoder(resp.Body).Decode(&err2)
-		return nil, fmt.Errorf("get check failed with %d: %v", resp.StatusCode, err2)
-	}
-
-	var list PingListResponse
-	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
-		return nil, err
-	}
-	return &list, nil
+	return pings, nil
 }
 
 // GetPingBody retrieves the body of a specific ping by UUID, ping number (n), and unique_key if needed
-func (c *client) GetPingBody(ctx context.Context, uuid string, n int) (string, error) {
-	ctx, span := telemetry.StartSpan(ctx, "healthchecksio-api-get-ping-body", trace.WithAttributes(
-		attribute.String("check.uuid", uuid),
-	))
+func (c *client) GetPingBody(ctx context.Context, uuid string, n int, opts ...CallOption) (string, error) {
+	ctx, span := c.startSpan(ctx, "get-ping-body", attr("check.uuid", uuid))
 	defer span.End()
 
-	address, err := c.buildAddress("/checks/", uuid, "/pings/", strconv.Itoa(n), "/body")
-	if err != nil {
-		return "", fmt.Errorf("get checks: %v", err)
-	}
-
-	req, err := retryablehttp.NewRequestWithContext(ctx, "GET", address.String(), nil)
-	if err != nil {
-		return "", err
-	}
-	req.Header.Set("X-Api-Key", c.apiKey)
-
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(ctx, apiRequest{
+		name:     "get ping body",
+		endpoint: "get-ping-body",
+		method:   "GET",
+		path:     []string{"/checks/", uuid, "/pings/", strconv.Itoa(n), "/body"},
+		status:   http.StatusOK,
+		opts:     opts,
+	})
 	if err != nil {
 		return "", err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		var err2 Error
-		json.NewDecoder(resp.Body).Decode(&err2)
-		return "", fmt.Errorf("get ping body failed with %d: %v", resp.StatusCode, err2)
-	}
-
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return "", err
+		return "", fmt.Errorf("get ping body: %w", err)
 	}
 	return string(body), nil
 }
@@ -577,87 +602,100 @@ type GetFlipsRequest struct {
 }
 
 // GetFlips lists status flips for a check by UUID or unique_key (supports query params: seconds, start, end)
-func (c *client) GetFlips(ctx context.Context, identifier string, params GetFlipsRequest) (*FlipListResponse, error) {
-	ctx, span := telemetry.StartSpan(ctx, "healthchecksio-api-get-flips", trace.WithAttributes(
-		attribute.String("check.identifier", identifier),
-	))
-	defer span.End()
-
-	address, err := c.buildAddress("/checks/", identifier, "/flips/")
-	if err != nil {
-		return nil, fmt.Errorf("get checks: %v", err)
-	}
-
+func (c *client) GetFlips(ctx context.Context, identifier string, params GetFlipsRequest, opts ...CallOption) (*FlipListResponse, error) {
 	q := make(url.Values)
 	if params.Seconds > 0 {
 		q.Set("seconds", strconv.Itoa(params.Seconds))
 	}
 	if params.Start > 0 {
-		q.Set("start", fmt.Sprintf("%d", params.Start))
+		q.Set("start", strconv.FormatInt(params.Start, 10))
 	}
 	if params.End > 0 {
-		q.Set("end", fmt.Sprintf("%d", params.End))
+		q.Set("end", strconv.FormatInt(params.End, 10))
+	}
+
+	return doJSON[FlipListResponse](ctx, c, apiRequest{
+		name:     "get flips",
+		endpoint: "get-flips",
+		attrs: []Attribute{
+			attr("check.identifier", identifier),
+		},
+		method: "GET",
+		path:   []string{"/checks/", identifier, "/flips/"},
+		query:  q,
+		status: http.StatusOK,
+		opts:   opts,
+	})
+}
+
+// Ping sends a ping to a check (success by default; supports hc-ping.com UUID or /api/v3/ping/<unique_key>)
+func (c *client) Ping(ctx context.Context, pingURL, body string, opts ...PingOption) (*PingResult, error) {
+	ctx, span := c.startSpan(ctx, "ping")
+	defer span.End()
+
+	// Only pay for attributes when someone is collecting them
+	if span.IsRecording() {
+		span.SetAttributes(
+			attr("check.ping_body", body),
+			attr("check.ping_url", pingURL),
+		)
 	}
-	address.RawQuery = q.Encode()
 
-	req, err := retryablehttp.NewRequestWithContext(ctx, "GET", address.String(), nil)
+	addr, err := pingAddress(ctx, pingURL, opts)
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("X-Api-Key", c.apiKey)
 
-	resp, err := c.httpClient.Do(req)
+	bs, err := c.pingBody(ctx, []byte(body))
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("ping: %w", err)
 	}
-	defer resp.Body.Close()
+	return c.sendPing(ctx, addr.String(), bytes.NewReader(bs))
+}
 
-	if resp.StatusCode != http.StatusOK {
-		var err2 Error
-		json.NewDecoder(resp.Body).Decode(&err2)
-		return nil, fmt.Errorf("get flips failed with %d: %v", resp.StatusCode, err2)
-	}
+// CallOption configures a single API request
+type CallOption func(*callOptions)
 
-	var list FlipListResponse
-	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
-		return nil, err
-	}
-	return &list, nil
+type callOptions struct {
+	apiKey       string
+	noCache      bool
+	confirmation string
+	requestID    string
+	pingTypes    []PingType
 }
 
-// Ping sends a ping to a check (success by default; supports hc-ping.com UUID or /api/v3/ping/<unique_key>)
-func (c *client) Ping(ctx context.Context, pingURL, body string, opts ...PingOption) error {
-	ctx, span := telemetry.StartSpan(ctx, "healthchecksio-api-ping", trace.WithAttributes(
-		attribute.String("check.ping_body", body),
-		attribute.String("check.ping_url", pingURL),
-	))
-	defer span.End()
-
-	addr, err := url.Parse(pingURL)
-	if err != nil {
-		return fmt.Errorf("parsing ping url: %v", err)
-	}
-	for i := range opts {
-		addr = opts[i](addr)
+// WithAPIKey overrides the client's API key for a single request
+func WithAPIKey(key string) CallOption {
+	return func(o *callOptions) {
+		o.apiKey = key
 	}
+}
 
-	req, err := retryablehttp.NewRequestWithContext(ctx, "POST", addr.String(), strings.NewReader(body))
-	if err != nil {
-		return err
+// WithRequestID sends the call with id as its X-Request-Id instead of a generated one,
+// to carry an upstream request's ID through to the Healthchecks API
+func WithRequestID(id string) CallOption {
+	return func(o *callOptions) {
+		o.requestID = id
 	}
-	req.Header.Set("User-Agent", "go-healthchecks-client")
+}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("ping: %v", err)
+// WithTypes makes GetPings return only pings of the given types, e.g. WithTypes(PingFail, PingStart)
+// for incident tooling which doesn't care about thousands of success pings. The API can't
+// filter pings, so every ping is still fetched (and cached) and the rest are dropped.
+func WithTypes(types ...PingType) CallOption {
+	return func(o *callOptions) {
+		o.pingTypes = append(o.pingTypes, types...)
 	}
+}
 
-	if resp.StatusCode != http.StatusOK {
-		bs, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("ping failed with %d: %v", resp.StatusCode, string(bs))
+func (c *client) callOptions(opts []CallOption) callOptions {
+	o := callOptions{
+		apiKey: c.apiKey,
 	}
-
-	return nil
+	for i := range opts {
+		opts[i](&o)
+	}
+	return o
 }
 
 // PingOption configures ping behavior
@@ -675,3 +713,21 @@ func WithFail() PingOption {
 		return u.JoinPath("/fail")
 	}
 }
+
+// WithLog sends a log ping which records the body without changing the check's status
+func WithLog() PingOption {
+	return func(u *url.URL) *url.URL {
+		return u.JoinPath("/log")
+	}
+}
+
+// WithRunID tags the ping with a run ID (a UUID), so Healthchecks pairs each start ping
+// with the completion ping of the same run when runs overlap
+func WithRunID(rid string) PingOption {
+	return func(u *url.URL) *url.URL {
+		q := u.Query()
+		q.Set("rid", rid)
+		u.RawQuery = q.Encode()
+		return u
+	}
+}
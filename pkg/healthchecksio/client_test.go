@@ -5,8 +5,12 @@ package healthchecksio_test
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -66,7 +70,7 @@ func TestCheckLifecycle(t *testing.T) {
 
 	// List checks and verify ours is there
 	listResp, err := client.GetChecks(ctx, healthchecksio.GetChecks{
-		Tags: "integration-test",
+		Tags: []string{"integration-test"},
 	})
 	require.NoError(t, err)
 
@@ -102,7 +106,7 @@ func TestCheckLifecycle(t *testing.T) {
 	require.NotNil(t, resumed)
 
 	// Send a ping (success)
-	err = client.Ping(ctx, created.PingURL, "")
+	_, err = client.Ping(ctx, created.PingURL, "")
 	require.NoError(t, err)
 
 	// Give HC a moment to process the ping
@@ -117,7 +121,7 @@ func TestCheckLifecycle(t *testing.T) {
 	require.Equal(t, 1, pings.Pings[0].N)
 
 	// Send a failure ping
-	err = client.Ping(ctx, created.PingURL, "example body", healthchecksio.WithFail())
+	_, err = client.Ping(ctx, created.PingURL, "example body", healthchecksio.WithFail())
 	require.NoError(t, err)
 
 	time.Sleep(2 * time.Second)
@@ -138,6 +142,34 @@ func TestCheckLifecycle(t *testing.T) {
 	require.NotEmpty(t, flips.Flips)
 }
 
+func TestGetPings_WithTypes(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Write([]byte(`{"pings":[{"type":"success","n":4},{"type":"fail","n":3},{"type":"start","n":2},{"type":"log","n":1}]}`))
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := healthchecksio.NewClient("key", healthchecksio.WithBaseURL(srv.URL), healthchecksio.WithCache(healthchecksio.NewMemoryCache(), time.Minute))
+
+	list, err := client.GetPings(ctx, "abc", healthchecksio.WithTypes(healthchecksio.PingFail, healthchecksio.PingStart))
+	require.NoError(t, err)
+	require.Len(t, list.Pings, 2)
+	require.Equal(t, 3, list.Pings[0].N)
+	require.Equal(t, 2, list.Pings[1].N)
+
+	// Filtering doesn't change what's cached
+	list, err = client.GetPings(ctx, "abc")
+	require.NoError(t, err)
+	require.Len(t, list.Pings, 4)
+	require.Equal(t, int32(1), requests.Load())
+
+	list, err = client.GetPings(ctx, "abc", healthchecksio.WithTypes(healthchecksio.PingIgnored))
+	require.NoError(t, err)
+	require.Empty(t, list.Pings)
+}
+
 func TestCreateWithMinimalFields(t *testing.T) {
 	ctx := context.Background()
 	client := setupTestClient(t)
@@ -152,3 +184,84 @@ func TestCreateWithMinimalFields(t *testing.T) {
 		client.DeleteCheck(ctx, created.UUID)
 	})
 }
+
+func TestWithAPIKey(t *testing.T) {
+	ctx := context.Background()
+	setupTestClient(t)
+
+	// A client with an invalid default key should succeed when the key is overridden per call
+	client := healthchecksio.NewClient("invalid-api-key")
+
+	_, err := client.GetChecks(ctx, healthchecksio.GetChecks{})
+	require.Error(t, err)
+
+	_, err = client.GetChecks(ctx, healthchecksio.GetChecks{}, healthchecksio.WithAPIKey(os.Getenv("GO_HEALTHCHECKSIO_API_KEY")))
+	require.NoError(t, err)
+}
+
+func TestCreateCheckRaw(t *testing.T) {
+	ctx := context.Background()
+	client := setupTestClient(t)
+
+	name := "raw-check-" + uuid.New().String()[:8]
+	created, err := client.CreateCheckRaw(ctx, json.RawMessage(`{"name":"`+name+`","grace":120}`))
+	require.NoError(t, err)
+	require.Equal(t, name, created.Name)
+	require.Equal(t, 120, created.Grace)
+
+	t.Cleanup(func() {
+		client.DeleteCheck(ctx, created.UUID)
+	})
+
+	updated, err := client.UpdateCheckRaw(ctx, created.UUID, json.RawMessage(`{"grace":300}`))
+	require.NoError(t, err)
+	require.Equal(t, 300, updated.Grace)
+}
+
+var (
+	_ healthchecksio.CheckReader      = healthchecksio.NewClient("")
+	_ healthchecksio.CheckWriter      = healthchecksio.NewClient("")
+	_ healthchecksio.ManagementClient = healthchecksio.NewClient("")
+	_ healthchecksio.Pinger           = healthchecksio.NewClient("")
+)
+
+func TestNew(t *testing.T) {
+	validKey := "ABCDEFGHIJKLMNOPQRSTUVWXYZ012_-9"
+
+	client, err := healthchecksio.New(validKey)
+	require.NoError(t, err)
+	require.NotNil(t, client)
+
+	_, err = healthchecksio.New(validKey, healthchecksio.WithBaseURL("https://hc.example.com/api/v3"))
+	require.NoError(t, err)
+
+	_, err = healthchecksio.New("")
+	require.ErrorContains(t, err, "invalid api key: empty")
+
+	_, err = healthchecksio.New("short")
+	require.ErrorContains(t, err, "expected 32 characters, got 5")
+
+	_, err = healthchecksio.New("ABCDEFGHIJKLMNOPQRSTUVWXYZ012 +9")
+	require.ErrorContains(t, err, "unexpected character ' '")
+
+	_, err = healthchecksio.New(validKey, healthchecksio.WithBaseURL("hc.example.com"))
+	require.ErrorContains(t, err, "scheme must be http or https")
+
+	_, err = healthchecksio.New(validKey, healthchecksio.WithBaseURL("https://"))
+	require.ErrorContains(t, err, "missing host")
+
+	_, err = healthchecksio.New(validKey, healthchecksio.WithBaseURL("https://hc.example.com/%zz"))
+	require.ErrorContains(t, err, "invalid base url")
+
+	// Rejected clients don't keep background goroutines running
+	var pings atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pings.Add(1)
+	}))
+	defer srv.Close()
+
+	_, err = healthchecksio.New(validKey, healthchecksio.WithBaseURL("hc.example.com"), healthchecksio.WithSelfMonitor(srv.URL, time.Millisecond))
+	require.Error(t, err)
+	time.Sleep(20 * time.Millisecond)
+	require.Zero(t, pings.Load())
+}
@@ -0,0 +1,67 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// CloneCheck creates a new check configured like the check with uuid. Non-zero fields of
+// overrides replace the copied values, they usually set at least a new name and slug.
+func CloneCheck(ctx context.Context, client ManagementClient, uuid string, overrides CreateCheck) (*Check, error) {
+	source, err := client.GetCheck(ctx, uuid)
+	if err != nil {
+		return nil, fmt.Errorf("clone check: %w", err)
+	}
+
+	create := source.toCreate()
+	overlay(&create, &overrides)
+	if create.Slug != "" && create.Slug == source.Slug {
+		return nil, errors.New("clone check: overrides must set a new slug")
+	}
+
+	check, err := client.CreateCheck(ctx, &create)
+	if err != nil {
+		return nil, fmt.Errorf("clone check: %w", err)
+	}
+	return check, nil
+}
+
+// toCreate returns the CreateCheck which configures a check like c
+func (c *Check) toCreate() CreateCheck {
+	create := CreateCheck{
+		Name:              c.Name,
+		Slug:              c.Slug,
+		Tags:              c.Tags,
+		Description:       c.Desc,
+		Grace:             c.Grace,
+		Schedule:          c.Schedule,
+		Timezone:          c.Timezone,
+		ManualResume:      c.ManualResume,
+		Methods:           c.Methods,
+		Channels:          c.Channels,
+		StartKeywords:     c.StartKw,
+		SuccessKeywords:   c.SuccessKw,
+		FailureKeywords:   c.FailureKw,
+		FilterSubject:     c.FilterSubject,
+		FilterBody:        c.FilterBody,
+		FilterHttpBody:    c.FilterHTTPBody,
+		FilterDefaultFail: c.FilterDefaultFail,
+	}
+	// Cron checks are configured by their schedule, the timeout only applies to simple checks
+	if c.Schedule == "" {
+		create.Timeout = c.Timeout
+	}
+	return create
+}
+
+// overlay copies the non-zero fields of src onto dst
+func overlay[T any](dst, src *T) {
+	d, s := reflect.ValueOf(dst).Elem(), reflect.ValueOf(src).Elem()
+	for i := range s.NumField() {
+		if field := s.Field(i); !field.IsZero() {
+			d.Field(i).Set(field)
+		}
+	}
+}
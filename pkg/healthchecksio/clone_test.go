@@ -0,0 +1,47 @@
+package healthchecksio
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloneCheck(t *testing.T) {
+	ctx := context.Background()
+	mock := &memoryClient{
+		checks: []Check{{
+			UUID:     "1",
+			Name:     "Nightly backup",
+			Slug:     "nightly-backup",
+			Tags:     "db env:prod",
+			Desc:     "pg_dump of the main database",
+			Grace:    600,
+			Timeout:  86400,
+			Schedule: "0 2 * * *",
+			Timezone: "UTC",
+		}},
+	}
+
+	clone, err := CloneCheck(ctx, mock, "1", CreateCheck{
+		Name: "Nightly backup (staging)",
+		Slug: "staging-nightly-backup",
+		Tags: "db env:staging",
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, "1", clone.UUID)
+	require.Equal(t, "Nightly backup (staging)", clone.Name)
+	require.Equal(t, "staging-nightly-backup", clone.Slug)
+	require.Equal(t, "db env:staging", clone.Tags)
+	require.Equal(t, "pg_dump of the main database", clone.Desc)
+	require.Equal(t, 600, clone.Grace)
+	require.Equal(t, "0 2 * * *", clone.Schedule)
+	require.Equal(t, "UTC", clone.Timezone)
+	require.Zero(t, clone.Timeout)
+
+	_, err = CloneCheck(ctx, mock, "1", CreateCheck{Name: "Copy"})
+	require.EqualError(t, err, "clone check: overrides must set a new slug")
+
+	_, err = CloneCheck(ctx, mock, "404", CreateCheck{Slug: "copy"})
+	require.ErrorContains(t, err, "clone check: get check failed with 404")
+}
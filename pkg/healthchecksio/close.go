@@ -0,0 +1,40 @@
+package healthchecksio
+
+import (
+	"errors"
+)
+
+// ErrClientClosed is returned by every call made after Close
+var ErrClientClosed = errors.New("healthchecksio: client is closed")
+
+// Close releases idle connections and stops background work started by the client.
+// Calls made after Close return ErrClientClosed. Close is safe to call more than once.
+func (c *client) Close() error {
+	if !c.closed.CompareAndSwap(false, true) {
+		return nil
+	}
+
+	c.closeMu.Lock()
+	closers := c.closers
+	c.closers = nil
+	c.closeMu.Unlock()
+
+	var errs []error
+	for i := len(closers) - 1; i >= 0; i-- {
+		if err := closers[i](); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	c.httpClient.CloseIdleConnections()
+
+	return errors.Join(errs...)
+}
+
+// onClose registers fn to be called when the client is closed, for stopping background goroutines
+func (c *client) onClose(fn func() error) {
+	c.closeMu.Lock()
+	defer c.closeMu.Unlock()
+
+	c.closers = append(c.closers, fn)
+}
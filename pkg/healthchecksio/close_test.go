@@ -0,0 +1,51 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Close(t *testing.T) {
+	ctx := context.Background()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"checks":[]}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", WithBaseURL(srv.URL)).(*client)
+
+	var stopped []string
+	c.onClose(func() error {
+		stopped = append(stopped, "first")
+		return nil
+	})
+	c.onClose(func() error {
+		stopped = append(stopped, "second")
+		return errors.New("second failed")
+	})
+
+	_, err := c.GetChecks(ctx, GetChecks{})
+	require.NoError(t, err)
+
+	require.ErrorContains(t, c.Close(), "second failed")
+	require.Equal(t, []string{"second", "first"}, stopped)
+
+	// Closing again is a no-op
+	require.NoError(t, c.Close())
+	require.Len(t, stopped, 2)
+
+	_, err = c.GetChecks(ctx, GetChecks{})
+	require.ErrorIs(t, err, ErrClientClosed)
+
+	_, err = c.Ping(ctx, srv.URL, "")
+	require.ErrorIs(t, err, ErrClientClosed)
+
+	err = c.Do(ctx, "GET", "/checks/", nil, nil)
+	require.ErrorIs(t, err, ErrClientClosed)
+}
@@ -0,0 +1,28 @@
+package healthchecksio
+
+import (
+	"encoding/json"
+)
+
+// Codec is a JSON implementation used for request and response bodies. Alternatives such as
+// go-json or sonic can be adapted with their Marshal and Unmarshal functions.
+type Codec interface {
+	Marshal(v any) ([]byte, error)
+	Unmarshal(data []byte, v any) error
+}
+
+// WithCodec replaces encoding/json for request and response bodies.
+// Strict decoding with a custom codec only rejects unmodeled fields on checks.
+func WithCodec(codec Codec) ClientOption {
+	return func(c *client) {
+		c.codec = codec
+	}
+}
+
+// marshal encodes v with the client's codec
+func (c *client) marshal(v any) ([]byte, error) {
+	if c.codec != nil {
+		return c.codec.Marshal(v)
+	}
+	return json.Marshal(v)
+}
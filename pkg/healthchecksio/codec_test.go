@@ -0,0 +1,58 @@
+package healthchecksio_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+type countingCodec struct {
+	marshals, unmarshals atomic.Int32
+}
+
+func (c *countingCodec) Marshal(v any) ([]byte, error) {
+	c.marshals.Add(1)
+	return json.Marshal(v)
+}
+
+func (c *countingCodec) Unmarshal(data []byte, v any) error {
+	c.unmarshals.Add(1)
+	return json.Unmarshal(data, v)
+}
+
+func TestWithCodec(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bs, _ := io.ReadAll(r.Body)
+		require.JSONEq(t, `{"name":"Backup"}`, string(bs))
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"uuid":"abc","name":"Backup","extra":true}`))
+	}))
+	defer srv.Close()
+
+	codec := &countingCodec{}
+	client := healthchecksio.NewClient("key", healthchecksio.WithBaseURL(srv.URL), healthchecksio.WithCodec(codec))
+
+	check, err := client.CreateCheck(context.Background(), &healthchecksio.CreateCheck{Name: "Backup"})
+	require.NoError(t, err)
+	require.Equal(t, "abc", check.UUID)
+	require.Equal(t, int32(1), codec.marshals.Load())
+	require.Equal(t, int32(1), codec.unmarshals.Load())
+
+	// Strict decoding still catches unmodeled check fields
+	client = healthchecksio.NewClient("key",
+		healthchecksio.WithBaseURL(srv.URL),
+		healthchecksio.WithCodec(codec),
+		healthchecksio.WithStrictDecoding(),
+	)
+	_, err = client.CreateCheck(context.Background(), &healthchecksio.CreateCheck{Name: "Backup"})
+	require.ErrorContains(t, err, "unknown fields [extra]")
+}
@@ -0,0 +1,134 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ConsumerOptions configures a Consumer
+type ConsumerOptions struct {
+	// PingURL is the check pinged as messages are processed
+	PingURL string
+
+	// BatchSize is how many messages Handle must process successfully per success ping, defaults to 1.
+	// HandleBatch and Run ping once per successful batch regardless.
+	BatchSize int
+
+	// MaxFailures is how many consecutive processing errors send a fail ping, defaults to 3
+	MaxFailures int
+
+	// ReceiveBackoff is the wait after a failed receive, doubling for each consecutive failure
+	// up to MaxReceiveBackoff, so Run doesn't spin on a broken queue. Defaults to 1 second and 1 minute.
+	ReceiveBackoff    time.Duration
+	MaxReceiveBackoff time.Duration
+
+	// OnPingError is called when a ping can't be delivered. Ping errors never fail message processing.
+	OnPingError func(error)
+}
+
+// Consumer wraps a message handler of a queue worker (Kafka, SQS, NATS, ...) and pings a check
+// as messages are processed so the worker gets a liveness check without bespoke glue.
+type Consumer[M any] struct {
+	client Pinger
+	opts   ConsumerOptions
+	handle func(context.Context, M) error
+
+	mu        sync.Mutex
+	successes int
+	failures  int
+}
+
+// NewConsumer creates a Consumer which processes messages with handle and pings with client
+func NewConsumer[M any](client Pinger, opts ConsumerOptions, handle func(ctx context.Context, msg M) error) *Consumer[M] {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 1
+	}
+	if opts.MaxFailures <= 0 {
+		opts.MaxFailures = 3
+	}
+	if opts.ReceiveBackoff <= 0 {
+		opts.ReceiveBackoff = time.Second
+	}
+	if opts.MaxReceiveBackoff < opts.ReceiveBackoff {
+		opts.MaxReceiveBackoff = max(time.Minute, opts.ReceiveBackoff)
+	}
+	return &Consumer[M]{
+		client: client,
+		opts:   opts,
+		handle: handle,
+	}
+}
+
+// Handle processes a single message and returns the handler's error
+func (c *Consumer[M]) Handle(ctx context.Context, msg M) error {
+	err := c.handle(ctx, msg)
+	c.record(ctx, err, false)
+	return err
+}
+
+// HandleBatch processes every message and returns their errors joined together.
+// A success ping is sent when the whole batch was processed without errors.
+func (c *Consumer[M]) HandleBatch(ctx context.Context, msgs []M) error {
+	var errs []error
+	for _, msg := range msgs {
+		if err := c.handle(ctx, msg); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	err := errors.Join(errs...)
+	c.record(ctx, err, true)
+	return err
+}
+
+// Run receives and processes batches until ctx is done. Receive errors count as processing
+// failures, followed by ReceiveBackoff, and an empty batch is treated as a healthy, idle queue.
+func (c *Consumer[M]) Run(ctx context.Context, receive func(ctx context.Context) ([]M, error)) error {
+	wait := c.opts.ReceiveBackoff
+	for ctx.Err() == nil {
+		msgs, err := receive(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			c.record(ctx, err, true)
+			sleepUntil(ctx, time.Now().Add(wait))
+			wait = min(wait*2, c.opts.MaxReceiveBackoff)
+			continue
+		}
+		wait = c.opts.ReceiveBackoff
+		c.HandleBatch(ctx, msgs)
+	}
+	return nil
+}
+
+func (c *Consumer[M]) record(ctx context.Context, err error, batch bool) {
+	c.mu.Lock()
+	var opts []PingOption
+	body := ""
+	send := false
+	if err != nil {
+		c.failures++
+		if c.failures == c.opts.MaxFailures {
+			send = true
+			body = err.Error()
+			opts = append(opts, WithFail())
+		}
+	} else {
+		c.failures = 0
+		c.successes++
+		if batch || c.successes >= c.opts.BatchSize {
+			c.successes = 0
+			send = true
+		}
+	}
+	c.mu.Unlock()
+
+	if !send {
+		return
+	}
+	if _, err := c.client.Ping(ctx, c.opts.PingURL, body, opts...); err != nil && c.opts.OnPingError != nil {
+		c.opts.OnPingError(err)
+	}
+}
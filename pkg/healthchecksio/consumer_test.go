@@ -0,0 +1,131 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// recordingPinger records each ping as its final URL followed by the body, if any
+type recordingPinger struct {
+	Client
+
+	mu    sync.Mutex
+	pings []string
+}
+
+func (r *recordingPinger) Ping(ctx context.Context, pingURL, body string, opts ...PingOption) (*PingResult, error) {
+	addr, err := url.Parse(pingURL)
+	if err != nil {
+		return nil, err
+	}
+	for _, opt := range opts {
+		addr = opt(addr)
+	}
+	got := addr.String()
+	if body != "" {
+		got += " " + body
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pings = append(r.pings, got)
+	return &PingResult{URL: addr.String()}, nil
+}
+
+func (r *recordingPinger) received() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.pings...)
+}
+
+func TestConsumer_Handle(t *testing.T) {
+	pinger := &recordingPinger{}
+	consumer := NewConsumer(pinger, ConsumerOptions{
+		PingURL:     "https://hc-ping.com/worker",
+		BatchSize:   2,
+		MaxFailures: 2,
+	}, func(ctx context.Context, msg string) error {
+		if msg == "bad" {
+			return errors.New("bad message")
+		}
+		return nil
+	})
+
+	ctx := context.Background()
+	for _, msg := range []string{"a", "b", "c", "bad", "bad", "bad", "d", "e"} {
+		consumer.Handle(ctx, msg)
+	}
+
+	require.Equal(t, []string{
+		"https://hc-ping.com/worker",
+		"https://hc-ping.com/worker/fail bad message",
+		"https://hc-ping.com/worker",
+	}, pinger.received())
+}
+
+func TestConsumer_Run(t *testing.T) {
+	pinger := &recordingPinger{}
+	consumer := NewConsumer(pinger, ConsumerOptions{
+		PingURL:     "https://hc-ping.com/worker",
+		MaxFailures: 1,
+	}, func(ctx context.Context, msg int) error {
+		if msg < 0 {
+			return errors.New("negative")
+		}
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	batches := [][]int{{1, 2}, {}, {3, -1}}
+	err := consumer.Run(ctx, func(ctx context.Context) ([]int, error) {
+		if len(batches) == 0 {
+			cancel()
+			return nil, ctx.Err()
+		}
+		batch := batches[0]
+		batches = batches[1:]
+		return batch, nil
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{
+		"https://hc-ping.com/worker",
+		"https://hc-ping.com/worker",
+		"https://hc-ping.com/worker/fail negative",
+	}, pinger.received())
+}
+
+func TestConsumer_RunBacksOff(t *testing.T) {
+	pinger := &recordingPinger{}
+	consumer := NewConsumer(pinger, ConsumerOptions{
+		PingURL:           "https://hc-ping.com/worker",
+		ReceiveBackoff:    20 * time.Millisecond,
+		MaxReceiveBackoff: 40 * time.Millisecond,
+	}, func(ctx context.Context, msg int) error { return nil })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var waits []time.Duration
+	last := time.Now()
+	err := consumer.Run(ctx, func(ctx context.Context) ([]int, error) {
+		waits = append(waits, time.Since(last))
+		last = time.Now()
+		if len(waits) == 5 {
+			cancel()
+			return nil, ctx.Err()
+		}
+		return nil, errors.New("broker unavailable")
+	})
+	require.NoError(t, err)
+
+	// Failed receives wait 20ms, 40ms, then stay capped at 40ms
+	require.GreaterOrEqual(t, waits[1], 20*time.Millisecond)
+	require.GreaterOrEqual(t, waits[2], 40*time.Millisecond)
+	require.GreaterOrEqual(t, waits[3], 40*time.Millisecond)
+	require.Equal(t, []string{"https://hc-ping.com/worker/fail broker unavailable"}, pinger.received())
+}
@@ -0,0 +1,56 @@
+package healthchecksio
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCron_Next(t *testing.T) {
+	start := time.Date(2025, time.March, 14, 10, 30, 0, 0, time.UTC) // a Friday
+
+	cases := []struct {
+		expr string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2025, time.March, 14, 10, 31, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2025, time.March, 14, 10, 45, 0, 0, time.UTC)},
+		{"0 3 * * *", time.Date(2025, time.March, 15, 3, 0, 0, 0, time.UTC)},
+		{"30 10 * * *", time.Date(2025, time.March, 15, 10, 30, 0, 0, time.UTC)},
+		{"0 9 * * mon-fri", time.Date(2025, time.March, 17, 9, 0, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1,15 * *", time.Date(2025, time.March, 15, 0, 0, 0, 0, time.UTC)},
+		{"0 12 * jan,jun *", time.Date(2025, time.June, 1, 12, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2025, time.March, 16, 0, 0, 0, 0, time.UTC)},
+		{"0 0 13 * 5", time.Date(2025, time.March, 21, 0, 0, 0, 0, time.UTC)}, // either day field matches
+		{"@hourly", time.Date(2025, time.March, 14, 11, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2025, time.March, 16, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.expr, func(t *testing.T) {
+			sched, err := parseCron(tc.expr)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, sched.next(start))
+		})
+	}
+}
+
+func TestCron_Invalid(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+	} {
+		_, err := parseCron(expr)
+		require.Error(t, err, expr)
+	}
+}
@@ -0,0 +1,265 @@
+package healthchecksio
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// CrontabOptions configures ParseCrontab
+type CrontabOptions struct {
+	// System parses /etc/crontab and /etc/cron.d files, whose entries name a user before the command
+	System bool
+
+	// Timezone is used for entries which aren't preceded by CRON_TZ or TZ, the API defaults to UTC
+	Timezone string
+
+	// Tags are set on every check
+	Tags string
+
+	// Source names the crontab in descriptions, e.g. "/etc/cron.d/backup" or "alice@db-1"
+	Source string
+}
+
+// ParseCrontab converts each entry of a crontab into a check with the same schedule and timezone.
+// Slugs are derived from the command and made unique within the crontab. @reboot entries have
+// no schedule to monitor and are skipped.
+func ParseCrontab(r io.Reader, opts CrontabOptions) ([]CreateCheck, error) {
+	source := opts.Source
+	if source == "" {
+		source = "crontab"
+	}
+	tz := opts.Timezone
+	slugs := make(map[string]int)
+
+	var out []CreateCheck
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if name, value, ok := crontabVariable(line); ok {
+			if name == "CRON_TZ" || name == "TZ" {
+				tz = value
+			}
+			continue
+		}
+
+		schedule, rest, err := splitCrontabSchedule(line)
+		if err != nil {
+			return nil, fmt.Errorf("%s line %d: %w", source, n, err)
+		}
+		if schedule == "@reboot" {
+			continue
+		}
+		if _, err := parseCron(schedule); err != nil {
+			return nil, fmt.Errorf("%s line %d: %w", source, n, err)
+		}
+
+		var user string
+		if opts.System {
+			user, rest = cutField(rest)
+		}
+		if rest == "" {
+			return nil, fmt.Errorf("%s line %d: missing command", source, n)
+		}
+
+		desc := fmt.Sprintf("Imported from %s line %d: %s", source, n, line)
+		if user != "" {
+			desc += "\nRuns as " + user
+		}
+		out = append(out, CreateCheck{
+			Name:        commandName(rest),
+			Slug:        uniqueSlug(slugs, CommandSlug(rest)),
+			Tags:        opts.Tags,
+			Description: desc,
+			Schedule:    cronMacro(schedule),
+			Timezone:    tz,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", source, err)
+	}
+	return out, nil
+}
+
+// crontabVariable parses NAME=value lines, which set environment variables for later entries
+func crontabVariable(line string) (name, value string, ok bool) {
+	name, value, ok = strings.Cut(line, "=")
+	if !ok {
+		return "", "", false
+	}
+	name = strings.TrimSpace(name)
+	if name == "" || strings.ContainsAny(name, " \t*") {
+		return "", "", false
+	}
+	return name, strings.Trim(strings.TrimSpace(value), `"'`), true
+}
+
+// splitCrontabSchedule splits an entry into its schedule (five fields or a macro) and the rest of the line
+func splitCrontabSchedule(line string) (schedule, rest string, err error) {
+	if strings.HasPrefix(line, "@") {
+		schedule, rest = cutField(line)
+		schedule = strings.ToLower(schedule)
+		if _, exists := cronMacros[schedule]; !exists && schedule != "@reboot" {
+			return "", "", fmt.Errorf("unknown schedule %s", schedule)
+		}
+		return schedule, rest, nil
+	}
+
+	fields := strings.Fields(line)
+	if len(fields) < 6 {
+		return "", "", fmt.Errorf("expected a schedule and command, got %q", line)
+	}
+	rest = line
+	for range 5 {
+		_, rest = cutField(rest)
+	}
+	return strings.Join(fields[:5], " "), rest, nil
+}
+
+// cutField returns the first field of s and what follows it, split on the same white space
+// as strings.Fields so any separator cron accepts works
+func cutField(s string) (field, rest string) {
+	s = strings.TrimLeftFunc(s, unicode.IsSpace)
+	i := strings.IndexFunc(s, unicode.IsSpace)
+	if i < 0 {
+		return s, ""
+	}
+	return s[:i], strings.TrimLeftFunc(s[i:], unicode.IsSpace)
+}
+
+// cronMacro expands macros like @daily, which the API doesn't accept
+func cronMacro(schedule string) string {
+	if expr, exists := cronMacros[schedule]; exists {
+		return expr
+	}
+	return schedule
+}
+
+// commandWrappers run another command, which names the check instead. The value is how many
+// positional arguments the wrapper takes before the command (e.g. timeout's duration).
+var commandWrappers = map[string]int{
+	"env": 0, "nice": 0, "ionice": 0, "nohup": 0, "sudo": 0, "chronic": 0, "run-one": 0,
+	"timeout": 1, "flock": 1,
+}
+
+// commandWords returns the program and arguments of the command a crontab entry runs,
+// skipping "cd dir &&" prefixes, variable assignments, wrappers and redirections
+func commandWords(command string) []string {
+	command, _, _ = strings.Cut(command, "%") // cron passes what follows % on stdin
+	segments := strings.FieldsFunc(command, func(r rune) bool { return r == ';' || r == '|' || r == '&' })
+
+	for _, segment := range segments {
+		words := strings.Fields(segment)
+		for len(words) > 0 {
+			if strings.Contains(words[0], "=") {
+				words = words[1:]
+				continue
+			}
+			positional, wrapper := commandWrappers[path.Base(words[0])]
+			if !wrapper {
+				break
+			}
+			words = skipWrapperArgs(words[1:], positional)
+		}
+		if len(words) == 0 || words[0] == "cd" {
+			continue
+		}
+
+		var out []string
+		for _, word := range words {
+			if strings.ContainsAny(word, "<>") {
+				break
+			}
+			out = append(out, word)
+		}
+		if len(out) > 0 {
+			return out
+		}
+	}
+	return strings.Fields(command)
+}
+
+// skipWrapperArgs drops a wrapper's flags (and the values of short flags like -n 10) and positional arguments
+func skipWrapperArgs(words []string, positional int) []string {
+	for len(words) > 0 && strings.HasPrefix(words[0], "-") {
+		if len(words[0]) == 2 && len(words) > 1 {
+			words = words[1:]
+		}
+		words = words[1:]
+	}
+	return words[min(positional, len(words)):]
+}
+
+// commandName is a readable name for a check running command
+func commandName(command string) string {
+	words := commandWords(command)
+	if len(words) > 0 {
+		words[0] = path.Base(words[0])
+	}
+	name := strings.Join(words, " ")
+	if len(name) > 100 {
+		name = name[:100]
+	}
+	return name
+}
+
+// CommandSlug derives a slug from the program and its first non-flag arguments, as ParseCrontab
+// does for the checks it creates
+func CommandSlug(command string) string {
+	var parts []string
+	for i, word := range commandWords(command) {
+		if i > 0 && strings.HasPrefix(word, "-") {
+			continue
+		}
+		word = path.Base(word)
+		if i == 0 {
+			word = strings.TrimSuffix(word, path.Ext(word))
+		}
+		parts = append(parts, word)
+		if len(parts) == 3 {
+			break
+		}
+	}
+	return slugify(strings.Join(parts, " "))
+}
+
+// slugify lowercases s and replaces everything but letters and digits with single dashes
+func slugify(s string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(s) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		dash = true
+	}
+	out := b.String()
+	if len(out) > 60 {
+		out = strings.TrimRight(out[:60], "-")
+	}
+	if out == "" {
+		out = "job"
+	}
+	return out
+}
+
+// uniqueSlug suffixes slug with -2, -3, ... when it has been used before
+func uniqueSlug(seen map[string]int, slug string) string {
+	seen[slug]++
+	if n := seen[slug]; n > 1 {
+		return uniqueSlug(seen, slug+"-"+strconv.Itoa(n))
+	}
+	return slug
+}
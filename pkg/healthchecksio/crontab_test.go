@@ -0,0 +1,93 @@
+package healthchecksio
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCrontab(t *testing.T) {
+	crontab := `# m h dom mon dow command
+MAILTO=ops@example.com
+0 3 * * * /usr/local/bin/backup.sh --full > /var/log/backup.log 2>&1
+
+CRON_TZ=America/Chicago
+*/15 9-17 * * mon-fri cd /srv/app && ./bin/report daily
+@daily nice -n 10 /usr/bin/python3 /opt/jobs/cleanup.py
+@reboot /usr/local/bin/warm-cache
+30 4 * * 0 /usr/local/bin/backup.sh --incremental
+`
+	checks, err := ParseCrontab(strings.NewReader(crontab), CrontabOptions{
+		Tags:   "crontab db-1",
+		Source: "root@db-1",
+	})
+	require.NoError(t, err)
+	require.Len(t, checks, 4)
+
+	require.Equal(t, "backup", checks[0].Slug)
+	require.Equal(t, "backup.sh --full", checks[0].Name)
+	require.Equal(t, "0 3 * * *", checks[0].Schedule)
+	require.Empty(t, checks[0].Timezone)
+	require.Equal(t, "crontab db-1", checks[0].Tags)
+	require.Equal(t, "Imported from root@db-1 line 3: 0 3 * * * /usr/local/bin/backup.sh --full > /var/log/backup.log 2>&1", checks[0].Description)
+
+	require.Equal(t, "report-daily", checks[1].Slug)
+	require.Equal(t, "*/15 9-17 * * mon-fri", checks[1].Schedule)
+	require.Equal(t, "America/Chicago", checks[1].Timezone)
+
+	require.Equal(t, "python3-cleanup-py", checks[2].Slug)
+	require.Equal(t, "0 0 * * *", checks[2].Schedule)
+
+	require.Equal(t, "backup-2", checks[3].Slug)
+	require.Equal(t, "America/Chicago", checks[3].Timezone)
+}
+
+func TestParseCrontab_System(t *testing.T) {
+	crontab := "17 * * * * root cd / && run-parts --report /etc/cron.hourly\n" +
+		"0 5 * * * postgres timeout 1h /usr/bin/vacuumdb --all\n"
+
+	checks, err := ParseCrontab(strings.NewReader(crontab), CrontabOptions{System: true, Timezone: "UTC"})
+	require.NoError(t, err)
+	require.Len(t, checks, 2)
+
+	require.Equal(t, "run-parts-cron-hourly", checks[0].Slug)
+	require.Contains(t, checks[0].Description, "Runs as root")
+	require.Equal(t, "UTC", checks[0].Timezone)
+	require.Equal(t, "vacuumdb", checks[1].Slug)
+}
+
+func TestParseCrontab_Errors(t *testing.T) {
+	_, err := ParseCrontab(strings.NewReader("61 * * * * /bin/true\n"), CrontabOptions{})
+	require.ErrorContains(t, err, "crontab line 1: cron")
+
+	_, err = ParseCrontab(strings.NewReader("@sometimes /bin/true\n"), CrontabOptions{})
+	require.EqualError(t, err, "crontab line 1: unknown schedule @sometimes")
+
+	_, err = ParseCrontab(strings.NewReader("* * * * *\n"), CrontabOptions{})
+	require.Error(t, err)
+
+	_, err = ParseCrontab(strings.NewReader("0 * * * * root\n"), CrontabOptions{System: true})
+	require.EqualError(t, err, "crontab line 1: missing command")
+}
+
+func TestParseCrontab_Whitespace(t *testing.T) {
+	checks, err := ParseCrontab(strings.NewReader("0\v3 *\f* *\t/usr/local/bin/backup.sh\n@daily\t/usr/local/bin/cleanup.sh\n"), CrontabOptions{})
+	require.NoError(t, err)
+	require.Len(t, checks, 2)
+
+	require.Equal(t, "0 3 * * *", checks[0].Schedule)
+	require.Equal(t, "backup", checks[0].Slug)
+	require.Equal(t, "0 0 * * *", checks[1].Schedule)
+	require.Equal(t, "cleanup", checks[1].Slug)
+}
+
+func TestParseCrontab_SystemTabs(t *testing.T) {
+	checks, err := ParseCrontab(strings.NewReader("0 3 * * *\troot\t/usr/local/bin/backup.sh --full\n"), CrontabOptions{System: true})
+	require.NoError(t, err)
+	require.Len(t, checks, 1)
+
+	require.Equal(t, "backup", checks[0].Slug)
+	require.Equal(t, "backup.sh --full", checks[0].Name)
+	require.True(t, strings.HasSuffix(checks[0].Description, "\nRuns as root"))
+}
@@ -0,0 +1,121 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// CutoverOptions configures StartCutover
+type CutoverOptions struct {
+	// Replacement configures the new check like CloneCheck's overrides: non-zero fields replace
+	// the old check's values. It must set a new slug, which is how a restarted cutover finds it.
+	Replacement CreateCheck
+
+	// Pings is how many successful pings the new check must receive before the old check is
+	// retired, defaults to 3
+	Pings int
+
+	// Delete deletes the old check instead of pausing it
+	Delete bool
+
+	// ConfirmDeletes confirms deleting the old check for WithDeleteProtection's RequireConfirmation,
+	// checks with protected tags are still refused
+	ConfirmDeletes bool
+}
+
+// Cutover is a blue/green migration from a check to its replacement, e.g. when a job's
+// schedule or owner changes. Both checks run in parallel until the new one has received
+// enough successful pings, then the old one is paused (or deleted).
+type Cutover struct {
+	client  ManagementClient
+	opts    CutoverOptions
+	retired bool
+
+	Old Check
+	New Check
+}
+
+// StartCutover creates the replacement for the check with uuid, or finds it when a check with
+// opts.Replacement's slug already exists so a cutover can be resumed after a restart.
+func StartCutover(ctx context.Context, client ManagementClient, uuid string, opts CutoverOptions) (*Cutover, error) {
+	if opts.Replacement.Slug == "" {
+		return nil, errors.New("start cutover: the replacement needs a slug")
+	}
+	if opts.Pings <= 0 {
+		opts.Pings = 3
+	}
+
+	old, err := client.GetCheck(ctx, uuid)
+	if err != nil {
+		return nil, fmt.Errorf("start cutover: %w", err)
+	}
+
+	replacement, err := GetChecksBySlugExact(ctx, client, opts.Replacement.Slug)
+	if errors.Is(err, ErrNotFound) {
+		replacement, err = CloneCheck(ctx, client, uuid, opts.Replacement)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("start cutover: %w", err)
+	}
+
+	return &Cutover{
+		client: client,
+		opts:   opts,
+		Old:    *old,
+		New:    *replacement,
+	}, nil
+}
+
+// Advance counts the new check's successful pings and retires the old check once there are
+// enough, reporting whether the cutover is complete. It's safe to call again after completing.
+func (c *Cutover) Advance(ctx context.Context) (bool, error) {
+	if c.retired {
+		return true, nil
+	}
+
+	pings, err := c.client.GetPings(ctx, c.New.UUID)
+	if err != nil {
+		return false, fmt.Errorf("cutover %s: %w", c.New.Slug, err)
+	}
+
+	var successes int
+	for _, ping := range pings.Pings {
+		if ping.Type == "success" {
+			successes++
+		}
+	}
+	if successes < c.opts.Pings {
+		return false, nil
+	}
+
+	switch {
+	case c.opts.Delete:
+		_, err = c.client.DeleteCheck(ctx, c.Old.UUID, confirmDelete(c.opts.ConfirmDeletes, c.Old)...)
+	case CheckStatus(c.Old.Status) != StatusPaused:
+		var paused *Check
+		paused, err = c.client.PauseCheck(ctx, c.Old.UUID)
+		if err == nil {
+			c.Old = *paused
+		}
+	}
+	if err != nil {
+		return false, fmt.Errorf("cutover %s: retiring %s: %w", c.New.Slug, c.Old.Slug, err)
+	}
+	c.retired = true
+	return true, nil
+}
+
+// Wait calls Advance every interval until the cutover completes or ctx is done
+func (c *Cutover) Wait(ctx context.Context, interval time.Duration) error {
+	for {
+		done, err := c.Advance(ctx)
+		if err != nil || done {
+			return err
+		}
+		if err := sleepUntil(ctx, time.Now().Add(interval)); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package healthchecksio
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCutover(t *testing.T) {
+	client := &memoryClient{checks: []Check{
+		{UUID: "old", Name: "Backup", Slug: "backup", Schedule: "0 3 * * *", Grace: 3600, Status: "up"},
+	}}
+	ctx := context.Background()
+
+	_, err := StartCutover(ctx, client, "old", CutoverOptions{})
+	require.ErrorContains(t, err, "needs a slug")
+
+	opts := CutoverOptions{
+		Replacement: CreateCheck{Slug: "backup-v2", Schedule: "0 4 * * *"},
+		Pings:       2,
+	}
+	cutover, err := StartCutover(ctx, client, "old", opts)
+	require.NoError(t, err)
+	require.Equal(t, "backup-v2", cutover.New.Slug)
+	require.Equal(t, "0 4 * * *", cutover.New.Schedule)
+	require.Equal(t, 3600, cutover.New.Grace)
+
+	// Restarts find the replacement instead of creating another
+	again, err := StartCutover(ctx, client, "old", opts)
+	require.NoError(t, err)
+	require.Equal(t, cutover.New.UUID, again.New.UUID)
+	require.Len(t, client.checks, 2)
+
+	client.pings = map[string][]Ping{
+		cutover.New.UUID: {{Type: "start"}, {Type: "success"}, {Type: "fail"}},
+	}
+	done, err := cutover.Advance(ctx)
+	require.NoError(t, err)
+	require.False(t, done)
+
+	client.pings[cutover.New.UUID] = append(client.pings[cutover.New.UUID], Ping{Type: "success"})
+	done, err = cutover.Advance(ctx)
+	require.NoError(t, err)
+	require.True(t, done)
+	require.Equal(t, "paused", client.checks[0].Status)
+
+	done, err = cutover.Advance(ctx)
+	require.NoError(t, err)
+	require.True(t, done)
+	require.Equal(t, []string{"create backup-v2", "pause backup"}, client.calls)
+}
+
+func TestCutoverDelete(t *testing.T) {
+	client := &memoryClient{checks: []Check{
+		{UUID: "old", Slug: "backup", Timeout: 86400, Status: "up"},
+	}}
+	ctx := context.Background()
+
+	cutover, err := StartCutover(ctx, client, "old", CutoverOptions{
+		Replacement: CreateCheck{Slug: "backup-v2"},
+		Pings:       1,
+		Delete:      true,
+	})
+	require.NoError(t, err)
+
+	client.pings = map[string][]Ping{cutover.New.UUID: {{Type: "success"}}}
+	require.NoError(t, cutover.Wait(ctx, 0))
+	require.Len(t, client.checks, 1)
+	require.Equal(t, "backup-v2", client.checks[0].Slug)
+}
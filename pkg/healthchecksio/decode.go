@@ -0,0 +1,57 @@
+package healthchecksio
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// decode reads a JSON response body into v, rejecting unmodeled fields when strict decoding is enabled
+func (c *client) decode(r io.Reader, v any) error {
+	if c.codec != nil {
+		bs, err := io.ReadAll(r)
+		if err != nil {
+			return fmt.Errorf("decoding response: %w", err)
+		}
+		if err := c.codec.Unmarshal(bs, v); err != nil {
+			return fmt.Errorf("decoding response: %w", err)
+		}
+	} else {
+		dec := json.NewDecoder(r)
+		if c.strictDecoding {
+			dec.DisallowUnknownFields()
+		}
+		if err := dec.Decode(v); err != nil {
+			return fmt.Errorf("decoding response: %w", err)
+		}
+	}
+
+	if c.strictDecoding {
+		// Check has a custom UnmarshalJSON so DisallowUnknownFields doesn't reach it
+		if fields := unknownFields(v); len(fields) > 0 {
+			return fmt.Errorf("decoding response: unknown fields %v", fields)
+		}
+	}
+	return nil
+}
+
+func unknownFields(v any) []string {
+	var out []string
+	switch vv := v.(type) {
+	case *Check:
+		for key := range vv.Unknown {
+			out = append(out, key)
+		}
+	case *CheckListResponse:
+		for i := range vv.Checks {
+			out = append(out, unknownFields(&vv.Checks[i])...)
+		}
+	case *checkListPage:
+		for i := range vv.Checks {
+			out = append(out, unknownFields(&vv.Checks[i])...)
+		}
+	}
+	slices.Sort(out)
+	return slices.Compact(out)
+}
@@ -0,0 +1,50 @@
+package healthchecksio
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecode_Strict(t *testing.T) {
+	lenient := &client{}
+	strict := &client{strictDecoding: true}
+
+	t.Run("check", func(t *testing.T) {
+		input := `{"name":"a","new_field":1}`
+
+		var check Check
+		require.NoError(t, lenient.decode(strings.NewReader(input), &check))
+		require.Equal(t, "a", check.Name)
+
+		err := strict.decode(strings.NewReader(input), &check)
+		require.ErrorContains(t, err, "unknown fields [new_field]")
+	})
+
+	t.Run("check list", func(t *testing.T) {
+		input := `{"checks":[{"name":"a"},{"name":"b","new_field":1}]}`
+
+		var list CheckListResponse
+		require.NoError(t, lenient.decode(strings.NewReader(input), &list))
+
+		err := strict.decode(strings.NewReader(input), &list)
+		require.ErrorContains(t, err, "unknown fields [new_field]")
+	})
+
+	t.Run("pings", func(t *testing.T) {
+		input := `{"pings":[{"type":"success","n":1,"new_field":1}]}`
+
+		var list PingListResponse
+		require.NoError(t, lenient.decode(strings.NewReader(input), &list))
+
+		err := strict.decode(strings.NewReader(input), &list)
+		require.ErrorContains(t, err, `unknown field "new_field"`)
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+		var check Check
+		err := strict.decode(strings.NewReader(`{"grace":"sixty"}`), &check)
+		require.Error(t, err)
+	})
+}
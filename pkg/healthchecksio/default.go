@@ -0,0 +1,54 @@
+package healthchecksio
+
+import (
+	"os"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	defaultMu     sync.Mutex
+	defaultClient atomic.Pointer[Client]
+)
+
+// Default returns the client shared by the whole process, so call sites don't need one
+// passed through every constructor. Unless SetDefault was called, it's created on first
+// use from $HEALTHCHECKS_API_KEY and $HEALTHCHECKS_BASE_URL. Default is safe for
+// concurrent use and every caller gets the same client.
+func Default() Client {
+	if c := defaultClient.Load(); c != nil {
+		return *c
+	}
+
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+
+	if c := defaultClient.Load(); c != nil {
+		return *c
+	}
+	c := clientFromEnv()
+	defaultClient.Store(&c)
+	return c
+}
+
+// SetDefault replaces the client returned by Default, typically once at startup with a
+// configured client. The previous client isn't closed. Setting nil makes the next Default
+// call create a client from the environment again.
+func SetDefault(client Client) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+
+	if client == nil {
+		defaultClient.Store(nil)
+		return
+	}
+	defaultClient.Store(&client)
+}
+
+func clientFromEnv() Client {
+	var opts []ClientOption
+	if baseURL := os.Getenv("HEALTHCHECKS_BASE_URL"); baseURL != "" {
+		opts = append(opts, WithBaseURL(baseURL))
+	}
+	return NewClient(os.Getenv("HEALTHCHECKS_API_KEY"), opts...)
+}
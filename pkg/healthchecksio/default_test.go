@@ -0,0 +1,42 @@
+package healthchecksio
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefault(t *testing.T) {
+	t.Setenv("HEALTHCHECKS_API_KEY", "env-key")
+	t.Setenv("HEALTHCHECKS_BASE_URL", "https://hc.example.com/api/v3")
+	SetDefault(nil)
+	t.Cleanup(func() { SetDefault(nil) })
+
+	// Concurrent first calls share one client
+	clients := make([]Client, 8)
+	var wg sync.WaitGroup
+	for i := range clients {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			clients[i] = Default()
+		}()
+	}
+	wg.Wait()
+	for _, c := range clients {
+		require.Same(t, clients[0], c)
+	}
+
+	c := clients[0].(*client)
+	require.Equal(t, "env-key", c.apiKey)
+	require.Equal(t, "https://hc.example.com/api/v3", c.baseURL)
+
+	configured := NewClient("configured")
+	SetDefault(configured)
+	require.Same(t, configured, Default())
+
+	SetDefault(nil)
+	require.NotSame(t, configured, Default())
+	require.Equal(t, "env-key", Default().(*client).apiKey)
+}
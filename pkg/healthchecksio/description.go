@@ -0,0 +1,134 @@
+package healthchecksio
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+	"text/template"
+)
+
+// DefaultDescriptionTemplate renders the check's own description followed by a "Key: value"
+// line for each of the service, repo and runbook variables which are set. The runbook line is
+// the one OwnershipOf reads, the owner variable is recorded as a tag by Apply instead.
+const DefaultDescriptionTemplate = `{{.desc}}
+
+{{with .service}}Service: {{.}}
+{{end}}{{with .repo}}Repo: {{.}}
+{{end}}{{with .runbook}}Runbook: {{.}}
+{{end}}`
+
+// DescriptionTemplate renders check descriptions from a text/template and variables, so
+// descriptions stay consistent (and parseable by DescriptionFields) across many checks
+type DescriptionTemplate struct {
+	tmpl *template.Template
+}
+
+// ParseDescriptionTemplate parses text, an empty text is DefaultDescriptionTemplate.
+// Variables are strings referenced as {{.service}}, missing ones render empty.
+func ParseDescriptionTemplate(text string) (*DescriptionTemplate, error) {
+	if text == "" {
+		text = DefaultDescriptionTemplate
+	}
+	tmpl, err := template.New("desc").Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("description template: %w", err)
+	}
+	return &DescriptionTemplate{tmpl: tmpl}, nil
+}
+
+// Render executes the template with vars. Trailing space is trimmed from every line and
+// runs of blank lines are collapsed, so optional sections can leave gaps.
+func (t *DescriptionTemplate) Render(vars map[string]string) (string, error) {
+	var buf bytes.Buffer
+	if err := t.tmpl.Execute(&buf, vars); err != nil {
+		return "", fmt.Errorf("description template: %w", err)
+	}
+
+	var lines []string
+	for line := range strings.Lines(buf.String()) {
+		line = strings.TrimRight(line, " \t\r\n")
+		if line == "" && (len(lines) == 0 || lines[len(lines)-1] == "") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n")), nil
+}
+
+// Apply returns check with its description rendered from vars along with the check's
+// name, slug, tags and desc (its description before rendering). vars take precedence.
+// An owner variable is recorded through Ownership.Apply, so OwnershipOf and
+// ValidateOwnership see it.
+func (t *DescriptionTemplate) Apply(check CreateCheck, vars map[string]string) (CreateCheck, error) {
+	all := map[string]string{
+		"name": check.Name,
+		"slug": check.Slug,
+		"tags": check.Tags,
+		"desc": check.Description,
+	}
+	maps.Copy(all, vars)
+
+	desc, err := t.Render(all)
+	if err != nil {
+		return check, fmt.Errorf("%s: %w", check.Slug, err)
+	}
+	check.Description = desc
+	return Ownership{Owner: vars["owner"]}.Apply(check), nil
+}
+
+// DescriptionFields returns the "Key: value" lines of desc keyed by their lowercased key,
+// e.g. service, owner and runbook from a description rendered by DefaultDescriptionTemplate.
+// The first line with a key wins.
+func DescriptionFields(desc string) map[string]string {
+	fields := make(map[string]string)
+	for line := range strings.Lines(desc) {
+		key, value, found := strings.Cut(strings.TrimSpace(line), ": ")
+		if !found || key == "" || strings.ContainsAny(key, " \t") {
+			continue
+		}
+		key = strings.ToLower(key)
+		if _, exists := fields[key]; !exists {
+			fields[key] = strings.TrimSpace(value)
+		}
+	}
+	return fields
+}
+
+// ManifestDescriptions renders the descriptions of a manifest's checks, see DescriptionTemplate
+type ManifestDescriptions struct {
+	// Template defaults to DefaultDescriptionTemplate
+	Template string `json:"template,omitempty"`
+
+	// Vars are shared by every check, e.g. the repo
+	Vars map[string]string `json:"vars,omitempty"`
+
+	// Checks are each check's variables by slug, over Vars
+	Checks map[string]map[string]string `json:"checks,omitempty"`
+}
+
+// Apply returns checks with their descriptions rendered. Errors rendering each check are
+// joined together.
+func (d *ManifestDescriptions) Apply(checks []CreateCheck) ([]CreateCheck, error) {
+	tmpl, err := ParseDescriptionTemplate(d.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]CreateCheck, len(checks))
+	var errs []error
+	for i, check := range checks {
+		vars := maps.Clone(d.Vars)
+		if vars == nil {
+			vars = make(map[string]string)
+		}
+		maps.Copy(vars, d.Checks[check.Slug])
+
+		out[i], err = tmpl.Apply(check, vars)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return out, errors.Join(errs...)
+}
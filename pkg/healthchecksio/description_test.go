@@ -0,0 +1,75 @@
+package healthchecksio
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescriptionTemplate(t *testing.T) {
+	tmpl, err := ParseDescriptionTemplate("")
+	require.NoError(t, err)
+
+	check, err := tmpl.Apply(CreateCheck{Slug: "backup", Description: "Runs pg_dump", Tags: "db"}, map[string]string{
+		"service": "billing",
+		"owner":   "alice",
+		"runbook": "https://wiki/backups",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Runs pg_dump\n\nService: billing\nRunbook: https://wiki/backups", check.Description)
+	require.Equal(t, "db owner:alice", check.Tags)
+	require.Equal(t, Ownership{Owner: "alice", Runbook: "https://wiki/backups"}, ownershipOf(check.Tags, check.Description))
+	require.Equal(t, map[string]string{"service": "billing", "runbook": "https://wiki/backups"}, DescriptionFields(check.Description))
+
+	// Nothing to render leaves no blank lines behind
+	check, err = tmpl.Apply(CreateCheck{Slug: "backup"}, nil)
+	require.NoError(t, err)
+	require.Empty(t, check.Description)
+
+	tmpl, err = ParseDescriptionTemplate("{{.name}} for {{.service}}\n\n\n  \nRepo: {{.repo}}  ")
+	require.NoError(t, err)
+	desc, err := tmpl.Render(map[string]string{"name": "Backup", "service": "billing"})
+	require.NoError(t, err)
+	require.Equal(t, "Backup for billing\n\nRepo:", desc)
+
+	_, err = ParseDescriptionTemplate("{{.service")
+	require.ErrorContains(t, err, "description template:")
+}
+
+func TestManifestDescriptions(t *testing.T) {
+	descriptions := &ManifestDescriptions{
+		Template: "{{.name}}\nService: {{.service}}\nRepo: {{.repo}}",
+		Vars:     map[string]string{"service": "platform", "repo": "github.com/acme/jobs"},
+		Checks:   map[string]map[string]string{"backup": {"service": "billing"}},
+	}
+	checks, err := descriptions.Apply([]CreateCheck{{Slug: "backup", Name: "Backup"}, {Slug: "reports", Name: "Reports"}})
+	require.NoError(t, err)
+	require.Equal(t, "Backup\nService: billing\nRepo: github.com/acme/jobs", checks[0].Description)
+	require.Equal(t, "Reports\nService: platform\nRepo: github.com/acme/jobs", checks[1].Description)
+
+	// Descriptions are rendered before namespacing and don't drift on the next sync
+	mock := &memoryClient{}
+	desired := []CreateCheck{{Slug: "backup", Name: "Backup"}}
+	opts := SyncOptions{Descriptions: descriptions, Namespace: EnvironmentNamespace("prod")}
+
+	_, err = Sync(context.Background(), mock, desired, opts)
+	require.NoError(t, err)
+	require.Equal(t, "Backup\nService: billing\nRepo: github.com/acme/jobs", mock.checks[0].Desc)
+	require.Empty(t, desired[0].Description)
+
+	plan, err := PlanSync(context.Background(), mock, desired, opts)
+	require.NoError(t, err)
+	require.Empty(t, plan.Pending())
+
+	// An owner variable satisfies RequireOwner
+	descriptions.Checks["backup"]["owner"] = "alice"
+	opts.RequireOwner = true
+	plan, err = PlanSync(context.Background(), mock, desired, opts)
+	require.NoError(t, err)
+	require.Equal(t, "owner:alice env:prod", plan.Pending()[0].Desired.Tags)
+
+	descriptions.Template = "{{.service.owner}}"
+	_, err = PlanSync(context.Background(), mock, desired, opts)
+	require.ErrorContains(t, err, "plan sync: backup: description template:")
+}
@@ -0,0 +1,55 @@
+package healthchecksio
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+)
+
+// Do sends a request to an arbitrary v3 API path (e.g. "/channels/" or "/checks/?tag=prod")
+// using the client's authentication, retries, telemetry and error handling.
+//
+// body may be nil, a json.RawMessage or []byte which is sent as-is, or any value which is JSON encoded.
+// out may be nil to discard the response, a *[]byte or *string to receive the raw body,
+// or any value the JSON response is decoded into.
+func (c *client) Do(ctx context.Context, method, path string, body, out any, opts ...CallOption) error {
+	ctx, span := c.startSpan(ctx, "do",
+		attr("http.method", method),
+		attr("http.path", path),
+	)
+	defer span.End()
+
+	rel, err := url.Parse(path)
+	if err != nil {
+		return fmt.Errorf("%s %s: parsing path: %w", method, path, err)
+	}
+
+	resp, err := c.doRequest(ctx, apiRequest{
+		name:     method + " " + path,
+		endpoint: "do",
+		method:   method,
+		path:     []string{rel.Path},
+		query:    rel.Query(),
+		body:     body,
+		opts:     opts,
+	})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch o := out.(type) {
+	case nil:
+		_, err = io.Copy(io.Discard, resp.Body)
+		return err
+	case *[]byte:
+		*o, err = io.ReadAll(resp.Body)
+		return err
+	case *string:
+		bs, err := io.ReadAll(resp.Body)
+		*o = string(bs)
+		return err
+	}
+	return c.decode(resp.Body, out)
+}
@@ -0,0 +1,81 @@
+package healthchecksio_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Do(t *testing.T) {
+	ctx := context.Background()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Api-Key") != "key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error":"missing api key"}`))
+			return
+		}
+
+		switch r.Method + " " + r.URL.RequestURI() {
+		case "GET /api/v3/channels/":
+			w.Write([]byte(`{"channels":[{"id":"abc","name":"Email","kind":"email"}]}`))
+
+		case "GET /api/v3/checks/?tag=prod":
+			w.Write([]byte(`{"checks":[]}`))
+
+		case "POST /api/v3/checks/abc":
+			require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			bs, _ := io.ReadAll(r.Body)
+			w.Write(bs)
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":"not found"}`))
+		}
+	}))
+	defer srv.Close()
+
+	client := healthchecksio.NewClient("key", healthchecksio.WithBaseURL(srv.URL+"/api/v3"))
+
+	t.Run("decode", func(t *testing.T) {
+		var out struct {
+			Channels []struct {
+				ID   string `json:"id"`
+				Name string `json:"name"`
+			} `json:"channels"`
+		}
+		require.NoError(t, client.Do(ctx, "GET", "/channels/", nil, &out))
+		require.Len(t, out.Channels, 1)
+		require.Equal(t, "Email", out.Channels[0].Name)
+	})
+
+	t.Run("query", func(t *testing.T) {
+		var raw string
+		require.NoError(t, client.Do(ctx, "GET", "/checks/?tag=prod", nil, &raw))
+		require.Equal(t, `{"checks":[]}`, raw)
+	})
+
+	t.Run("body", func(t *testing.T) {
+		var raw []byte
+		require.NoError(t, client.Do(ctx, "POST", "/checks/abc", map[string]int{"grace": 60}, &raw))
+		require.JSONEq(t, `{"grace":60}`, string(raw))
+
+		require.NoError(t, client.Do(ctx, "POST", "/checks/abc", json.RawMessage(`{"timeout":5}`), &raw))
+		require.JSONEq(t, `{"timeout":5}`, string(raw))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		err := client.Do(ctx, "GET", "/missing", nil, nil)
+		require.ErrorContains(t, err, "GET /missing failed with 404: not found")
+
+		err = client.Do(ctx, "GET", "/channels/", nil, nil, healthchecksio.WithAPIKey("other"))
+		require.ErrorContains(t, err, "failed with 401: missing api key")
+	})
+}
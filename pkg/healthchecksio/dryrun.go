@@ -0,0 +1,95 @@
+package healthchecksio
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// WithDryRun makes CreateCheck, UpdateCheck, DeleteCheck, PauseCheck and ResumeCheck (and
+// their Raw variants) log what they would do instead of calling the API. They return
+// synthesized results built from the request and the check's current state, which is still read.
+//
+// Requests made with Do and pings are sent as usual.
+func WithDryRun() ClientOption {
+	return func(c *client) {
+		c.dryRun = true
+	}
+}
+
+// mutatingEndpoints are the endpoints which modify checks
+var mutatingEndpoints = map[string]bool{
+	"create-check": true,
+	"update-check": true,
+	"delete-check": true,
+	"pause-check":  true,
+	"resume-check": true,
+}
+
+// dryRunResponse returns the synthesized response for a mutating request
+func (c *client) dryRunResponse(req *http.Request, endpoint string) (*http.Response, error) {
+	body, err := requestBody(req)
+	if err != nil {
+		return nil, fmt.Errorf("dry run %s: %w", endpoint, err)
+	}
+	slog.InfoContext(req.Context(), "healthchecksio dry run",
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.String("body", string(body)),
+	)
+
+	var check Check
+	status := http.StatusOK
+	if endpoint == "create-check" {
+		status = http.StatusCreated
+		check.UUID = uuid.NewString()
+		check.Status = string(StatusNew)
+	} else {
+		current, err := c.GetCheck(req.Context(), checkIDFromPath(req.URL.Path), WithAPIKey(req.Header.Get("X-Api-Key")))
+		if err != nil {
+			return nil, fmt.Errorf("dry run %s: %w", endpoint, err)
+		}
+		check = *current
+	}
+	check.Raw, check.Unknown = nil, nil
+
+	switch endpoint {
+	case "create-check", "update-check":
+		if len(body) > 0 {
+			if err := json.Unmarshal(body, (*checkFields)(&check)); err != nil {
+				return nil, fmt.Errorf("dry run %s: %w", endpoint, err)
+			}
+		}
+	case "pause-check":
+		check.Status = string(StatusPaused)
+	case "resume-check":
+		check.Status = string(StatusNew)
+	}
+
+	bs, err := json.Marshal(check)
+	if err != nil {
+		return nil, fmt.Errorf("dry run %s: %w", endpoint, err)
+	}
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(bs)),
+		Request:    req,
+	}, nil
+}
+
+// checkIDFromPath returns the check UUID from a /checks/<uuid>[/action] path
+func checkIDFromPath(path string) string {
+	idx := strings.LastIndex(path, "/checks/")
+	if idx < 0 {
+		return ""
+	}
+	id, _, _ := strings.Cut(path[idx+len("/checks/"):], "/")
+	return id
+}
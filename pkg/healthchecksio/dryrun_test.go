@@ -0,0 +1,55 @@
+package healthchecksio_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithDryRun(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		require.Equal(t, "/api/v3/checks/abc", r.URL.Path)
+		require.Equal(t, "call-key", r.Header.Get("X-Api-Key"))
+		w.Write([]byte(`{"uuid":"abc","name":"Backup","slug":"backup","grace":60,"status":"up"}`))
+	}))
+	defer srv.Close()
+
+	client := healthchecksio.NewClient("key", healthchecksio.WithBaseURL(srv.URL+"/api/v3"), healthchecksio.WithDryRun())
+	ctx := context.Background()
+	callKey := healthchecksio.WithAPIKey("call-key")
+
+	created, err := client.CreateCheck(ctx, &healthchecksio.CreateCheck{Name: "Reports", Timeout: 3600}, callKey)
+	require.NoError(t, err)
+	require.NotEmpty(t, created.UUID)
+	require.Equal(t, "Reports", created.Name)
+	require.Equal(t, 3600, created.Timeout)
+	require.Equal(t, "new", created.Status)
+
+	updated, err := client.UpdateCheck(ctx, "abc", &healthchecksio.UpdateCheck{Grace: 300}, callKey)
+	require.NoError(t, err)
+	require.Equal(t, "Backup", updated.Name)
+	require.Equal(t, 300, updated.Grace)
+
+	paused, err := client.PauseCheck(ctx, "abc", callKey)
+	require.NoError(t, err)
+	require.Equal(t, "paused", paused.Status)
+
+	resumed, err := client.ResumeCheck(ctx, "abc", callKey)
+	require.NoError(t, err)
+	require.Equal(t, "new", resumed.Status)
+
+	deleted, err := client.DeleteCheck(ctx, "abc", callKey)
+	require.NoError(t, err)
+	require.Equal(t, "abc", deleted.UUID)
+	require.Equal(t, "up", deleted.Status)
+}
@@ -0,0 +1,100 @@
+package healthchecksio
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"time"
+)
+
+// RetryEngine repeats attempts of a request until one succeeds, it gives up or ctx is done.
+// The client decides which outcomes are retryable (see WithRetryPolicy), engines decide
+// how often and how long to wait between attempts. Do must return as soon as ctx is done,
+// including while waiting between attempts; the client never starts an attempt after that.
+type RetryEngine interface {
+	Do(ctx context.Context, attempt func(ctx context.Context) AttemptResult) (*http.Response, error)
+}
+
+// AttemptResult is the outcome of one attempt of a request
+type AttemptResult struct {
+	Response *http.Response
+	Err      error
+
+	// Retry reports whether the client's retry policies allow another attempt
+	Retry bool
+
+	// Wait is the delay the server asked for through Retry-After, zero to use the engine's own backoff
+	Wait time.Duration
+}
+
+// Discard drains and closes the attempt's response, engines call it before retrying
+func (r AttemptResult) Discard() {
+	if r.Response != nil {
+		io.Copy(io.Discard, io.LimitReader(r.Response.Body, 4096))
+		r.Response.Body.Close()
+	}
+}
+
+// WithRetryEngine replaces how requests are retried, defaults to ExponentialBackoff(5, 500ms, 4s)
+func WithRetryEngine(engine RetryEngine) ClientOption {
+	return func(c *client) {
+		c.retry = engine
+	}
+}
+
+// NoRetries sends every request once
+func NoRetries() RetryEngine {
+	return noRetries{}
+}
+
+type noRetries struct{}
+
+func (noRetries) Do(ctx context.Context, attempt func(ctx context.Context) AttemptResult) (*http.Response, error) {
+	result := attempt(ctx)
+	return result.Response, result.Err
+}
+
+// ExponentialBackoff retries up to max times, waiting waitMin doubled for each attempt
+// and capped at waitMax, or for as long as the server asks through Retry-After.
+// Cancelling ctx ends a wait immediately.
+func ExponentialBackoff(max int, waitMin, waitMax time.Duration) RetryEngine {
+	return &exponentialBackoff{
+		max:     max,
+		waitMin: waitMin,
+		waitMax: waitMax,
+	}
+}
+
+type exponentialBackoff struct {
+	max     int
+	waitMin time.Duration
+	waitMax time.Duration
+}
+
+func (e *exponentialBackoff) Do(ctx context.Context, attempt func(ctx context.Context) AttemptResult) (*http.Response, error) {
+	for i := 0; ; i++ {
+		result := attempt(ctx)
+		if !result.Retry || i >= e.max {
+			return result.Response, result.Err
+		}
+		result.Discard()
+
+		wait := result.Wait
+		if wait <= 0 {
+			wait = exponentialWait(e.waitMin, e.waitMax, i)
+		}
+		if err := sleepUntil(ctx, time.Now().Add(wait)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// exponentialWait returns waitMin doubled attempt times, capped at waitMax
+func exponentialWait(waitMin, waitMax time.Duration, attempt int) time.Duration {
+	wait := float64(waitMin) * math.Pow(2, float64(attempt))
+	if wait > float64(waitMax) || math.IsInf(wait, 0) {
+		return waitMax
+	}
+	return time.Duration(wait)
+}
@@ -0,0 +1,96 @@
+package healthchecksio
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// PingEnvelope is a machine readable ping body. Monitors with MonitorOptions.Structured and
+// `healthchecks run` send one, as do pings carrying metadata, so tooling can read failure
+// reasons with ParsePingEnvelope instead of scraping free text.
+//
+//	{"status":"fail","duration":12.5,"host":"web-1","error":"exit status 1","log":"pg_dump: connection refused"}
+type PingEnvelope struct {
+	// Status is the kind of ping: start, success, fail or log
+	Status string `json:"status,omitempty"`
+
+	// Duration is how long the job ran, in seconds
+	Duration float64 `json:"duration,omitempty"`
+
+	// Host is the hostname of the machine sending the ping
+	Host string `json:"host,omitempty"`
+
+	// Error describes why the job failed
+	Error string `json:"error,omitempty"`
+
+	// Log is the tail of the job's output
+	Log string `json:"log,omitempty"`
+
+	// Exit describes how the job's process exited
+	Exit *PingExit `json:"exit,omitempty"`
+
+	// Env holds selected environment variables of the job, with secrets redacted
+	Env map[string]string `json:"env,omitempty"`
+
+	// Metadata holds WithPingMetadata and ContextWithPingMetadata values
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// Body is the free form message the ping was sent with
+	Body string `json:"body,omitempty"`
+}
+
+// PingExit is how a process exited
+type PingExit struct {
+	// Code is the exit status, -1 when the process was killed by a signal
+	Code int `json:"code"`
+
+	// Signal names the signal which killed the process
+	Signal string `json:"signal,omitempty"`
+
+	// UserTime and SystemTime are the CPU time used by the process, in seconds
+	UserTime   float64 `json:"user_time"`
+	SystemTime float64 `json:"system_time"`
+}
+
+// String returns the envelope encoded as a ping body
+func (e PingEnvelope) String() string {
+	bs, _ := json.Marshal(e) // only strings, numbers and maps of strings
+	return string(bs)
+}
+
+// ParsePingEnvelope parses a ping body, e.g. from GetPingBody, as a PingEnvelope. It returns
+// false for plain text bodies and JSON which isn't an envelope.
+func ParsePingEnvelope(body string) (*PingEnvelope, bool) {
+	body = strings.TrimSpace(body)
+	if !strings.HasPrefix(body, "{") {
+		return nil, false
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(body), &fields); err != nil || len(fields) == 0 {
+		return nil, false
+	}
+	for name := range fields {
+		if !envelopeFields[name] {
+			return nil, false
+		}
+	}
+
+	var envelope PingEnvelope
+	if err := json.Unmarshal([]byte(body), &envelope); err != nil {
+		return nil, false
+	}
+	return &envelope, true
+}
+
+var envelopeFields = map[string]bool{
+	"status":   true,
+	"duration": true,
+	"host":     true,
+	"error":    true,
+	"log":      true,
+	"exit":     true,
+	"env":      true,
+	"metadata": true,
+	"body":     true,
+}
@@ -0,0 +1,57 @@
+package healthchecksio_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePingEnvelope(t *testing.T) {
+	envelope, ok := healthchecksio.ParsePingEnvelope(` {"status":"fail","duration":12.5,"host":"web-1","error":"exit status 1","log":"pg_dump: connection refused"}` + "\n")
+	require.True(t, ok)
+	require.Equal(t, &healthchecksio.PingEnvelope{
+		Status:   "fail",
+		Duration: 12.5,
+		Host:     "web-1",
+		Error:    "exit status 1",
+		Log:      "pg_dump: connection refused",
+	}, envelope)
+
+	for _, body := range []string{
+		"",
+		"backed up 12GB",
+		"{}",
+		`{"status": 200, "rows": 3}`, // JSON written by something else
+		`{"status": 200}`,
+		`{"status":`,
+	} {
+		_, ok := healthchecksio.ParsePingEnvelope(body)
+		require.False(t, ok, body)
+	}
+
+	body := healthchecksio.PingEnvelope{Status: "success", Body: "done"}.String()
+	require.Equal(t, `{"status":"success","body":"done"}`, body)
+}
+
+func TestPingEnvelopeMetadata(t *testing.T) {
+	bodies := make(chan string, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bs, _ := io.ReadAll(r.Body)
+		bodies <- string(bs)
+	}))
+	defer srv.Close()
+
+	client := healthchecksio.NewClient("", healthchecksio.WithPingMetadata(map[string]string{"version": "1.4.2"}))
+
+	// Envelopes get the metadata merged in rather than being wrapped again
+	body := healthchecksio.PingEnvelope{Status: "fail", Error: "boom"}.String()
+	_, err := client.Ping(context.Background(), srv.URL, body)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"status":"fail","error":"boom","metadata":{"version":"1.4.2"}}`, <-bodies)
+}
@@ -0,0 +1,98 @@
+package healthchecksio
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// ExportFormat is the output format of exported ping and flip history
+type ExportFormat string
+
+const (
+	ExportCSV       ExportFormat = "csv"
+	ExportJSONLines ExportFormat = "jsonl"
+)
+
+// ExportPings writes the ping history of a check to w in the given format
+func ExportPings(ctx context.Context, client CheckReader, identifier string, w io.Writer, format ExportFormat) error {
+	list, err := client.GetPings(ctx, identifier)
+	if err != nil {
+		return fmt.Errorf("export pings: %w", err)
+	}
+	return WritePings(w, list.Pings, format)
+}
+
+// ExportFlips writes the status flips of a check to w in the given format
+func ExportFlips(ctx context.Context, client CheckReader, identifier string, params GetFlipsRequest, w io.Writer, format ExportFormat) error {
+	list, err := client.GetFlips(ctx, identifier, params)
+	if err != nil {
+		return fmt.Errorf("export flips: %w", err)
+	}
+	return WriteFlips(w, list.Flips, format)
+}
+
+// WritePings writes pings to w in the given format
+func WritePings(w io.Writer, pings []Ping, format ExportFormat) error {
+	switch format {
+	case ExportCSV:
+		cw := csv.NewWriter(w)
+		cw.Write([]string{"n", "type", "date", "scheme", "remote_addr", "method", "ua", "rid", "duration", "body_url"})
+		for _, p := range pings {
+			var bodyURL string
+			if p.BodyURL != nil {
+				bodyURL = *p.BodyURL
+			}
+			cw.Write([]string{
+				strconv.Itoa(p.N),
+				p.Type,
+				p.Date.Format(time.RFC3339),
+				p.Scheme,
+				p.RemoteAddr,
+				p.Method,
+				p.Ua,
+				p.Rid,
+				strconv.FormatFloat(p.Duration, 'f', -1, 64),
+				bodyURL,
+			})
+		}
+		cw.Flush()
+		return cw.Error()
+
+	case ExportJSONLines:
+		return writeJSONLines(w, pings)
+	}
+	return fmt.Errorf("unknown export format %q", format)
+}
+
+// WriteFlips writes flips to w in the given format
+func WriteFlips(w io.Writer, flips []Flip, format ExportFormat) error {
+	switch format {
+	case ExportCSV:
+		cw := csv.NewWriter(w)
+		cw.Write([]string{"timestamp", "up"})
+		for _, f := range flips {
+			cw.Write([]string{f.Timestamp, strconv.Itoa(f.Up)})
+		}
+		cw.Flush()
+		return cw.Error()
+
+	case ExportJSONLines:
+		return writeJSONLines(w, flips)
+	}
+	return fmt.Errorf("unknown export format %q", format)
+}
+
+func writeJSONLines[T any](w io.Writer, items []T) error {
+	enc := json.NewEncoder(w)
+	for i := range items {
+		if err := enc.Encode(items[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package healthchecksio_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWritePings(t *testing.T) {
+	bodyURL := "https://healthchecks.io/api/v3/checks/abc/pings/2/body"
+	pings := []healthchecksio.Ping{
+		{
+			Type:     "success",
+			Date:     time.Date(2025, time.March, 1, 10, 0, 0, 0, time.UTC),
+			N:        1,
+			Method:   "POST",
+			Duration: 1.5,
+		},
+		{
+			Type:    "fail",
+			Date:    time.Date(2025, time.March, 1, 11, 0, 0, 0, time.UTC),
+			N:       2,
+			Ua:      "curl, with comma",
+			BodyURL: &bodyURL,
+		},
+	}
+
+	t.Run("csv", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, healthchecksio.WritePings(&buf, pings, healthchecksio.ExportCSV))
+
+		expected := "n,type,date,scheme,remote_addr,method,ua,rid,duration,body_url\n" +
+			"1,success,2025-03-01T10:00:00Z,,,POST,,,1.5,\n" +
+			"2,fail,2025-03-01T11:00:00Z,,,,\"curl, with comma\",,0," + bodyURL + "\n"
+		require.Equal(t, expected, buf.String())
+	})
+
+	t.Run("jsonl", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, healthchecksio.WritePings(&buf, pings, healthchecksio.ExportJSONLines))
+
+		lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+		require.Len(t, lines, 2)
+		require.Contains(t, string(lines[1]), `"type":"fail"`)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		err := healthchecksio.WritePings(&bytes.Buffer{}, pings, "xml")
+		require.ErrorContains(t, err, `unknown export format "xml"`)
+	})
+}
+
+func TestWriteFlips(t *testing.T) {
+	flips := []healthchecksio.Flip{
+		{Timestamp: "2025-03-01T10:00:00+00:00", Up: 1},
+		{Timestamp: "2025-03-01T11:00:00+00:00", Up: 0},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, healthchecksio.WriteFlips(&buf, flips, healthchecksio.ExportCSV))
+	require.Equal(t, "timestamp,up\n2025-03-01T10:00:00+00:00,1\n2025-03-01T11:00:00+00:00,0\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, healthchecksio.WriteFlips(&buf, flips, healthchecksio.ExportJSONLines))
+	require.Equal(t, "{\"timestamp\":\"2025-03-01T10:00:00+00:00\",\"up\":1}\n{\"timestamp\":\"2025-03-01T11:00:00+00:00\",\"up\":0}\n", buf.String())
+}
@@ -0,0 +1,107 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// GroupAction is an operation applied to a group of checks
+type GroupAction string
+
+const (
+	GroupPause  GroupAction = "pause"
+	GroupResume GroupAction = "resume"
+	GroupDelete GroupAction = "delete"
+)
+
+// SelectGroup lists the checks matching filter which action would change: pausing skips
+// checks which are already paused and resuming only includes paused checks.
+func SelectGroup(ctx context.Context, client CheckReader, action GroupAction, filter GetChecks) ([]Check, error) {
+	switch action {
+	case GroupPause, GroupResume, GroupDelete:
+	default:
+		return nil, fmt.Errorf("select group: unknown action %q", action)
+	}
+
+	list, err := client.GetChecks(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("select group: %w", err)
+	}
+
+	var out []Check
+	for _, check := range list.Checks {
+		paused := CheckStatus(check.Status) == StatusPaused
+		if (action == GroupPause && paused) || (action == GroupResume && !paused) {
+			continue
+		}
+		out = append(out, check)
+	}
+	return out, nil
+}
+
+// GroupOptions configures ApplyGroup
+type GroupOptions struct {
+	// Concurrency is how many requests are in flight at most, defaults to 4
+	Concurrency int
+
+	// ConfirmDeletes confirms GroupDelete for WithDeleteProtection's RequireConfirmation,
+	// checks with protected tags are still refused
+	ConfirmDeletes bool
+}
+
+// ApplyGroup performs action on every check. All checks are attempted and failures are joined together.
+func ApplyGroup(ctx context.Context, client CheckWriter, action GroupAction, checks []Check, opts GroupOptions) error {
+	var apply func(context.Context, string, ...CallOption) (*Check, error)
+	switch action {
+	case GroupPause:
+		apply = client.PauseCheck
+	case GroupResume:
+		apply = client.ResumeCheck
+	case GroupDelete:
+		apply = client.DeleteCheck
+	default:
+		return fmt.Errorf("apply group: unknown action %q", action)
+	}
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+
+	var (
+		mu   sync.Mutex
+		errs []error
+		wg   sync.WaitGroup
+		sem  = make(chan struct{}, concurrency)
+	)
+	for _, check := range checks {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			mu.Lock()
+			errs = append(errs, fmt.Errorf("%s %s: %w", action, check.Name, ctx.Err()))
+			mu.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			var callOpts []CallOption
+			if action == GroupDelete {
+				callOpts = confirmDelete(opts.ConfirmDeletes, check)
+			}
+			if _, err := apply(ctx, check.UUID, callOpts...); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("%s %s: %w", action, check.Name, err))
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
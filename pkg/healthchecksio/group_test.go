@@ -0,0 +1,54 @@
+package healthchecksio
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectGroup(t *testing.T) {
+	client := &memoryClient{checks: []Check{
+		{UUID: "1", Slug: "backup", Tags: "db", Status: "up"},
+		{UUID: "2", Slug: "vacuum", Tags: "db", Status: "paused"},
+		{UUID: "3", Slug: "web", Tags: "www", Status: "down"},
+	}}
+	ctx := context.Background()
+	filter := GetChecks{Tags: []string{"db"}}
+
+	slugs := func(action GroupAction) []string {
+		checks, err := SelectGroup(ctx, client, action, filter)
+		require.NoError(t, err)
+
+		var out []string
+		for _, check := range checks {
+			out = append(out, check.Slug)
+		}
+		return out
+	}
+	require.Equal(t, []string{"backup"}, slugs(GroupPause))
+	require.Equal(t, []string{"vacuum"}, slugs(GroupResume))
+	require.Equal(t, []string{"backup", "vacuum"}, slugs(GroupDelete))
+
+	_, err := SelectGroup(ctx, client, "archive", filter)
+	require.Error(t, err)
+}
+
+func TestApplyGroup(t *testing.T) {
+	client := &memoryClient{checks: []Check{
+		{UUID: "1", Slug: "backup", Status: "up"},
+		{UUID: "2", Slug: "vacuum", Status: "up"},
+	}}
+	ctx := context.Background()
+
+	checks := slices.Clone(client.checks)
+	checks = append(checks, Check{UUID: "missing", Name: "gone"})
+
+	err := ApplyGroup(ctx, client, GroupPause, checks, GroupOptions{Concurrency: 2})
+	require.ErrorContains(t, err, "pause gone")
+	require.ElementsMatch(t, []string{"pause backup", "pause vacuum"}, client.calls)
+
+	require.NoError(t, ApplyGroup(ctx, client, GroupDelete, checks[:2], GroupOptions{}))
+	require.Empty(t, client.checks)
+}
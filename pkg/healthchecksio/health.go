@@ -0,0 +1,154 @@
+package healthchecksio
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// HealthOptions configures ProjectHealth
+type HealthOptions struct {
+	// Filter limits which checks are scored
+	Filter GetChecks
+
+	// Weights are how much checks with a tag count towards the score, e.g. 10 for
+	// "severity:critical" and 0.5 for "severity:low". A check with several weighted tags
+	// counts with the largest weight, checks without one with DefaultWeight.
+	Weights map[string]float64
+
+	// DefaultWeight is the weight of checks without a weighted tag, defaults to 1
+	DefaultWeight float64
+
+	// StatusScores are how healthy a check in each status is, from 0 to 1. Checks in a status
+	// missing from the map aren't scored. Defaults to DefaultStatusScores.
+	StatusScores map[CheckStatus]float64
+}
+
+// DefaultStatusScores counts up and running checks as healthy, checks in their grace period as
+// half healthy and down checks as unhealthy. New and paused checks aren't scored.
+var DefaultStatusScores = map[CheckStatus]float64{
+	StatusUp:      1,
+	StatusStarted: 1,
+	StatusGrace:   0.5,
+	StatusDown:    0,
+}
+
+// HealthReport is a weighted health score of a project's checks
+type HealthReport struct {
+	// Score is the weighted average of the scored checks' status scores, from 0 to 1.
+	// It's 1 when no check is scored.
+	Score float64 `json:"score"`
+
+	// Scored and Excluded count the checks which were and weren't scored
+	Scored   int `json:"scored"`
+	Excluded int `json:"excluded"`
+
+	// Statuses counts the checks in each status, scored or not
+	Statuses map[CheckStatus]int `json:"statuses"`
+
+	// Groups break the score down by the tag which weighted each check, the checks counting
+	// with DefaultWeight are in the group with an empty tag. They're ordered by Impact.
+	Groups []HealthGroup `json:"groups"`
+}
+
+// HealthGroup is the score of the checks weighted by one tag
+type HealthGroup struct {
+	Tag    string  `json:"tag"`
+	Weight float64 `json:"weight"`
+	Checks int     `json:"checks"`
+
+	// Score is the average status score of the group's checks
+	Score float64 `json:"score"`
+
+	// Impact is how much the group lowers the project's score
+	Impact float64 `json:"impact"`
+
+	// Unhealthy are the names of the group's checks which scored less than 1
+	Unhealthy []string `json:"unhealthy"`
+}
+
+// ProjectHealth scores the checks matching opts.Filter, see ComputeHealth
+func ProjectHealth(ctx context.Context, client CheckReader, opts HealthOptions) (*HealthReport, error) {
+	checks, err := client.GetChecks(ctx, opts.Filter)
+	if err != nil {
+		return nil, fmt.Errorf("project health: %w", err)
+	}
+	return ComputeHealth(checks.Checks, opts), nil
+}
+
+// ComputeHealth scores checks: each contributes its status score, weighted by its tags
+func ComputeHealth(checks []Check, opts HealthOptions) *HealthReport {
+	if opts.DefaultWeight <= 0 {
+		opts.DefaultWeight = 1
+	}
+	if opts.StatusScores == nil {
+		opts.StatusScores = DefaultStatusScores
+	}
+
+	report := &HealthReport{Score: 1, Statuses: make(map[CheckStatus]int)}
+	groups := make(map[string]*HealthGroup)
+	sums := make(map[string]float64)
+	var total, healthy float64
+
+	for _, check := range checks {
+		status := CheckStatus(check.Status)
+		report.Statuses[status]++
+
+		score, scored := opts.StatusScores[status]
+		if !scored {
+			report.Excluded++
+			continue
+		}
+		report.Scored++
+
+		tag, weight := opts.weight(check)
+		group, exists := groups[tag]
+		if !exists {
+			group = &HealthGroup{Tag: tag, Weight: weight, Unhealthy: []string{}}
+			groups[tag] = group
+		}
+		group.Checks++
+		sums[tag] += score
+		if score < 1 {
+			group.Unhealthy = append(group.Unhealthy, check.Name)
+		}
+
+		total += weight
+		healthy += weight * score
+	}
+
+	if total > 0 {
+		report.Score = healthy / total
+	}
+	for tag, group := range groups {
+		group.Score = sums[tag] / float64(group.Checks)
+		if total > 0 {
+			group.Impact = group.Weight * float64(group.Checks) * (1 - group.Score) / total
+		}
+		report.Groups = append(report.Groups, *group)
+	}
+	slices.SortFunc(report.Groups, func(a, b HealthGroup) int {
+		if c := cmp.Compare(b.Impact, a.Impact); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Tag, b.Tag)
+	})
+	return report
+}
+
+// weight returns the tag weighting check and its weight, the largest of its weighted tags
+func (o HealthOptions) weight(check Check) (string, float64) {
+	tag, weight, found := "", o.DefaultWeight, false
+	for _, t := range strings.Fields(check.Tags) {
+		w, exists := o.Weights[t]
+		if !exists {
+			continue
+		}
+		if !found || w > weight || (w == weight && t < tag) {
+			tag, weight, found = t, w, true
+		}
+	}
+	return tag, weight
+}
@@ -0,0 +1,77 @@
+package healthchecksio
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeHealth(t *testing.T) {
+	checks := []Check{
+		{Name: "payments", Tags: "prod severity:critical", Status: "down"},
+		{Name: "orders", Tags: "severity:critical", Status: "up"},
+		{Name: "reports", Tags: "severity:low", Status: "grace"},
+		{Name: "cleanup", Tags: "severity:low severity:critical", Status: "up"},
+		{Name: "backup", Status: "up"},
+		{Name: "fresh", Status: "new"},
+		{Name: "migration", Tags: "severity:critical", Status: "paused"},
+	}
+	report := ComputeHealth(checks, HealthOptions{
+		Weights: map[string]float64{"severity:critical": 10, "severity:low": 0.5},
+	})
+
+	// (0 + 10 + 0.5*0.5 + 10 + 1) / (10 + 10 + 0.5 + 10 + 1)
+	require.InDelta(t, 21.25/31.5, report.Score, 1e-9)
+	require.Equal(t, 5, report.Scored)
+	require.Equal(t, 2, report.Excluded)
+	require.Equal(t, map[CheckStatus]int{StatusUp: 3, StatusDown: 1, StatusGrace: 1, StatusNew: 1, StatusPaused: 1}, report.Statuses)
+
+	require.Len(t, report.Groups, 3)
+	critical := report.Groups[0]
+	require.Equal(t, "severity:critical", critical.Tag)
+	require.Equal(t, 3, critical.Checks)
+	require.InDelta(t, 2.0/3, critical.Score, 1e-9)
+	require.InDelta(t, 10/31.5, critical.Impact, 1e-9)
+	require.Equal(t, []string{"payments"}, critical.Unhealthy)
+
+	require.Equal(t, "severity:low", report.Groups[1].Tag)
+	require.Equal(t, []string{"reports"}, report.Groups[1].Unhealthy)
+	require.Equal(t, "", report.Groups[2].Tag)
+	require.Zero(t, report.Groups[2].Impact)
+
+	// Impacts add up to what the score is missing
+	var impact float64
+	for _, group := range report.Groups {
+		impact += group.Impact
+	}
+	require.InDelta(t, 1-report.Score, impact, 1e-9)
+}
+
+func TestComputeHealth_StatusScores(t *testing.T) {
+	checks := []Check{{Name: "a", Status: "grace"}, {Name: "b", Status: "new"}}
+
+	report := ComputeHealth(checks, HealthOptions{
+		StatusScores: map[CheckStatus]float64{StatusGrace: 0, StatusNew: 1},
+	})
+	require.InDelta(t, 0.5, report.Score, 1e-9)
+
+	// Nothing to score is healthy
+	report = ComputeHealth(nil, HealthOptions{})
+	require.Equal(t, 1.0, report.Score)
+	require.Empty(t, report.Groups)
+}
+
+func TestProjectHealth(t *testing.T) {
+	client := &memoryClient{
+		checks: []Check{
+			{UUID: "1", Name: "backup", Tags: "prod", Status: "down"},
+			{UUID: "2", Name: "report", Tags: "prod", Status: "up"},
+			{UUID: "3", Name: "staging", Tags: "staging", Status: "down"},
+		},
+	}
+	report, err := ProjectHealth(context.Background(), client, HealthOptions{Filter: GetChecks{Tags: []string{"prod"}}})
+	require.NoError(t, err)
+	require.Equal(t, 0.5, report.Score)
+	require.Equal(t, 2, report.Scored)
+}
@@ -0,0 +1,133 @@
+package healthchecksio
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// HeartbeatCheck is a single check pinged by a Heartbeat
+type HeartbeatCheck struct {
+	PingURL string
+
+	// Interval between pings, defaults to 1 minute
+	Interval time.Duration
+
+	// Healthy is called before each ping, its result is classified by HeartbeatOptions.Classify
+	// and errors are sent as the ping's body. When nil every ping is a success.
+	Healthy func(ctx context.Context) error
+}
+
+// HeartbeatOptions configures a Heartbeat
+type HeartbeatOptions struct {
+	// Classify decides which ping a Healthy result sends, defaults to DefaultClassifier
+	Classify func(error) PingOutcome
+
+	// OnError is called when a ping can't be delivered
+	OnError func(check HeartbeatCheck, err error)
+}
+
+// Heartbeat periodically pings a set of checks (per-region, per-capability, ...) on
+// independent intervals while sharing one lifecycle.
+type Heartbeat struct {
+	client Pinger
+	checks []HeartbeatCheck
+	opts   HeartbeatOptions
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewHeartbeat creates a Heartbeat which pings checks with client
+func NewHeartbeat(client Pinger, opts HeartbeatOptions, checks ...HeartbeatCheck) *Heartbeat {
+	for i := range checks {
+		if checks[i].Interval <= 0 {
+			checks[i].Interval = time.Minute
+		}
+	}
+	if opts.Classify == nil {
+		opts.Classify = DefaultClassifier
+	}
+	return &Heartbeat{
+		client: client,
+		checks: checks,
+		opts:   opts,
+	}
+}
+
+// Start pings every check immediately and then on its interval until Stop is called or ctx is done.
+// Calling Start on a running Heartbeat does nothing.
+func (h *Heartbeat) Start(ctx context.Context) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.cancel != nil {
+		return
+	}
+	ctx, h.cancel = context.WithCancel(ctx)
+
+	for _, check := range h.checks {
+		h.wg.Add(1)
+		go func() {
+			defer h.wg.Done()
+			h.run(ctx, check)
+		}()
+	}
+}
+
+// Stop halts every check's pings and waits for in-flight pings to finish
+func (h *Heartbeat) Stop() {
+	h.mu.Lock()
+	cancel := h.cancel
+	h.cancel = nil
+	h.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+		h.wg.Wait()
+	}
+}
+
+func (h *Heartbeat) run(ctx context.Context, check HeartbeatCheck) {
+	ticker := time.NewTicker(check.Interval)
+	defer ticker.Stop()
+
+	for {
+		h.beat(ctx, check)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h *Heartbeat) beat(ctx context.Context, check HeartbeatCheck) {
+	var err error
+	if check.Healthy != nil {
+		err = check.Healthy(ctx)
+	}
+	if ctx.Err() != nil {
+		return
+	}
+
+	var body string
+	if err != nil {
+		body = err.Error()
+	}
+	switch h.opts.Classify(err) {
+	case OutcomeSuccess:
+		_, err = h.client.Ping(ctx, check.PingURL, body)
+	case OutcomeFail:
+		_, err = h.client.Ping(ctx, check.PingURL, body, WithFail())
+	case OutcomeLog:
+		_, err = h.client.Ping(ctx, check.PingURL, body, WithLog())
+	default:
+		return
+	}
+	if err != nil && ctx.Err() == nil && h.opts.OnError != nil {
+		h.opts.OnError(check, err)
+	}
+}
@@ -0,0 +1,75 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeartbeat(t *testing.T) {
+	pinger := &recordingPinger{}
+	heartbeat := NewHeartbeat(pinger, HeartbeatOptions{},
+		HeartbeatCheck{
+			PingURL:  "https://hc-ping.com/us-east",
+			Interval: 5 * time.Millisecond,
+		},
+		HeartbeatCheck{
+			PingURL:  "https://hc-ping.com/exports",
+			Interval: time.Hour,
+			Healthy: func(ctx context.Context) error {
+				return errors.New("exports disabled")
+			},
+		},
+	)
+
+	heartbeat.Start(context.Background())
+	heartbeat.Start(context.Background()) // no-op
+
+	count := func(ping string) int {
+		var n int
+		for _, p := range pinger.received() {
+			if p == ping {
+				n++
+			}
+		}
+		return n
+	}
+	require.Eventually(t, func() bool {
+		return count("https://hc-ping.com/us-east") >= 3
+	}, time.Second, time.Millisecond)
+
+	heartbeat.Stop()
+	heartbeat.Stop()
+
+	// The hourly check only pinged once, when started
+	require.Equal(t, 1, count("https://hc-ping.com/exports/fail exports disabled"))
+
+	stopped := pinger.received()
+	time.Sleep(20 * time.Millisecond)
+	require.Equal(t, stopped, pinger.received())
+}
+
+func TestHeartbeat_Classify(t *testing.T) {
+	errMaintenance := errors.New("in maintenance")
+	pinger := &recordingPinger{}
+	heartbeat := NewHeartbeat(pinger, HeartbeatOptions{
+		Classify: func(err error) PingOutcome {
+			if errors.Is(err, errMaintenance) {
+				return OutcomeLog
+			}
+			return DefaultClassifier(err)
+		},
+	}, HeartbeatCheck{
+		PingURL:  "https://hc-ping.com/db",
+		Interval: time.Hour,
+		Healthy:  func(ctx context.Context) error { return errMaintenance },
+	})
+
+	heartbeat.Start(context.Background())
+	require.Eventually(t, func() bool { return len(pinger.received()) == 1 }, time.Second, time.Millisecond)
+	heartbeat.Stop()
+	require.Equal(t, []string{"https://hc-ping.com/db/log in maintenance"}, pinger.received())
+}
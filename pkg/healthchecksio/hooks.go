@@ -0,0 +1,68 @@
+package healthchecksio
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"time"
+)
+
+// ResponseHook is called after every completed HTTP attempt, including retries.
+// The request is a copy with credentials redacted. The response body can be read freely,
+// it's a copy of what the client reads.
+type ResponseHook func(req *http.Request, resp *http.Response, took time.Duration)
+
+// WithOnResponse registers a hook invoked for every completed HTTP attempt, for
+// centrally logging slow calls and error bodies
+func WithOnResponse(hook ResponseHook) ClientOption {
+	return func(c *client) {
+		c.responseHooks = append(c.responseHooks, hook)
+	}
+}
+
+// hookTransport calls response hooks around each round trip
+type hookTransport struct {
+	next  http.RoundTripper
+	hooks []ResponseHook
+
+	// limit is the most of each body buffered for hooks, zero for no limit
+	limit int64
+}
+
+func (t *hookTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	took := time.Since(start)
+
+	bs, err := io.ReadAll(limitBody(resp.Body, t.limit))
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(bs))
+
+	sanitized := sanitizeRequest(req)
+	for _, hook := range t.hooks {
+		copied := *resp
+		copied.Request = sanitized
+		copied.Header = resp.Header.Clone()
+		copied.Body = io.NopCloser(bytes.NewReader(bs))
+
+		hook(sanitized, &copied, took)
+	}
+	return resp, nil
+}
+
+// sanitizeRequest returns a copy of req without credentials or a body
+func sanitizeRequest(req *http.Request) *http.Request {
+	out := req.Clone(req.Context())
+	if out.Header.Get("X-Api-Key") != "" {
+		out.Header.Set("X-Api-Key", "REDACTED")
+	}
+	out.Body = http.NoBody
+	out.GetBody = nil
+	return out
+}
@@ -0,0 +1,88 @@
+package healthchecksio_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithOnResponse(t *testing.T) {
+	ctx := context.Background()
+
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			w.Write([]byte(`{"error":"upstream"}`))
+			return
+		}
+		w.Write([]byte(`{"name":"backup","uuid":"abc"}`))
+	}))
+	defer srv.Close()
+
+	type observed struct {
+		apiKey string
+		status int
+		body   string
+	}
+	var seen []observed
+
+	client := healthchecksio.NewClient("secret-key",
+		healthchecksio.WithBaseURL(srv.URL),
+		healthchecksio.WithRetries(2, time.Millisecond, time.Millisecond),
+		healthchecksio.WithOnResponse(func(req *http.Request, resp *http.Response, took time.Duration) {
+			bs, _ := io.ReadAll(resp.Body)
+			seen = append(seen, observed{
+				apiKey: req.Header.Get("X-Api-Key"),
+				status: resp.StatusCode,
+				body:   string(bs),
+			})
+			require.Positive(t, took)
+		}),
+	)
+
+	check, err := client.GetCheck(ctx, "abc")
+	require.NoError(t, err)
+	require.Equal(t, "backup", check.Name) // hooks reading the body don't affect the client
+
+	require.Equal(t, []observed{
+		{apiKey: "REDACTED", status: http.StatusBadGateway, body: `{"error":"upstream"}`},
+		{apiKey: "REDACTED", status: http.StatusOK, body: `{"name":"backup","uuid":"abc"}`},
+	}, seen)
+}
+
+func TestWithOnResponse_TooLarge(t *testing.T) {
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.Write([]byte(`{"uuid":"` + strings.Repeat("a", 2000) + `"}`))
+	}))
+	defer srv.Close()
+
+	var hooked int
+	client := healthchecksio.NewClient("key",
+		healthchecksio.WithBaseURL(srv.URL),
+		healthchecksio.WithRetries(3, time.Millisecond, time.Millisecond),
+		healthchecksio.WithMaxResponseBytes(1024),
+		healthchecksio.WithMaxPingResponseBytes(1024),
+		healthchecksio.WithOnResponse(func(req *http.Request, resp *http.Response, took time.Duration) {
+			hooked++
+		}),
+	)
+
+	// Buffering the body for hooks hits the limit, which isn't retried
+	_, err := client.GetCheck(context.Background(), "abc")
+	var tooLarge *healthchecksio.ResponseTooLargeError
+	require.ErrorAs(t, err, &tooLarge)
+	require.Equal(t, int32(1), attempts.Load())
+	require.Zero(t, hooked)
+}
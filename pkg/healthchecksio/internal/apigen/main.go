@@ -0,0 +1,64 @@
+// Command apigen generates the list of fields the v3 API documents for each object from
+// testdata/apispec.json. Tests use it to assert every documented field is modeled.
+//
+//	go generate ./pkg/healthchecksio
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"maps"
+	"os"
+	"slices"
+)
+
+type spec struct {
+	Source  string `json:"source"`
+	Objects map[string]struct {
+		Fields map[string]string `json:"fields"`
+	} `json:"objects"`
+}
+
+func main() {
+	specPath := flag.String("spec", "testdata/apispec.json", "API spec to read")
+	out := flag.String("out", "apifields_gen_test.go", "Go file to write")
+	pkg := flag.String("package", "healthchecksio", "Package of the generated file")
+	flag.Parse()
+
+	bs, err := os.ReadFile(*specPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var s spec
+	if err := json.Unmarshal(bs, &s); err != nil {
+		log.Fatalf("parsing %s: %v", *specPath, err)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by apigen from %s. DO NOT EDIT.\n\n", *specPath)
+	fmt.Fprintf(&buf, "package %s\n\n", *pkg)
+	fmt.Fprintf(&buf, "// documentedFields are the JSON fields of each object documented at %s,\n", s.Source)
+	fmt.Fprintf(&buf, "// keyed by the Go type modeling it\n")
+	fmt.Fprintf(&buf, "var documentedFields = map[string]map[string]string{\n")
+	for _, object := range slices.Sorted(maps.Keys(s.Objects)) {
+		fields := s.Objects[object].Fields
+		fmt.Fprintf(&buf, "\t%q: {\n", object)
+		for _, name := range slices.Sorted(maps.Keys(fields)) {
+			fmt.Fprintf(&buf, "\t\t%q: %q,\n", name, fields[name])
+		}
+		fmt.Fprintf(&buf, "\t},\n")
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
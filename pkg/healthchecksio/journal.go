@@ -0,0 +1,200 @@
+package healthchecksio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PingJournal writes pings to a directory before sending them and removes them once
+// delivered, so must-not-lose pings (e.g. a failed backup's fail ping) survive the process
+// dying mid-send. Call Replay on startup to send whatever a previous process left behind.
+type PingJournal struct {
+	client Pinger
+	dir    string
+
+	mu       sync.Mutex
+	inFlight map[string]bool
+}
+
+// journalEntry is a ping as stored in a PingJournal's directory
+type journalEntry struct {
+	URL     string    `json:"url"`
+	Body    string    `json:"body,omitempty"`
+	Created time.Time `json:"created"`
+}
+
+// NewPingJournal creates a PingJournal storing pings in dir, which is created when missing
+func NewPingJournal(client Pinger, dir string) (*PingJournal, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("ping journal: %w", err)
+	}
+	return &PingJournal{
+		client:   client,
+		dir:      dir,
+		inFlight: make(map[string]bool),
+	}, nil
+}
+
+// Ping saves the ping to disk, then sends it. The ping stays on disk for Replay when it
+// can't be delivered, unless the ping endpoint rejected it (a 4xx other than 429).
+func (j *PingJournal) Ping(ctx context.Context, pingURL, body string, opts ...PingOption) (*PingResult, error) {
+	addr, err := pingAddress(ctx, pingURL, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := j.write(journalEntry{URL: addr.String(), Body: body, Created: time.Now()})
+	if err != nil {
+		return nil, fmt.Errorf("ping journal: %w", err)
+	}
+	j.claim(path)
+	defer j.release(path)
+
+	return j.send(ctx, path, addr.String(), body)
+}
+
+// ReplayOptions configures PingJournal.Replay
+type ReplayOptions struct {
+	// MaxAge drops pings older than it unsent, zero replays every ping however old. A ping
+	// left behind days ago would otherwise mark its check up (or down) as if it just happened.
+	MaxAge time.Duration
+}
+
+// Replay sends the pings left in the journal, oldest first, and returns how many were
+// delivered. Pings older than opts.MaxAge are removed without being sent. All of them are
+// attempted and failures are joined together.
+func (j *PingJournal) Replay(ctx context.Context, opts ReplayOptions) (int, error) {
+	names, err := filepath.Glob(filepath.Join(j.dir, "*.json"))
+	if err != nil {
+		return 0, fmt.Errorf("ping journal replay: %w", err)
+	}
+	slices.Sort(names) // named by creation time
+
+	var delivered int
+	var errs []error
+	for _, path := range names {
+		if !j.claim(path) {
+			continue // being sent by Ping
+		}
+		sent, err := j.replay(ctx, path, opts)
+		j.release(path)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if sent {
+			delivered++
+		}
+	}
+	return delivered, errors.Join(errs...)
+}
+
+// replay sends the ping stored at path, sent is false when there was nothing to send
+func (j *PingJournal) replay(ctx context.Context, path string, opts ReplayOptions) (sent bool, err error) {
+	bs, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("ping journal replay: %w", err)
+	}
+
+	var entry journalEntry
+	if err := json.Unmarshal(bs, &entry); err != nil {
+		// A torn write from a crash, there's nothing to send
+		os.Remove(path)
+		return false, fmt.Errorf("ping journal replay %s: %w", filepath.Base(path), err)
+	}
+	if opts.MaxAge > 0 && time.Since(entry.Created) > opts.MaxAge {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return false, fmt.Errorf("ping journal replay: %w", err)
+		}
+		return false, nil
+	}
+	if _, err := j.send(ctx, path, entry.URL, entry.Body); err != nil {
+		return false, fmt.Errorf("ping journal replay %s: %w", filepath.Base(path), err)
+	}
+	return true, nil
+}
+
+func (j *PingJournal) send(ctx context.Context, path, address, body string) (*PingResult, error) {
+	// address already has the ping's options, don't apply those from WithPingContext again
+	ctx = context.WithValue(ctx, pingOptionsKey{}, []PingOption(nil))
+
+	result, err := j.client.Ping(ctx, address, body)
+	if err == nil || rejected(result) {
+		if rerr := os.Remove(path); rerr != nil && !errors.Is(rerr, os.ErrNotExist) {
+			err = errors.Join(err, fmt.Errorf("ping journal: %w", rerr))
+		}
+	}
+	return result, err
+}
+
+// rejected reports whether the ping endpoint refused a ping, so sending it again won't help
+func rejected(result *PingResult) bool {
+	if result == nil {
+		return false
+	}
+	code := result.StatusCode
+	return code >= 400 && code < 500 && code != http.StatusTooManyRequests
+}
+
+// write stores entry in a new file, renamed into place so Replay never sees a partial write
+func (j *PingJournal) write(entry journalEntry) (string, error) {
+	bs, err := json.Marshal(entry)
+	if err != nil {
+		return "", err
+	}
+
+	f, err := os.CreateTemp(j.dir, ".ping-*")
+	if err != nil {
+		return "", err
+	}
+	_, err = f.Write(bs)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+
+	// Zero padded nanoseconds sort in creation order, the temp suffix keeps names unique
+	suffix := strings.TrimPrefix(filepath.Base(f.Name()), ".ping-")
+	path := filepath.Join(j.dir, fmt.Sprintf("%020d-%s.json", entry.Created.UnixNano(), suffix))
+	if err := os.Rename(f.Name(), path); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return path, nil
+}
+
+func (j *PingJournal) claim(path string) bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.inFlight[path] {
+		return false
+	}
+	j.inFlight[path] = true
+	return true
+}
+
+func (j *PingJournal) release(path string) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	delete(j.inFlight, path)
+}
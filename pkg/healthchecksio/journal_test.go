@@ -0,0 +1,133 @@
+package healthchecksio_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPingJournal(t *testing.T) {
+	var down atomic.Bool
+	down.Store(true)
+
+	var mu sync.Mutex
+	var received []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing/fail" {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		if down.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		bs, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		received = append(received, r.URL.Path+" "+string(bs))
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "pings")
+	client := healthchecksio.NewClient("", healthchecksio.WithRetryEngine(healthchecksio.NoRetries()))
+
+	journal, err := healthchecksio.NewPingJournal(client, dir)
+	require.NoError(t, err)
+
+	_, err = journal.Ping(ctx, srv.URL+"/backup", "disk full", healthchecksio.WithFail())
+	require.ErrorContains(t, err, "ping failed with 502")
+	_, err = journal.Ping(ctx, srv.URL+"/backup", "retrying")
+	require.Error(t, err)
+
+	// Pings the endpoint refused aren't kept
+	_, err = journal.Ping(ctx, srv.URL+"/missing", "", healthchecksio.WithFail())
+	require.ErrorContains(t, err, "ping failed with 404")
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	// A restarted process replays what's left, oldest first
+	down.Store(false)
+	journal, err = healthchecksio.NewPingJournal(client, dir)
+	require.NoError(t, err)
+
+	delivered, err := journal.Replay(ctx, healthchecksio.ReplayOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 2, delivered)
+	require.Equal(t, []string{"/backup/fail disk full", "/backup retrying"}, received)
+
+	entries, err = os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	// Delivered pings are removed right away
+	result, err := journal.Ping(ctx, srv.URL+"/backup", "")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, result.StatusCode)
+
+	entries, err = os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestPingJournalCorruptEntry(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "00000000000000000001-x.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"url":`), 0600))
+
+	journal, err := healthchecksio.NewPingJournal(healthchecksio.NewClient(""), dir)
+	require.NoError(t, err)
+
+	delivered, err := journal.Replay(context.Background(), healthchecksio.ReplayOptions{})
+	require.Error(t, err)
+	require.Zero(t, delivered)
+	require.NoFileExists(t, path)
+}
+
+func TestPingJournalReplayMaxAge(t *testing.T) {
+	var received []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.URL.Path)
+	}))
+	defer srv.Close()
+
+	// Left behind by processes which died a day and a minute ago
+	dir := t.TempDir()
+	for i, entry := range []struct {
+		path    string
+		created time.Time
+	}{
+		{"/stale", time.Now().Add(-24 * time.Hour)},
+		{"/recent", time.Now().Add(-time.Minute)},
+	} {
+		bs := fmt.Sprintf(`{"url":%q,"created":%q}`, srv.URL+entry.path, entry.created.Format(time.RFC3339Nano))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, fmt.Sprintf("%020d-x.json", i)), []byte(bs), 0600))
+	}
+
+	journal, err := healthchecksio.NewPingJournal(healthchecksio.NewClient(""), dir)
+	require.NoError(t, err)
+
+	delivered, err := journal.Replay(context.Background(), healthchecksio.ReplayOptions{MaxAge: time.Hour})
+	require.NoError(t, err)
+	require.Equal(t, 1, delivered)
+	require.Equal(t, []string{"/recent"}, received)
+
+	// The stale ping is dropped too
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
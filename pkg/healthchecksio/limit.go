@@ -0,0 +1,69 @@
+package healthchecksio
+
+import (
+	"fmt"
+	"io"
+)
+
+const (
+	// DefaultMaxResponseBytes is the largest management API response read by default
+	DefaultMaxResponseBytes = 32 << 20
+
+	// DefaultMaxPingResponseBytes is the largest ping response read by default
+	DefaultMaxPingResponseBytes = 64 << 10
+)
+
+// ResponseTooLargeError is returned when a response body is larger than the configured limit
+type ResponseTooLargeError struct {
+	Limit int64
+}
+
+func (e *ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("response body exceeded %d bytes", e.Limit)
+}
+
+// WithMaxResponseBytes limits how much of a management API response is read,
+// defaults to DefaultMaxResponseBytes. Zero or less removes the limit.
+func WithMaxResponseBytes(n int64) ClientOption {
+	return func(c *client) {
+		c.maxResponseBytes = n
+	}
+}
+
+// WithMaxPingResponseBytes limits how much of a ping response is read,
+// defaults to DefaultMaxPingResponseBytes. Zero or less removes the limit.
+func WithMaxPingResponseBytes(n int64) ClientOption {
+	return func(c *client) {
+		c.maxPingResponseBytes = n
+	}
+}
+
+// limitBody wraps body so reading past limit bytes fails with a ResponseTooLargeError
+func limitBody(body io.ReadCloser, limit int64) io.ReadCloser {
+	if limit <= 0 {
+		return body
+	}
+	return &limitedBody{ReadCloser: body, remaining: limit, limit: limit}
+}
+
+type limitedBody struct {
+	io.ReadCloser
+	remaining int64
+	limit     int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.remaining < 0 {
+		return 0, &ResponseTooLargeError{Limit: b.limit}
+	}
+	// Read one byte past the limit to tell a body of exactly limit bytes from a larger one
+	if int64(len(p)) > b.remaining+1 {
+		p = p[:b.remaining+1]
+	}
+	n, err := b.ReadCloser.Read(p)
+	b.remaining -= int64(n)
+	if b.remaining < 0 {
+		return n + int(b.remaining), &ResponseTooLargeError{Limit: b.limit}
+	}
+	return n, err
+}
@@ -0,0 +1,59 @@
+package healthchecksio_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseSizeLimits(t *testing.T) {
+	page := strings.Repeat("<html>proxy error</html>", 1000)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/checks/":
+			w.Write([]byte(`{"checks":[{"uuid":"` + strings.Repeat("a", 2000) + `"}]}`))
+		case "/checks/small":
+			w.Write([]byte(`{"uuid":"small"}`))
+		default:
+			w.Write([]byte(page))
+		}
+	}))
+	defer srv.Close()
+
+	client := healthchecksio.NewClient("key",
+		healthchecksio.WithBaseURL(srv.URL),
+		healthchecksio.WithMaxResponseBytes(1024),
+		healthchecksio.WithMaxPingResponseBytes(100),
+	)
+	ctx := context.Background()
+
+	_, err := client.GetChecks(ctx, healthchecksio.GetChecks{})
+	var tooLarge *healthchecksio.ResponseTooLargeError
+	require.ErrorAs(t, err, &tooLarge)
+	require.Equal(t, int64(1024), tooLarge.Limit)
+
+	check, err := client.GetCheck(ctx, "small")
+	require.NoError(t, err)
+	require.Equal(t, "small", check.UUID)
+
+	_, err = client.Ping(ctx, srv.URL+"/ping/abc", "")
+	require.ErrorAs(t, err, &tooLarge)
+	require.Equal(t, int64(100), tooLarge.Limit)
+
+	// Limits can be removed
+	client = healthchecksio.NewClient("key",
+		healthchecksio.WithBaseURL(srv.URL),
+		healthchecksio.WithMaxResponseBytes(0),
+		healthchecksio.WithMaxPingResponseBytes(0),
+	)
+	_, err = client.GetChecks(ctx, healthchecksio.GetChecks{})
+	require.NoError(t, err)
+	_, err = client.Ping(ctx, srv.URL+"/ping/abc", "")
+	require.NoError(t, err)
+}
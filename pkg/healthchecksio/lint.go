@@ -0,0 +1,147 @@
+package healthchecksio
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// LintRule inspects a check for a monitoring hygiene problem
+type LintRule struct {
+	// Name identifies the rule in findings, e.g. "missing-description"
+	Name string
+
+	// Check returns a description of the problem, or an empty string when check passes
+	Check func(check Check) string
+}
+
+// LintFinding is a problem a LintRule found with a check
+type LintFinding struct {
+	Rule    string `json:"rule"`
+	UUID    string `json:"uuid"`
+	Name    string `json:"name"`
+	Slug    string `json:"slug,omitempty"`
+	Message string `json:"message"`
+}
+
+var (
+	// LintMissingDescription reports checks without a description
+	LintMissingDescription = LintRule{
+		Name: "missing-description",
+		Check: func(check Check) string {
+			if strings.TrimSpace(check.Desc) == "" {
+				return "has no description"
+			}
+			return ""
+		},
+	}
+
+	// LintNoChannels reports checks which notify no integration when they go down
+	LintNoChannels = LintRule{
+		Name: "no-channels",
+		Check: func(check Check) string {
+			if strings.TrimSpace(check.Channels) == "" {
+				return "has no notification channels assigned"
+			}
+			return ""
+		},
+	}
+
+	// LintNoTags reports checks without tags
+	LintNoTags = LintRule{
+		Name: "no-tags",
+		Check: func(check Check) string {
+			if strings.TrimSpace(check.Tags) == "" {
+				return "has no tags"
+			}
+			return ""
+		},
+	}
+
+	// LintGraceShorterThanTimeout reports simple checks whose grace period is shorter than
+	// their timeout, which alerts on runs that are merely a little late
+	LintGraceShorterThanTimeout = LintRule{
+		Name: "grace-shorter-than-timeout",
+		Check: func(check Check) string {
+			if check.Schedule == "" && check.Timeout > 0 && check.Grace < check.Timeout {
+				return fmt.Sprintf("grace %v is shorter than its timeout %v",
+					time.Duration(check.Grace)*time.Second, time.Duration(check.Timeout)*time.Second)
+			}
+			return ""
+		},
+	}
+
+	// LintNeverPinged reports checks which have never received a ping
+	LintNeverPinged = LintRule{
+		Name: "never-pinged",
+		Check: func(check Check) string {
+			if _, pinged := check.LastPingTime(); !pinged {
+				return "has never been pinged"
+			}
+			return ""
+		},
+	}
+
+	// LintMissingOwner reports checks without an owner:<owner> tag, see Ownership
+	LintMissingOwner = LintRule{
+		Name: "missing-owner",
+		Check: func(check Check) string {
+			if OwnershipOf(check).Owner == "" {
+				return "has no owner"
+			}
+			return ""
+		},
+	}
+
+	// LintMissingRunbook reports checks whose description has no "Runbook: <url>" line, see Ownership
+	LintMissingRunbook = LintRule{
+		Name: "missing-runbook",
+		Check: func(check Check) string {
+			if OwnershipOf(check).Runbook == "" {
+				return "has no runbook"
+			}
+			return ""
+		},
+	}
+)
+
+// DefaultLintRules are the rules LintChecks applies when none are given
+func DefaultLintRules() []LintRule {
+	return []LintRule{
+		LintMissingDescription,
+		LintNoChannels,
+		LintNoTags,
+		LintGraceShorterThanTimeout,
+		LintNeverPinged,
+	}
+}
+
+// LintChecks applies rules (DefaultLintRules when empty) to every check matching filter and
+// returns the findings, ordered by check then rule
+func LintChecks(ctx context.Context, client CheckReader, filter GetChecks, rules ...LintRule) ([]LintFinding, error) {
+	if len(rules) == 0 {
+		rules = DefaultLintRules()
+	}
+
+	list, err := client.GetChecks(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("lint checks: %w", err)
+	}
+
+	var findings []LintFinding
+	for _, check := range list.Checks {
+		for _, rule := range rules {
+			if message := rule.Check(check); message != "" {
+				findings = append(findings, LintFinding{
+					Rule:    rule.Name,
+					UUID:    check.UUID,
+					Name:    check.Name,
+					Slug:    check.Slug,
+					Message: message,
+				})
+			}
+		}
+	}
+	return findings, nil
+}
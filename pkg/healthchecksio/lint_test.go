@@ -0,0 +1,52 @@
+package healthchecksio
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLintChecks(t *testing.T) {
+	client := &memoryClient{checks: []Check{
+		{
+			UUID: "1", Name: "Backup", Slug: "backup", Desc: "Nightly pg_dump", Tags: "db",
+			Channels: "ch-1", Timeout: 86400, Grace: 3600, LastPing: "2025-03-01T03:00:00+00:00",
+		},
+		{UUID: "2", Name: "Web", Slug: "web", Schedule: "*/5 * * * *", Grace: 60},
+	}}
+	ctx := context.Background()
+
+	findings, err := LintChecks(ctx, client, GetChecks{})
+	require.NoError(t, err)
+
+	type found struct{ slug, rule string }
+	var got []found
+	for _, f := range findings {
+		got = append(got, found{f.Slug, f.Rule})
+	}
+	require.Equal(t, []found{
+		{"backup", "grace-shorter-than-timeout"},
+		{"web", "missing-description"},
+		{"web", "no-channels"},
+		{"web", "no-tags"},
+		{"web", "never-pinged"},
+	}, got)
+	require.Equal(t, "grace 1h0m0s is shorter than its timeout 24h0m0s", findings[0].Message)
+
+	findings, err = LintChecks(ctx, client, GetChecks{Tags: []string{"db"}}, LintMissingOwner, LintMissingRunbook)
+	require.NoError(t, err)
+	require.Len(t, findings, 2)
+	require.Equal(t, LintFinding{Rule: "missing-owner", UUID: "1", Name: "Backup", Slug: "backup", Message: "has no owner"}, findings[0])
+
+	custom := LintRule{Name: "slug-prefix", Check: func(check Check) string {
+		if check.Slug != "web" {
+			return ""
+		}
+		return "slugs must start with a team prefix"
+	}}
+	findings, err = LintChecks(ctx, client, GetChecks{}, custom)
+	require.NoError(t, err)
+	require.Len(t, findings, 1)
+	require.Equal(t, "web", findings[0].Slug)
+}
@@ -0,0 +1,278 @@
+package healthchecksio
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"time"
+	"unicode/utf8"
+)
+
+// ManifestSchema is the JSON Schema (draft 2020-12) of the Manifest format read by the sync
+// engine. Editors can reference it for completion and CI can validate against it.
+//
+//go:embed manifest.schema.json
+var ManifestSchema []byte
+
+// ValidateManifest checks a JSON encoded manifest against ManifestSchema along with rules a
+// schema can't express: unique slugs, parseable schedules and known time zones.
+// Every problem found is returned, joined together.
+func ValidateManifest(data []byte) error {
+	var doc any
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&doc); err != nil {
+		return fmt.Errorf("validate manifest: %w", err)
+	}
+
+	var errs []error
+	manifestSchema.validate("", doc, &errs)
+	if len(errs) == 0 {
+		var manifest Manifest
+		if err := json.Unmarshal(data, &manifest); err != nil {
+			return fmt.Errorf("validate manifest: %w", err)
+		}
+		errs = validateManifestChecks(manifest.Checks)
+		if manifest.Descriptions != nil {
+			errs = append(errs, validateManifestDescriptions(manifest)...)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("validate manifest: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+func validateManifestChecks(checks []CreateCheck) []error {
+	var errs []error
+	seen := make(map[string]int)
+	for i, check := range checks {
+		path := fmt.Sprintf("checks[%d]", i)
+		if first, exists := seen[check.Slug]; exists {
+			errs = append(errs, fmt.Errorf("%s.slug: %q is also used by checks[%d]", path, check.Slug, first))
+		} else {
+			seen[check.Slug] = i
+		}
+		if check.Schedule != "" {
+			if _, err := parseCron(check.Schedule); err != nil {
+				errs = append(errs, fmt.Errorf("%s.schedule: %w", path, err))
+			}
+		}
+		if check.Timezone != "" {
+			if _, err := time.LoadLocation(check.Timezone); err != nil {
+				errs = append(errs, fmt.Errorf("%s.tz: unknown time zone %q", path, check.Timezone))
+			}
+		}
+	}
+	return errs
+}
+
+func validateManifestDescriptions(manifest Manifest) []error {
+	var errs []error
+	if _, err := manifest.Descriptions.Apply(manifest.Checks); err != nil {
+		errs = append(errs, fmt.Errorf("descriptions: %w", err))
+	}
+	slugs := make([]string, 0, len(manifest.Descriptions.Checks))
+	for slug := range manifest.Descriptions.Checks {
+		slugs = append(slugs, slug)
+	}
+	sort.Strings(slugs)
+	for _, slug := range slugs {
+		if !slices.ContainsFunc(manifest.Checks, func(check CreateCheck) bool { return check.Slug == slug }) {
+			errs = append(errs, fmt.Errorf("descriptions.checks.%s: no check has this slug", slug))
+		}
+	}
+	return errs
+}
+
+// jsonSchema is the subset of JSON Schema used by ManifestSchema
+type jsonSchema struct {
+	Type                 string                 `json:"type"`
+	Properties           map[string]*jsonSchema `json:"properties"`
+	AdditionalProperties *bool                  `json:"additionalProperties"`
+	Required             []string               `json:"required"`
+	Items                *jsonSchema            `json:"items"`
+	UniqueItems          bool                   `json:"uniqueItems"`
+	Enum                 []any                  `json:"enum"`
+	Minimum              *float64               `json:"minimum"`
+	Maximum              *float64               `json:"maximum"`
+	MinLength            *int                   `json:"minLength"`
+	MaxLength            *int                   `json:"maxLength"`
+	Pattern              string                 `json:"pattern"`
+
+	pattern *regexp.Regexp
+}
+
+var manifestSchema = mustParseSchema(ManifestSchema)
+
+func mustParseSchema(data []byte) *jsonSchema {
+	var s jsonSchema
+	if err := json.Unmarshal(data, &s); err != nil {
+		panic(fmt.Sprintf("parsing manifest schema: %v", err))
+	}
+	s.compile()
+	return &s
+}
+
+func (s *jsonSchema) compile() {
+	if s.Pattern != "" {
+		s.pattern = regexp.MustCompile(s.Pattern)
+	}
+	for _, prop := range s.Properties {
+		prop.compile()
+	}
+	if s.Items != nil {
+		s.Items.compile()
+	}
+}
+
+func (s *jsonSchema) validate(path string, v any, errs *[]error) {
+	fail := func(format string, args ...any) {
+		where := path
+		if where == "" {
+			where = "manifest"
+		}
+		*errs = append(*errs, fmt.Errorf("%s: %s", where, fmt.Sprintf(format, args...)))
+	}
+
+	if !schemaTypeMatches(s.Type, v) {
+		fail("must be %s, got %s", schemaArticle(s.Type), jsonTypeName(v))
+		return
+	}
+	if len(s.Enum) > 0 && !slices.ContainsFunc(s.Enum, func(want any) bool { return reflect.DeepEqual(want, plainJSON(v)) }) {
+		fail("must be one of %s", enumList(s.Enum))
+	}
+
+	switch v := v.(type) {
+	case map[string]any:
+		for _, name := range s.Required {
+			if _, exists := v[name]; !exists {
+				fail("missing required field %q", name)
+			}
+		}
+		names := make([]string, 0, len(v))
+		for name := range v {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			prop, exists := s.Properties[name]
+			if !exists {
+				if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+					fail("unknown field %q", name)
+				}
+				continue
+			}
+			prop.validate(joinSchemaPath(path, name), v[name], errs)
+		}
+
+	case []any:
+		for i, item := range v {
+			if s.Items != nil {
+				s.Items.validate(fmt.Sprintf("%s[%d]", path, i), item, errs)
+			}
+			if s.UniqueItems && slices.ContainsFunc(v[:i], func(prev any) bool { return reflect.DeepEqual(prev, item) }) {
+				fail("duplicate item %v", item)
+			}
+		}
+
+	case string:
+		n := utf8.RuneCountInString(v)
+		if s.MinLength != nil && n < *s.MinLength {
+			fail("must be at least %d characters", *s.MinLength)
+		}
+		if s.MaxLength != nil && n > *s.MaxLength {
+			fail("must be at most %d characters", *s.MaxLength)
+		}
+		if s.pattern != nil && !s.pattern.MatchString(v) {
+			fail("%q doesn't match %s", v, s.Pattern)
+		}
+
+	case json.Number:
+		f, _ := v.Float64()
+		if s.Minimum != nil && f < *s.Minimum {
+			fail("must be at least %v", *s.Minimum)
+		}
+		if s.Maximum != nil && f > *s.Maximum {
+			fail("must be at most %v", *s.Maximum)
+		}
+	}
+}
+
+func joinSchemaPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func schemaTypeMatches(want string, v any) bool {
+	switch want {
+	case "":
+		return true
+	case "integer":
+		n, ok := v.(json.Number)
+		if !ok {
+			return false
+		}
+		f, err := n.Float64()
+		return err == nil && f == math.Trunc(f)
+	case "number":
+		_, ok := v.(json.Number)
+		return ok
+	}
+	return jsonTypeName(v) == want
+}
+
+func jsonTypeName(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case json.Number:
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+func schemaArticle(kind string) string {
+	switch kind {
+	case "array", "integer", "object":
+		return "an " + kind
+	}
+	return "a " + kind
+}
+
+// plainJSON converts numbers back to float64 so they compare equal to values decoded from the schema
+func plainJSON(v any) any {
+	if n, ok := v.(json.Number); ok {
+		f, _ := n.Float64()
+		return f
+	}
+	return v
+}
+
+func enumList(values []any) string {
+	var buf bytes.Buffer
+	for i, v := range values {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(&buf, "%q", fmt.Sprint(v))
+	}
+	return buf.String()
+}
@@ -0,0 +1,69 @@
+package healthchecksio
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestManifestSchemaMatchesCreateCheck(t *testing.T) {
+	props := manifestSchema.Properties["checks"].Items.Properties
+
+	typ := reflect.TypeFor[CreateCheck]()
+	var fields []string
+	for i := 0; i < typ.NumField(); i++ {
+		name, _, _ := strings.Cut(typ.Field(i).Tag.Get("json"), ",")
+		fields = append(fields, name)
+		require.Contains(t, props, name, "CreateCheck.%s is missing from the schema", typ.Field(i).Name)
+	}
+	for name := range props {
+		require.Contains(t, fields, name, "schema field %s isn't in CreateCheck", name)
+	}
+}
+
+func TestValidateManifest(t *testing.T) {
+	valid := `{"checks": [
+		{"slug": "nightly-backup", "name": "Nightly backup", "schedule": "0 3 * * *", "tz": "America/Chicago", "grace": 3600},
+		{"slug": "web", "timeout": 300, "methods": "POST", "unique": ["slug"]}
+	]}`
+	require.NoError(t, ValidateManifest([]byte(valid)))
+	require.NoError(t, ValidateManifest([]byte(`{"checks": [{"slug": "x"}], "descriptions": {"vars": {"repo": "acme/jobs"}, "checks": {"x": {"owner": "a"}}}}`)))
+
+	cases := map[string]string{
+		`[]`:                                     "manifest: must be an object, got array",
+		`{}`:                                     `manifest: missing required field "checks"`,
+		`{"checks": [{"name": "x"}]}`:            `checks[0]: missing required field "slug"`,
+		`{"checks": [{"slug": "x", "nmae": 1}]}`: `checks[0]: unknown field "nmae"`,
+		`{"checks": [{"slug": "Nightly"}]}`:      `checks[0].slug: "Nightly" doesn't match`,
+		`{"checks": [{"slug": "x", "timeout": 30}]}`:                                     "checks[0].timeout: must be at least 60",
+		`{"checks": [{"slug": "x", "grace": 1.5}]}`:                                      "checks[0].grace: must be an integer, got number",
+		`{"checks": [{"slug": "x", "tags": ["a"]}]}`:                                     "checks[0].tags: must be a string, got array",
+		`{"checks": [{"slug": "x", "methods": "GET"}]}`:                                  `checks[0].methods: must be one of "", "POST"`,
+		`{"checks": [{"slug": "x", "unique": ["slug", "slug"]}]}`:                        "checks[0].unique: duplicate item slug",
+		`{"checks": [{"slug": "x", "schedule": "* *"}]}`:                                 "checks[0].schedule:",
+		`{"checks": [{"slug": "x", "tz": "Mars/Olympus"}]}`:                              `checks[0].tz: unknown time zone "Mars/Olympus"`,
+		`{"checks": [{"slug": "x"}, {"slug": "x"}]}`:                                     `checks[1].slug: "x" is also used by checks[0]`,
+		`{"checks": [{"slug": "x"}], "descriptions": {"template": "{{.service"}}`:        "descriptions: description template:",
+		`{"checks": [{"slug": "x"}], "descriptions": {"checks": {"y": {"owner": "a"}}}}`: "descriptions.checks.y: no check has this slug",
+		`{"checks": [{"slug": "x"}], "descriptions": {"tmpl": ""}}`:                      `descriptions: unknown field "tmpl"`,
+	}
+	for input, want := range cases {
+		err := ValidateManifest([]byte(input))
+		require.ErrorContains(t, err, want, input)
+	}
+
+	// every problem is reported
+	err := ValidateManifest([]byte(`{"checks": [{"timeout": 1, "grace": 1}]}`))
+	require.ErrorContains(t, err, "missing required field")
+	require.ErrorContains(t, err, "timeout: must be at least 60")
+	require.ErrorContains(t, err, "grace: must be at least 60")
+}
+
+func TestManifestSchemaIsJSON(t *testing.T) {
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(ManifestSchema, &schema))
+	require.Equal(t, "https://json-schema.org/draft/2020-12/schema", schema["$schema"])
+}
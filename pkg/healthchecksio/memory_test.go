@@ -0,0 +1,217 @@
+package healthchecksio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// memoryClient is an in-memory Client for tests of helpers built on top of the API
+type memoryClient struct {
+	Client
+
+	mu       sync.Mutex
+	checks   []Check
+	channels []Channel
+	flips    map[string][]Flip
+	pings    map[string][]Ping
+	calls    []string
+	nextID   int
+}
+
+func (m *memoryClient) record(format string, args ...any) {
+	m.calls = append(m.calls, fmt.Sprintf(format, args...))
+}
+
+func (m *memoryClient) find(identifier string) int {
+	return slices.IndexFunc(m.checks, func(c Check) bool {
+		return c.UUID == identifier
+	})
+}
+
+func (m *memoryClient) GetChecks(ctx context.Context, params GetChecks, opts ...CallOption) (*CheckListResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	out := &CheckListResponse{}
+	for _, c := range m.checks {
+		if params.Slug != "" && c.Slug != params.Slug {
+			continue
+		}
+		if !hasAllTags(c, params.Tags) {
+			continue
+		}
+		out.Checks = append(out.Checks, c)
+	}
+	return out, nil
+}
+
+func hasAllTags(c Check, tags []string) bool {
+	have := strings.Fields(c.Tags)
+	for _, tag := range tags {
+		if !slices.Contains(have, tag) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *memoryClient) GetChannels(ctx context.Context, opts ...CallOption) (*ChannelListResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return &ChannelListResponse{Channels: slices.Clone(m.channels)}, nil
+}
+
+func (m *memoryClient) GetCheck(ctx context.Context, identifier string, opts ...CallOption) (*Check, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	idx := m.find(identifier)
+	if idx < 0 {
+		return nil, fmt.Errorf("get check failed with 404: %v", Error{Err: "not found"})
+	}
+	check := m.checks[idx]
+	return &check, nil
+}
+
+func (m *memoryClient) CreateCheck(ctx context.Context, create *CreateCheck, opts ...CallOption) (*Check, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.nextID++
+	check := Check{
+		UUID:     fmt.Sprintf("uuid-%d", m.nextID),
+		Name:     create.Name,
+		Slug:     create.Slug,
+		Tags:     create.Tags,
+		Desc:     create.Description,
+		Timeout:  create.Timeout,
+		Grace:    create.Grace,
+		Schedule: create.Schedule,
+		Timezone: create.Timezone,
+		Status:   string(StatusNew),
+	}
+	m.checks = append(m.checks, check)
+	m.record("create %s", create.Slug)
+	return &check, nil
+}
+
+func (m *memoryClient) UpdateCheck(ctx context.Context, uuid string, update *UpdateCheck, opts ...CallOption) (*Check, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	idx := m.find(uuid)
+	if idx < 0 {
+		return nil, fmt.Errorf("update check failed with 404: %v", Error{Err: "not found"})
+	}
+	check := &m.checks[idx]
+	if update.Name != "" {
+		check.Name = update.Name
+	}
+	if update.Slug != "" {
+		check.Slug = update.Slug
+	}
+	if update.Tags != "" {
+		check.Tags = update.Tags
+	}
+	if update.Description != "" {
+		check.Desc = update.Description
+	}
+	if update.Timeout != 0 {
+		check.Timeout = update.Timeout
+	}
+	if update.Grace != 0 {
+		check.Grace = update.Grace
+	}
+	if update.Schedule != "" {
+		check.Schedule = update.Schedule
+	}
+	if update.Timezone != "" {
+		check.Timezone = update.Timezone
+	}
+	m.record("update %s", check.Slug)
+	out := *check
+	return &out, nil
+}
+
+func (m *memoryClient) UpdateCheckRaw(ctx context.Context, uuid string, body json.RawMessage, opts ...CallOption) (*Check, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	idx := m.find(uuid)
+	if idx < 0 {
+		return nil, fmt.Errorf("update check failed with 404: %v", Error{Err: "not found"})
+	}
+	if err := json.Unmarshal(body, (*checkFields)(&m.checks[idx])); err != nil {
+		return nil, err
+	}
+	m.record("update %s", m.checks[idx].Slug)
+	out := m.checks[idx]
+	return &out, nil
+}
+
+func (m *memoryClient) DeleteCheck(ctx context.Context, uuid string, opts ...CallOption) (*Check, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	idx := m.find(uuid)
+	if idx < 0 {
+		return nil, fmt.Errorf("delete check failed with 404: %v", Error{Err: "not found"})
+	}
+	check := m.checks[idx]
+	m.checks = slices.Delete(m.checks, idx, idx+1)
+	m.record("delete %s", check.Slug)
+	return &check, nil
+}
+
+func (m *memoryClient) PauseCheck(ctx context.Context, uuid string, opts ...CallOption) (*Check, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	idx := m.find(uuid)
+	if idx < 0 {
+		return nil, fmt.Errorf("pause check failed with 404: %v", Error{Err: "not found"})
+	}
+	m.checks[idx].Status = string(StatusPaused)
+	m.record("pause %s", m.checks[idx].Slug)
+	check := m.checks[idx]
+	return &check, nil
+}
+
+func (m *memoryClient) ResumeCheck(ctx context.Context, uuid string, opts ...CallOption) (*Check, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	idx := m.find(uuid)
+	if idx < 0 {
+		return nil, fmt.Errorf("resume check failed with 404: %v", Error{Err: "not found"})
+	}
+	m.checks[idx].Status = string(StatusNew)
+	m.record("resume %s", m.checks[idx].Slug)
+	check := m.checks[idx]
+	return &check, nil
+}
+
+func (m *memoryClient) GetFlips(ctx context.Context, identifier string, params GetFlipsRequest, opts ...CallOption) (*FlipListResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.find(identifier) < 0 {
+		return nil, fmt.Errorf("get flips failed with 404: %v", Error{Err: "not found"})
+	}
+	return &FlipListResponse{Flips: slices.Clone(m.flips[identifier])}, nil
+}
+
+func (m *memoryClient) GetPings(ctx context.Context, identifier string, opts ...CallOption) (*PingListResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.find(identifier) < 0 {
+		return nil, fmt.Errorf("get pings failed with 404: %v", Error{Err: "not found"})
+	}
+	return &PingListResponse{Pings: slices.Clone(m.pings[identifier])}, nil
+}
@@ -0,0 +1,120 @@
+package healthchecksio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/url"
+	"os"
+	"runtime/debug"
+	"slices"
+	"strconv"
+)
+
+// WithPingMetadata attaches key/value metadata (e.g. host, version, region) to every ping
+// the client sends. Pings with metadata send a PingEnvelope as their body.
+func WithPingMetadata(metadata map[string]string) ClientOption {
+	return func(c *client) {
+		if c.pingMetadata == nil {
+			c.pingMetadata = make(map[string]string)
+		}
+		maps.Copy(c.pingMetadata, metadata)
+	}
+}
+
+// WithPingIdentity adds the hostname, process ID and app version to every ping's metadata,
+// so when one replica out of many fails the failing ping says which machine sent it.
+// An empty version uses the main module's version from the binary's build info.
+func WithPingIdentity(version string) ClientOption {
+	return WithPingMetadata(processIdentity(version))
+}
+
+func processIdentity(version string) map[string]string {
+	identity := map[string]string{
+		"pid": strconv.Itoa(os.Getpid()),
+	}
+	if host, err := os.Hostname(); err == nil {
+		identity["host"] = host
+	}
+	if version == "" {
+		if info, ok := debug.ReadBuildInfo(); ok && info.Main.Version != "(devel)" {
+			version = info.Main.Version
+		}
+	}
+	if version != "" {
+		identity["version"] = version
+	}
+	return identity
+}
+
+type pingMetadataKey struct{}
+
+// ContextWithPingMetadata returns a context whose pings carry metadata, merged over any
+// metadata already on ctx and the client's WithPingMetadata values.
+func ContextWithPingMetadata(ctx context.Context, metadata map[string]string) context.Context {
+	merged := maps.Clone(pingMetadataFromContext(ctx))
+	if merged == nil {
+		merged = make(map[string]string, len(metadata))
+	}
+	maps.Copy(merged, metadata)
+	return context.WithValue(ctx, pingMetadataKey{}, merged)
+}
+
+func pingMetadataFromContext(ctx context.Context) map[string]string {
+	metadata, _ := ctx.Value(pingMetadataKey{}).(map[string]string)
+	return metadata
+}
+
+type pingOptionsKey struct{}
+
+// WithPingContext returns a context whose pings default to opts, added after any options
+// already on ctx, so code deep inside a job can ping with the run's correlation data (e.g.
+// WithRunID) set up where the job starts. Options passed to Ping are applied after them.
+func WithPingContext(ctx context.Context, opts ...PingOption) context.Context {
+	merged := append(slices.Clip(pingOptionsFromContext(ctx)), opts...)
+	return context.WithValue(ctx, pingOptionsKey{}, slices.Clip(merged))
+}
+
+func pingOptionsFromContext(ctx context.Context) []PingOption {
+	opts, _ := ctx.Value(pingOptionsKey{}).([]PingOption)
+	return opts
+}
+
+// pingAddress parses pingURL and applies the ping options from ctx, then opts
+func pingAddress(ctx context.Context, pingURL string, opts []PingOption) (*url.URL, error) {
+	addr, err := url.Parse(pingURL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing ping url: %v", err)
+	}
+	for _, opt := range pingOptionsFromContext(ctx) {
+		addr = opt(addr)
+	}
+	for _, opt := range opts {
+		addr = opt(addr)
+	}
+	return addr, nil
+}
+
+// pingBody wraps body in a PingEnvelope when the client or ctx has metadata, otherwise
+// body is returned as is. Bodies which already are an envelope get the metadata merged in.
+func (c *client) pingBody(ctx context.Context, body []byte) ([]byte, error) {
+	fromContext := pingMetadataFromContext(ctx)
+	if len(c.pingMetadata) == 0 && len(fromContext) == 0 {
+		return body, nil
+	}
+
+	envelope, ok := ParsePingEnvelope(string(body))
+	if !ok {
+		envelope = &PingEnvelope{Body: string(body)}
+	}
+	metadata := maps.Clone(c.pingMetadata)
+	if metadata == nil {
+		metadata = make(map[string]string, len(fromContext))
+	}
+	maps.Copy(metadata, fromContext)
+	maps.Copy(metadata, envelope.Metadata)
+	envelope.Metadata = metadata
+
+	return json.Marshal(envelope)
+}
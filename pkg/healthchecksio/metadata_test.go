@@ -0,0 +1,118 @@
+package healthchecksio_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPingMetadata(t *testing.T) {
+	bodies := make(chan string, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bs, _ := io.ReadAll(r.Body)
+		bodies <- string(bs)
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+
+	// Plain bodies are untouched without metadata
+	client := healthchecksio.NewClient("", healthchecksio.WithRetryEngine(healthchecksio.NoRetries()))
+	_, err := client.Ping(ctx, srv.URL, "hello")
+	require.NoError(t, err)
+	require.Equal(t, "hello", <-bodies)
+
+	client = healthchecksio.NewClient("",
+		healthchecksio.WithPingMetadata(map[string]string{"region": "us-east-1", "version": "1.4.2"}),
+	)
+	_, err = client.Ping(ctx, srv.URL, "backed up 12GB")
+	require.NoError(t, err)
+	require.JSONEq(t, `{"metadata":{"region":"us-east-1","version":"1.4.2"},"body":"backed up 12GB"}`, <-bodies)
+
+	// Context metadata is merged over the client's
+	ctx = healthchecksio.ContextWithPingMetadata(ctx, map[string]string{"host": "web-1", "version": "1.5.0"})
+	ctx = healthchecksio.ContextWithPingMetadata(ctx, map[string]string{"job": "backup"})
+
+	target, err := client.PingTarget(srv.URL)
+	require.NoError(t, err)
+	_, err = target.Fail(ctx, nil)
+	require.NoError(t, err)
+
+	var envelope healthchecksio.PingEnvelope
+	require.NoError(t, json.Unmarshal([]byte(<-bodies), &envelope))
+	require.Equal(t, healthchecksio.PingEnvelope{
+		Metadata: map[string]string{"host": "web-1", "job": "backup", "region": "us-east-1", "version": "1.5.0"},
+	}, envelope)
+}
+
+func TestPingIdentity(t *testing.T) {
+	bodies := make(chan string, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bs, _ := io.ReadAll(r.Body)
+		bodies <- string(bs)
+	}))
+	defer srv.Close()
+
+	client := healthchecksio.NewClient("", healthchecksio.WithPingIdentity("1.4.2"))
+	_, err := client.Ping(context.Background(), srv.URL, "")
+	require.NoError(t, err)
+
+	host, err := os.Hostname()
+	require.NoError(t, err)
+
+	envelope, ok := healthchecksio.ParsePingEnvelope(<-bodies)
+	require.True(t, ok)
+	require.Equal(t, map[string]string{
+		"host":    host,
+		"pid":     strconv.Itoa(os.Getpid()),
+		"version": "1.4.2",
+	}, envelope.Metadata)
+}
+
+func TestWithPingContext(t *testing.T) {
+	requests := make(chan string, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests <- r.URL.RequestURI()
+	}))
+	defer srv.Close()
+
+	client := healthchecksio.NewClient("", healthchecksio.WithRetryEngine(healthchecksio.NoRetries()))
+	rid := "5f1e63a4-5d2b-4d6c-9d0e-0e1c3d2d7a11"
+	ctx := healthchecksio.WithPingContext(context.Background(), healthchecksio.WithRunID(rid))
+
+	_, err := client.Ping(ctx, srv.URL+"/abc", "", healthchecksio.WithStart())
+	require.NoError(t, err)
+	require.Equal(t, "/abc/start?rid="+rid, <-requests)
+
+	// Options passed to Ping are applied last
+	_, err = client.Ping(ctx, srv.URL+"/abc", "", healthchecksio.WithRunID("other"))
+	require.NoError(t, err)
+	require.Equal(t, "/abc?rid=other", <-requests)
+
+	target, err := client.PingTarget(srv.URL + "/abc")
+	require.NoError(t, err)
+	_, err = target.Fail(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, "/abc/fail?rid="+rid, <-requests)
+
+	// Journaled pings keep the context's options without applying them twice
+	journal, err := healthchecksio.NewPingJournal(client, t.TempDir())
+	require.NoError(t, err)
+	_, err = journal.Ping(healthchecksio.WithPingContext(ctx, healthchecksio.WithLog()), srv.URL+"/abc", "")
+	require.NoError(t, err)
+	require.Equal(t, "/abc/log?rid="+rid, <-requests)
+
+	// Pings without options in their context are untouched
+	_, err = client.Ping(context.Background(), srv.URL+"/abc", "")
+	require.NoError(t, err)
+	require.Equal(t, "/abc", <-requests)
+}
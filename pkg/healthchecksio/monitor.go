@@ -0,0 +1,144 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// PingOutcome is the ping sent after a monitored function returns
+type PingOutcome int
+
+const (
+	// OutcomeSuccess sends a success ping
+	OutcomeSuccess PingOutcome = iota
+	// OutcomeFail sends a fail ping, marking the check down
+	OutcomeFail
+	// OutcomeLog sends a log ping which records the error without changing the check's status
+	OutcomeLog
+	// OutcomeIgnore sends nothing
+	OutcomeIgnore
+)
+
+// DefaultClassifier reports nil errors as success, context.Canceled as a log entry
+// (e.g. a deploy stopping the process) and everything else as a failure.
+func DefaultClassifier(err error) PingOutcome {
+	switch {
+	case err == nil:
+		return OutcomeSuccess
+	case errors.Is(err, context.Canceled):
+		return OutcomeLog
+	default:
+		return OutcomeFail
+	}
+}
+
+// MonitorOptions configures a Monitor
+type MonitorOptions struct {
+	// Classify decides which ping the monitored function's result sends, defaults to DefaultClassifier
+	Classify func(error) PingOutcome
+
+	// Retries is how many more times fn is called after an error classified as OutcomeFail.
+	// Each failed attempt before the last sends a log ping.
+	Retries int
+
+	// RetryBackoff is the wait before the first retry, doubling for each later retry
+	RetryBackoff time.Duration
+
+	// Structured sends a PingEnvelope with the status, duration, host and error as
+	// every ping body instead of the plain error text
+	Structured bool
+}
+
+// Monitor wraps functions (batch jobs, cron tasks) with start and completion pings
+type Monitor struct {
+	client  Pinger
+	pingURL string
+	opts    MonitorOptions
+	host    string
+}
+
+// NewMonitor creates a Monitor which pings pingURL with client
+func NewMonitor(client Pinger, pingURL string, opts MonitorOptions) *Monitor {
+	if opts.Classify == nil {
+		opts.Classify = DefaultClassifier
+	}
+	m := &Monitor{
+		client:  client,
+		pingURL: pingURL,
+		opts:    opts,
+	}
+	if opts.Structured {
+		m.host, _ = os.Hostname()
+	}
+	return m
+}
+
+// Run sends a start ping, calls fn and pings with the classified outcome of its error.
+// The returned error is fn's error joined with any ping errors.
+func (m *Monitor) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	// Don't let a failed start ping prevent the job from running
+	_, startErr := m.client.Ping(ctx, m.pingURL, m.body("start", 0, nil, ""), WithStart())
+	started := time.Now()
+
+	var logErrs []error
+	var err error
+	wait := m.opts.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		err = fn(ctx)
+		if attempt >= m.opts.Retries || m.opts.Classify(err) != OutcomeFail {
+			break
+		}
+
+		body := m.body("log", time.Since(started), err, fmt.Sprintf("attempt %d/%d failed", attempt+1, m.opts.Retries+1))
+		if _, perr := m.client.Ping(ctx, m.pingURL, body, WithLog()); perr != nil {
+			logErrs = append(logErrs, perr)
+		}
+		if sleepUntil(ctx, time.Now().Add(wait)) != nil {
+			break
+		}
+		wait *= 2
+	}
+
+	return errors.Join(err, startErr, errors.Join(logErrs...), m.complete(context.WithoutCancel(ctx), err, time.Since(started)))
+}
+
+func (m *Monitor) complete(ctx context.Context, err error, took time.Duration) error {
+	var perr error
+	switch m.opts.Classify(err) {
+	case OutcomeSuccess:
+		_, perr = m.client.Ping(ctx, m.pingURL, m.body("success", took, err, ""))
+	case OutcomeFail:
+		_, perr = m.client.Ping(ctx, m.pingURL, m.body("fail", took, err, ""), WithFail())
+	case OutcomeLog:
+		_, perr = m.client.Ping(ctx, m.pingURL, m.body("log", took, err, ""), WithLog())
+	}
+	return perr
+}
+
+// body returns a ping's body, a PingEnvelope when the Monitor is structured or else
+// the message followed by the error
+func (m *Monitor) body(status string, took time.Duration, err error, message string) string {
+	var errText string
+	if err != nil {
+		errText = err.Error()
+	}
+	if !m.opts.Structured {
+		switch {
+		case message == "":
+			return errText
+		case errText == "":
+			return message
+		}
+		return message + ": " + errText
+	}
+	return PingEnvelope{
+		Status:   status,
+		Duration: took.Seconds(),
+		Host:     m.host,
+		Error:    errText,
+		Body:     message,
+	}.String()
+}
@@ -0,0 +1,142 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var errNoData = errors.New("no data")
+
+func TestMonitor_Run(t *testing.T) {
+	cases := []struct {
+		name     string
+		err      error
+		classify func(error) PingOutcome
+		want     string
+	}{
+		{name: "success", want: "https://hc-ping.com/job"},
+		{name: "fail", err: errors.New("boom"), want: "https://hc-ping.com/job/fail boom"},
+		{name: "canceled", err: fmt.Errorf("shutting down: %w", context.Canceled), want: "https://hc-ping.com/job/log shutting down: context canceled"},
+		{
+			name: "custom",
+			err:  errNoData,
+			classify: func(err error) PingOutcome {
+				if errors.Is(err, errNoData) {
+					return OutcomeIgnore
+				}
+				return DefaultClassifier(err)
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			pinger := &recordingPinger{}
+			monitor := NewMonitor(pinger, "https://hc-ping.com/job", MonitorOptions{
+				Classify: tc.classify,
+			})
+
+			err := monitor.Run(context.Background(), func(ctx context.Context) error {
+				return tc.err
+			})
+			require.ErrorIs(t, err, tc.err)
+
+			want := []string{"https://hc-ping.com/job/start"}
+			if tc.want != "" {
+				want = append(want, tc.want)
+			}
+			require.Equal(t, want, pinger.received())
+		})
+	}
+}
+
+func TestMonitor_Retries(t *testing.T) {
+	pinger := &recordingPinger{}
+	monitor := NewMonitor(pinger, "https://hc-ping.com/job", MonitorOptions{
+		Retries:      2,
+		RetryBackoff: time.Millisecond,
+	})
+
+	var calls int
+	err := monitor.Run(context.Background(), func(ctx context.Context) error {
+		calls++
+		if calls < 3 {
+			return fmt.Errorf("flaky %d", calls)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+	require.Equal(t, []string{
+		"https://hc-ping.com/job/start",
+		"https://hc-ping.com/job/log attempt 1/3 failed: flaky 1",
+		"https://hc-ping.com/job/log attempt 2/3 failed: flaky 2",
+		"https://hc-ping.com/job",
+	}, pinger.received())
+
+	// Exhausted retries send the fail ping, errors the classifier doesn't fail aren't retried
+	pinger = &recordingPinger{}
+	monitor = NewMonitor(pinger, "https://hc-ping.com/job", MonitorOptions{
+		Retries:      1,
+		RetryBackoff: time.Millisecond,
+	})
+	err = monitor.Run(context.Background(), func(ctx context.Context) error {
+		return errors.New("down")
+	})
+	require.EqualError(t, err, "down")
+	require.Equal(t, []string{
+		"https://hc-ping.com/job/start",
+		"https://hc-ping.com/job/log attempt 1/2 failed: down",
+		"https://hc-ping.com/job/fail down",
+	}, pinger.received())
+
+	calls = 0
+	err = monitor.Run(context.Background(), func(ctx context.Context) error {
+		calls++
+		return context.Canceled
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 1, calls)
+}
+
+func TestMonitor_Structured(t *testing.T) {
+	pinger := &recordingPinger{}
+	monitor := NewMonitor(pinger, "https://hc-ping.com/job", MonitorOptions{
+		Retries:      1,
+		RetryBackoff: time.Millisecond,
+		Structured:   true,
+	})
+	monitor.host = "web-1"
+
+	err := monitor.Run(context.Background(), func(ctx context.Context) error {
+		return errors.New("connection refused")
+	})
+	require.Error(t, err)
+
+	received := pinger.received()
+	require.Len(t, received, 3)
+
+	parse := func(ping, prefix string) *PingEnvelope {
+		body, found := strings.CutPrefix(ping, prefix+" ")
+		require.True(t, found, ping)
+		envelope, ok := ParsePingEnvelope(body)
+		require.True(t, ok, body)
+		return envelope
+	}
+	require.Equal(t, &PingEnvelope{Status: "start", Host: "web-1"}, parse(received[0], "https://hc-ping.com/job/start"))
+
+	retry := parse(received[1], "https://hc-ping.com/job/log")
+	require.Equal(t, "attempt 1/2 failed", retry.Body)
+	require.Equal(t, "connection refused", retry.Error)
+
+	fail := parse(received[2], "https://hc-ping.com/job/fail")
+	require.Equal(t, "fail", fail.Status)
+	require.Equal(t, "web-1", fail.Host)
+	require.Equal(t, "connection refused", fail.Error)
+	require.Positive(t, fail.Duration)
+}
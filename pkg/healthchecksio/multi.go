@@ -0,0 +1,60 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// GetChecksByUUIDs fetches each check concurrently, with at most concurrency (default 4) requests
+// in flight, and returns them keyed by UUID. Checks which couldn't be fetched are missing from the
+// map and their errors are joined together.
+func GetChecksByUUIDs(ctx context.Context, client CheckReader, uuids []string, concurrency int) (map[string]*Check, error) {
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+
+	var (
+		mu   sync.Mutex
+		out  = make(map[string]*Check, len(uuids))
+		errs []error
+		wg   sync.WaitGroup
+		sem  = make(chan struct{}, concurrency)
+		seen = make(map[string]bool, len(uuids))
+	)
+	for _, uuid := range uuids {
+		if seen[uuid] {
+			continue
+		}
+		seen[uuid] = true
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			mu.Lock()
+			errs = append(errs, fmt.Errorf("get check %s: %w", uuid, ctx.Err()))
+			mu.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			check, err := client.GetCheck(ctx, uuid)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("get check %s: %w", uuid, err))
+				return
+			}
+			out[uuid] = check
+		}()
+	}
+	wg.Wait()
+
+	return out, errors.Join(errs...)
+}
@@ -0,0 +1,51 @@
+package healthchecksio
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// slowReader counts how many GetCheck calls are in flight
+type slowReader struct {
+	*memoryClient
+
+	inFlight atomic.Int32
+	maxSeen  atomic.Int32
+}
+
+func (s *slowReader) GetCheck(ctx context.Context, identifier string, opts ...CallOption) (*Check, error) {
+	n := s.inFlight.Add(1)
+	defer s.inFlight.Add(-1)
+	for {
+		max := s.maxSeen.Load()
+		if n <= max || s.maxSeen.CompareAndSwap(max, n) {
+			break
+		}
+	}
+	time.Sleep(5 * time.Millisecond)
+	return s.memoryClient.GetCheck(ctx, identifier, opts...)
+}
+
+func TestGetChecksByUUIDs(t *testing.T) {
+	reader := &slowReader{
+		memoryClient: &memoryClient{
+			checks: []Check{
+				{UUID: "1", Slug: "a"},
+				{UUID: "2", Slug: "b"},
+				{UUID: "3", Slug: "c"},
+				{UUID: "4", Slug: "d"},
+				{UUID: "5", Slug: "e"},
+			},
+		},
+	}
+
+	checks, err := GetChecksByUUIDs(context.Background(), reader, []string{"1", "2", "3", "4", "5", "1", "missing"}, 2)
+	require.ErrorContains(t, err, "get check missing: get check failed with 404")
+	require.Len(t, checks, 5)
+	require.Equal(t, "c", checks["3"].Slug)
+	require.LessOrEqual(t, reader.maxSeen.Load(), int32(2))
+}
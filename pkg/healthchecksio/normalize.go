@@ -0,0 +1,70 @@
+package healthchecksio
+
+import (
+	"slices"
+	"strings"
+)
+
+// defaultTimezone is the tz the API gives cron checks created without one
+const defaultTimezone = "UTC"
+
+// normalizeDesired canonicalizes want the way the API stores it, so comparing against
+// what the API returns doesn't report formatting differences as drift
+func normalizeDesired(want CreateCheck) CreateCheck {
+	want.Name = strings.TrimSpace(want.Name)
+	want.Tags = canonicalTags(want.Tags)
+	want.Schedule = canonicalSchedule(want.Schedule)
+	if want.Schedule != "" && want.Timezone == "" {
+		want.Timezone = defaultTimezone
+	}
+	want.Methods = canonicalMethods(want.Methods)
+	if want.Channels != "*" {
+		want.Channels = canonicalList(want.Channels)
+	}
+	want.StartKeywords = canonicalList(want.StartKeywords)
+	want.SuccessKeywords = canonicalList(want.SuccessKeywords)
+	want.FailureKeywords = canonicalList(want.FailureKeywords)
+	return want
+}
+
+// normalizeExisting canonicalizes a check returned by the API to compare with normalizeDesired
+func normalizeExisting(current Check) Check {
+	current.Name = strings.TrimSpace(current.Name)
+	current.Tags = canonicalTags(current.Tags)
+	current.Schedule = canonicalSchedule(current.Schedule)
+	if current.Schedule != "" && current.Timezone == "" {
+		current.Timezone = defaultTimezone
+	}
+	current.Methods = canonicalMethods(current.Methods)
+	current.Channels = canonicalList(current.Channels)
+	current.StartKw = canonicalList(current.StartKw)
+	current.SuccessKw = canonicalList(current.SuccessKw)
+	current.FailureKw = canonicalList(current.FailureKw)
+	return current
+}
+
+// canonicalTags sorts and de-duplicates the space separated tags, the API keeps them in
+// whatever order they were last written
+func canonicalTags(tags string) string {
+	fields := strings.Fields(tags)
+	slices.Sort(fields)
+	return strings.Join(slices.Compact(fields), " ")
+}
+
+// canonicalSchedule collapses the whitespace between a cron expression's fields
+func canonicalSchedule(schedule string) string {
+	return strings.Join(strings.Fields(schedule), " ")
+}
+
+// canonicalMethods upper cases methods, the API accepts "" (any method) or "POST"
+func canonicalMethods(methods string) string {
+	return strings.ToUpper(strings.TrimSpace(methods))
+}
+
+// canonicalList sorts and de-duplicates a comma separated list such as channel IDs or
+// keywords, dropping the whitespace around each item
+func canonicalList(list string) string {
+	items := splitChannels(list)
+	slices.Sort(items)
+	return strings.Join(slices.Compact(items), ",")
+}
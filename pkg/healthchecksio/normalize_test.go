@@ -0,0 +1,101 @@
+package healthchecksio
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffCheck_Normalization(t *testing.T) {
+	cases := []struct {
+		name    string
+		want    CreateCheck
+		current Check
+		fields  []string
+	}{
+		{
+			name:    "tags reordered",
+			want:    CreateCheck{Tags: "prod db  backup"},
+			current: Check{Tags: "backup db prod"},
+		},
+		{
+			name:    "duplicate tags",
+			want:    CreateCheck{Tags: "db db prod"},
+			current: Check{Tags: "prod db"},
+		},
+		{
+			name:    "tag removed",
+			want:    CreateCheck{Tags: "db"},
+			current: Check{Tags: "db prod"},
+			fields:  []string{"tags"},
+		},
+		{
+			name:    "schedule whitespace",
+			want:    CreateCheck{Schedule: " 0  3 * *\t* ", Timezone: "UTC"},
+			current: Check{Schedule: "0 3 * * *", Timezone: "UTC"},
+		},
+		{
+			name:    "default timezone",
+			want:    CreateCheck{Schedule: "0 3 * * *"},
+			current: Check{Schedule: "0 3 * * *", Timezone: "UTC"},
+		},
+		{
+			name:    "timeout ignored on cron checks",
+			want:    CreateCheck{Schedule: "0 3 * * *", Timeout: 86400, Grace: 3600},
+			current: Check{Schedule: "0 3 * * *", Timezone: "UTC", Grace: 3600},
+		},
+		{
+			name:    "timezone ignored on simple checks",
+			want:    CreateCheck{Timeout: 86400, Timezone: "UTC"},
+			current: Check{Timeout: 86400},
+		},
+		{
+			name:    "cron to simple",
+			want:    CreateCheck{Timeout: 3600},
+			current: Check{Schedule: "0 3 * * *", Timezone: "UTC"},
+			fields:  []string{"timeout"},
+		},
+		{
+			name:    "timezone on an existing cron check",
+			want:    CreateCheck{Timezone: "Europe/Berlin"},
+			current: Check{Schedule: "0 3 * * *", Timezone: "UTC"},
+			fields:  []string{"tz"},
+		},
+		{
+			name:    "default grace",
+			want:    CreateCheck{Timeout: 86400},
+			current: Check{Timeout: 86400, Grace: 3600},
+		},
+		{
+			name:    "methods case",
+			want:    CreateCheck{Methods: "post"},
+			current: Check{Methods: "POST"},
+		},
+		{
+			name:    "channels reordered",
+			want:    CreateCheck{Channels: "b, a"},
+			current: Check{Channels: "a,b"},
+		},
+		{
+			name:    "every channel",
+			want:    CreateCheck{Channels: "*"},
+			current: Check{Channels: "a,b"},
+			fields:  []string{"channels"},
+		},
+		{
+			name:    "keyword spacing",
+			want:    CreateCheck{SuccessKeywords: "ok, done", FailureKeywords: "error,failed"},
+			current: Check{SuccessKw: "done,ok", FailureKw: "error, failed"},
+		},
+		{
+			name:    "name padding",
+			want:    CreateCheck{Name: "Backup "},
+			current: Check{Name: "Backup"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.fields, diffCheck(&tc.want, &tc.current))
+		})
+	}
+}
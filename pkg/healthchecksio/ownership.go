@@ -0,0 +1,118 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	ownerTagPrefix = "owner:"
+	teamTagPrefix  = "team:"
+
+	// runbookPrefix starts the description line holding a check's runbook URL
+	runbookPrefix = "Runbook: "
+)
+
+// Ownership is who is responsible for a check. Owner and Team are stored as structured
+// tags (owner:payments, team:billing) so checks can be filtered by them, the runbook URL
+// as a "Runbook: <url>" line of the description.
+type Ownership struct {
+	Owner   string
+	Team    string
+	Runbook string
+}
+
+// OwnershipOf returns the ownership recorded on check
+func OwnershipOf(check Check) Ownership {
+	return ownershipOf(check.Tags, check.Desc)
+}
+
+func ownershipOf(tags, desc string) Ownership {
+	var o Ownership
+	for _, tag := range strings.Fields(tags) {
+		if owner, found := strings.CutPrefix(tag, ownerTagPrefix); found && o.Owner == "" {
+			o.Owner = owner
+		}
+		if team, found := strings.CutPrefix(tag, teamTagPrefix); found && o.Team == "" {
+			o.Team = team
+		}
+	}
+	for line := range strings.Lines(desc) {
+		if runbook, found := strings.CutPrefix(strings.TrimSpace(line), runbookPrefix); found {
+			o.Runbook = strings.TrimSpace(runbook)
+			break
+		}
+	}
+	return o
+}
+
+// Apply returns check with the ownership's non-empty fields recorded, replacing any owner,
+// team or runbook it already declared
+func (o Ownership) Apply(check CreateCheck) CreateCheck {
+	tags := strings.Fields(check.Tags)
+	if o.Owner != "" {
+		tags = slices.DeleteFunc(tags, func(tag string) bool { return strings.HasPrefix(tag, ownerTagPrefix) })
+		tags = append(tags, ownerTagPrefix+o.Owner)
+	}
+	if o.Team != "" {
+		tags = slices.DeleteFunc(tags, func(tag string) bool { return strings.HasPrefix(tag, teamTagPrefix) })
+		tags = append(tags, teamTagPrefix+o.Team)
+	}
+	check.Tags = strings.Join(tags, " ")
+
+	if o.Runbook != "" {
+		var lines []string
+		for line := range strings.Lines(check.Description) {
+			if !strings.HasPrefix(strings.TrimSpace(line), runbookPrefix) {
+				lines = append(lines, strings.TrimRight(line, "\n"))
+			}
+		}
+		lines = append(lines, runbookPrefix+o.Runbook)
+		check.Description = strings.TrimLeft(strings.Join(lines, "\n"), "\n")
+	}
+	return check
+}
+
+// ChecksOwnedBy lists the checks tagged owner:<owner>
+func ChecksOwnedBy(ctx context.Context, client CheckReader, owner string) ([]Check, error) {
+	if owner == "" {
+		return nil, errors.New("checks owned by: owner is required")
+	}
+	list, err := client.GetChecks(ctx, GetChecks{Tags: []string{ownerTagPrefix + owner}})
+	if err != nil {
+		return nil, fmt.Errorf("checks owned by %s: %w", owner, err)
+	}
+	return list.Checks, nil
+}
+
+// ValidateOwnership returns an error naming every check which doesn't declare an owner
+func ValidateOwnership(checks []Check) error {
+	var errs []error
+	for _, check := range checks {
+		if OwnershipOf(check).Owner == "" {
+			errs = append(errs, fmt.Errorf("check %s has no owner", checkLabel(check.Slug, check.Name)))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// validateDesiredOwnership is ValidateOwnership for manifest entries
+func validateDesiredOwnership(checks []CreateCheck) error {
+	var errs []error
+	for _, check := range checks {
+		if ownershipOf(check.Tags, check.Description).Owner == "" {
+			errs = append(errs, fmt.Errorf("check %s has no owner", checkLabel(check.Slug, check.Name)))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func checkLabel(slug, name string) string {
+	if slug != "" {
+		return slug
+	}
+	return fmt.Sprintf("%q", name)
+}
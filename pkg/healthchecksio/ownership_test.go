@@ -0,0 +1,69 @@
+package healthchecksio
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOwnership(t *testing.T) {
+	owners := Ownership{Owner: "payments", Team: "billing", Runbook: "https://wiki.example.com/backup"}
+
+	create := owners.Apply(CreateCheck{
+		Slug:        "backup",
+		Tags:        "db owner:alice",
+		Description: "Runs pg_dump\nRunbook: https://old.example.com",
+	})
+	require.Equal(t, "db owner:payments team:billing", create.Tags)
+	require.Equal(t, "Runs pg_dump\nRunbook: https://wiki.example.com/backup", create.Description)
+
+	check := Check{Tags: create.Tags, Desc: create.Description}
+	require.Equal(t, owners, OwnershipOf(check))
+
+	// Empty fields are left alone
+	create = Ownership{Owner: "infra"}.Apply(CreateCheck{Tags: "team:ops", Description: "Nightly"})
+	require.Equal(t, "team:ops owner:infra", create.Tags)
+	require.Equal(t, "Nightly", create.Description)
+
+	create = Ownership{Runbook: "https://wiki"}.Apply(CreateCheck{})
+	require.Equal(t, "Runbook: https://wiki", create.Description)
+
+	require.Equal(t, Ownership{}, OwnershipOf(Check{Tags: "db", Desc: "no runbook here"}))
+}
+
+func TestChecksOwnedBy(t *testing.T) {
+	client := &memoryClient{checks: []Check{
+		{UUID: "1", Slug: "backup", Tags: "db owner:payments"},
+		{UUID: "2", Slug: "web", Tags: "owner:frontend"},
+		{UUID: "3", Slug: "orphan"},
+	}}
+
+	checks, err := ChecksOwnedBy(context.Background(), client, "payments")
+	require.NoError(t, err)
+	require.Len(t, checks, 1)
+	require.Equal(t, "backup", checks[0].Slug)
+
+	_, err = ChecksOwnedBy(context.Background(), client, "")
+	require.Error(t, err)
+
+	err = ValidateOwnership(client.checks)
+	require.EqualError(t, err, "check orphan has no owner")
+	require.NoError(t, ValidateOwnership(client.checks[:2]))
+}
+
+func TestPlanSyncRequireOwner(t *testing.T) {
+	client := &memoryClient{}
+	desired := []CreateCheck{
+		{Slug: "backup", Tags: "owner:payments"},
+		{Slug: "web"},
+		{Slug: "cron"},
+	}
+
+	_, err := PlanSync(context.Background(), client, desired, SyncOptions{RequireOwner: true})
+	require.ErrorContains(t, err, "check web has no owner")
+	require.ErrorContains(t, err, "check cron has no owner")
+
+	_, err = PlanSync(context.Background(), client, desired[:1], SyncOptions{RequireOwner: true})
+	require.NoError(t, err)
+}
@@ -0,0 +1,111 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// checkListPage is one page of GetChecks. The API returns every check at once today,
+// but self-hosted forks and future versions may paginate with a next link in the body
+// or a Link header.
+type checkListPage struct {
+	Checks []Check `json:"checks"`
+	Next   string  `json:"next,omitempty"`
+}
+
+// getCheckPages performs req and follows next links until every page of checks was read
+func (c *client) getCheckPages(ctx context.Context, req apiRequest) (*CheckListResponse, error) {
+	ctx, span := c.startSpan(ctx, req.endpoint, req.attrs...)
+	defer span.End()
+
+	out := &CheckListResponse{}
+	seen := make(map[string]bool)
+	for pages := 1; ; pages++ {
+		resp, err := c.doRequest(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		var page checkListPage
+		err = c.decode(resp.Body, &page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", req.name, err)
+		}
+		out.Checks = append(out.Checks, page.Checks...)
+
+		next := page.Next
+		if next == "" {
+			next = linkNext(resp.Header)
+		}
+		if next == "" {
+			if pages > 1 {
+				span.SetAttributes(attr("http.pages", pages))
+			}
+			return out, nil
+		}
+
+		current := responseURL(resp)
+		target, err := c.nextPage(current, next)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", req.name, err)
+		}
+		if seen[target.String()] {
+			return nil, fmt.Errorf("%s: pagination loops back to %s", req.name, target.Redacted())
+		}
+		seen[target.String()] = true
+		req.address = target
+	}
+}
+
+// responseURL returns the URL resp was read from, nil when the transport didn't record it
+func responseURL(resp *http.Response) *url.URL {
+	if resp.Request == nil {
+		return nil
+	}
+	return resp.Request.URL
+}
+
+// nextPage resolves a next link against the page it was found on, or the base URL when
+// that's unknown. Links to other hosts are refused so the API key is never sent anywhere
+// but the API.
+func (c *client) nextPage(current *url.URL, next string) (*url.URL, error) {
+	ref, err := url.Parse(next)
+	if err != nil {
+		return nil, fmt.Errorf("parsing next page: %w", err)
+	}
+	base, err := url.Parse(c.baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("problem parsing baseAddress: %w", err)
+	}
+	if current == nil {
+		current = base
+	}
+	address := current.ResolveReference(ref)
+	if address.Scheme != base.Scheme || address.Host != base.Host {
+		return nil, errors.New("next page is on another host than the API")
+	}
+	return address, nil
+}
+
+// linkNext returns the target of the rel="next" entry of a response's Link header
+func linkNext(header http.Header) string {
+	for _, value := range header.Values("Link") {
+		for _, link := range strings.Split(value, ",") {
+			target, params, found := strings.Cut(strings.TrimSpace(link), ";")
+			if !found || !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+			for _, param := range strings.Split(params, ";") {
+				key, val, _ := strings.Cut(strings.TrimSpace(param), "=")
+				if strings.EqualFold(key, "rel") && strings.Contains(" "+strings.Trim(val, `"`)+" ", " next ") {
+					return strings.TrimSuffix(strings.TrimPrefix(target, "<"), ">")
+				}
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,86 @@
+package healthchecksio_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetChecksPagination(t *testing.T) {
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "key", r.Header.Get("X-Api-Key"))
+		require.Equal(t, "prod", r.URL.Query().Get("tag"))
+
+		switch r.URL.Query().Get("page") {
+		case "":
+			w.Write([]byte(`{"checks":[{"uuid":"1"}],"next":"` + srv.URL + `/api/v3/checks/?tag=prod&page=2"}`))
+		case "2":
+			// Relative links in a Link header work too
+			w.Header().Set("Link", `</api/v3/checks/?tag=prod&page=3>; rel="next", </api/v3/checks/?tag=prod>; rel="first"`)
+			w.Write([]byte(`{"checks":[{"uuid":"2"},{"uuid":"3"}]}`))
+		case "3":
+			w.Write([]byte(`{"checks":[{"uuid":"4"}],"next":null}`))
+		}
+	}))
+	defer srv.Close()
+
+	client := healthchecksio.NewClient("key", healthchecksio.WithBaseURL(srv.URL+"/api/v3"), healthchecksio.WithStrictDecoding())
+	list, err := client.GetChecks(context.Background(), healthchecksio.GetChecks{Tags: []string{"prod"}})
+	require.NoError(t, err)
+
+	var uuids []string
+	for _, check := range list.Checks {
+		uuids = append(uuids, check.UUID)
+	}
+	require.Equal(t, []string{"1", "2", "3", "4"}, uuids)
+	require.Equal(t, uint64(3), client.Stats().Requests["get-checks"])
+}
+
+func TestGetChecksPaginationStrict(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "":
+			w.Write([]byte(`{"checks":[{"uuid":"1"}],"next":"/checks/?page=2"}`))
+		case "2":
+			w.Write([]byte(`{"checks":[{"uuid":"2","new_field":true}]}`))
+		}
+	}))
+	defer srv.Close()
+
+	// Unknown fields on any page fail strict decoding
+	client := healthchecksio.NewClient("key", healthchecksio.WithBaseURL(srv.URL), healthchecksio.WithStrictDecoding())
+	_, err := client.GetChecks(context.Background(), healthchecksio.GetChecks{})
+	require.ErrorContains(t, err, "unknown fields [new_field]")
+	require.Equal(t, uint64(2), client.Stats().Requests["get-checks"])
+
+	client = healthchecksio.NewClient("key", healthchecksio.WithBaseURL(srv.URL))
+	list, err := client.GetChecks(context.Background(), healthchecksio.GetChecks{})
+	require.NoError(t, err)
+	require.Len(t, list.Checks, 2)
+}
+
+func TestGetChecksPaginationGuards(t *testing.T) {
+	next := ""
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"checks":[],"next":"` + next + `"}`))
+	}))
+	defer srv.Close()
+
+	client := healthchecksio.NewClient("key", healthchecksio.WithBaseURL(srv.URL))
+	ctx := context.Background()
+
+	// The API key is never sent to another host
+	next = "https://evil.example.com/checks/?page=2"
+	_, err := client.GetChecks(ctx, healthchecksio.GetChecks{})
+	require.ErrorContains(t, err, "get checks: next page is on another host than the API")
+
+	next = "/checks/?page=2"
+	_, err = client.GetChecks(ctx, healthchecksio.GetChecks{})
+	require.ErrorContains(t, err, "get checks: pagination loops back to")
+}
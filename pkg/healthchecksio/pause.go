@@ -0,0 +1,96 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// pausedUntilTag prefixes the tag recording when a check paused by PauseCheckFor should resume
+const pausedUntilTag = "paused-until:"
+
+// PauseCheckFor pauses a check and tags it with the time it should be resumed at
+// (paused-until:<unix seconds>). The tag lives on the check itself so ResumeExpired needs
+// no other state and can run from cron on any host. The tag is written before pausing,
+// so a failed pause leaves a token behind rather than a check paused forever.
+func PauseCheckFor(ctx context.Context, client ManagementClient, uuid string, d time.Duration) (*Check, error) {
+	if d <= 0 {
+		return nil, errors.New("pause check for: duration must be positive")
+	}
+
+	until := time.Now().Add(d).Unix()
+	_, err := editTags(ctx, client, uuid, func(current []string) []string {
+		current = slices.DeleteFunc(current, isPausedUntilTag)
+		return append(current, pausedUntilTag+strconv.FormatInt(until, 10))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("pause check for: %w", err)
+	}
+
+	check, err := client.PauseCheck(ctx, uuid)
+	if err != nil {
+		return nil, fmt.Errorf("pause check for: %w", err)
+	}
+	return check, nil
+}
+
+// PausedUntil returns when a check paused by PauseCheckFor should resume
+func PausedUntil(check Check) (time.Time, bool) {
+	for _, tag := range strings.Fields(check.Tags) {
+		if !isPausedUntilTag(tag) {
+			continue
+		}
+		unix, err := strconv.ParseInt(strings.TrimPrefix(tag, pausedUntilTag), 10, 64)
+		if err == nil {
+			return time.Unix(unix, 0), true
+		}
+	}
+	return time.Time{}, false
+}
+
+func isPausedUntilTag(tag string) bool {
+	return strings.HasPrefix(tag, pausedUntilTag)
+}
+
+// ResumeExpired resumes every check matching filter whose PauseCheckFor duration has passed
+// and removes its paused-until tag. Checks which are no longer paused (a ping resumes a check)
+// only have the tag removed. The resumed checks are returned, all of them are attempted and
+// failures are joined together.
+func ResumeExpired(ctx context.Context, client ManagementClient, filter GetChecks) ([]Check, error) {
+	checks, err := client.GetChecks(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("resume expired: %w", err)
+	}
+
+	now := time.Now()
+
+	var resumed []Check
+	var errs []error
+	for _, check := range checks.Checks {
+		until, exists := PausedUntil(check)
+		if !exists || now.Before(until) {
+			continue
+		}
+
+		if CheckStatus(check.Status) == StatusPaused {
+			if _, err := client.ResumeCheck(ctx, check.UUID); err != nil {
+				errs = append(errs, fmt.Errorf("resume %s: %w", check.Slug, err))
+				continue
+			}
+			resumed = append(resumed, check)
+		}
+
+		// Removed after resuming so a failed resume is retried on the next run
+		_, err := editTags(ctx, client, check.UUID, func(current []string) []string {
+			return slices.DeleteFunc(current, isPausedUntilTag)
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("resume %s: %w", check.Slug, err))
+		}
+	}
+	return resumed, errors.Join(errs...)
+}
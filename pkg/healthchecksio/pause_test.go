@@ -0,0 +1,80 @@
+package healthchecksio
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPauseCheckFor(t *testing.T) {
+	client := &memoryClient{checks: []Check{
+		{UUID: "1", Slug: "backup", Tags: "db paused-until:100", Status: "up"},
+	}}
+	ctx := context.Background()
+
+	check, err := PauseCheckFor(ctx, client, "1", time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, "paused", check.Status)
+
+	// the old token is replaced
+	until, exists := PausedUntil(client.checks[0])
+	require.True(t, exists)
+	require.WithinDuration(t, time.Now().Add(time.Hour), until, 2*time.Second)
+	require.Equal(t, "db "+pausedUntilTag+strconv.FormatInt(until.Unix(), 10), client.checks[0].Tags)
+
+	_, err = PauseCheckFor(ctx, client, "1", 0)
+	require.ErrorContains(t, err, "duration must be positive")
+
+	_, err = PauseCheckFor(ctx, client, "missing", time.Hour)
+	require.ErrorContains(t, err, "404")
+}
+
+func TestResumeExpired(t *testing.T) {
+	past := pausedUntilTag + strconv.FormatInt(time.Now().Add(-time.Minute).Unix(), 10)
+	future := pausedUntilTag + strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10)
+
+	client := &memoryClient{checks: []Check{
+		{UUID: "1", Slug: "expired", Tags: "db " + past, Status: "paused"},
+		{UUID: "2", Slug: "pending", Tags: future, Status: "paused"},
+		{UUID: "3", Slug: "pinged", Tags: past, Status: "up"},
+		{UUID: "4", Slug: "manual", Status: "paused"},
+	}}
+
+	resumed, err := ResumeExpired(context.Background(), client, GetChecks{})
+	require.NoError(t, err)
+	require.Len(t, resumed, 1)
+	require.Equal(t, "expired", resumed[0].Slug)
+
+	require.Equal(t, "new", client.checks[0].Status)
+	require.Equal(t, "db", client.checks[0].Tags)
+	require.Equal(t, "paused", client.checks[1].Status)
+	require.Equal(t, future, client.checks[1].Tags)
+	require.Equal(t, "up", client.checks[2].Status)
+	require.Empty(t, client.checks[2].Tags)
+	require.Equal(t, "paused", client.checks[3].Status)
+	require.Equal(t, []string{"resume expired", "update expired", "update pinged"}, client.calls)
+}
+
+func TestPauseCheckFor_Sync(t *testing.T) {
+	client := &memoryClient{checks: []Check{
+		{UUID: "1", Slug: "backup", Tags: "db", Status: "up"},
+	}}
+	ctx := context.Background()
+
+	_, err := PauseCheckFor(ctx, client, "1", time.Hour)
+	require.NoError(t, err)
+	token := client.checks[0].Tags[len("db "):]
+
+	// A manifest setting the tags keeps the pause token, so ResumeExpired still resumes it
+	desired := []CreateCheck{{Slug: "backup", Tags: "db nightly"}}
+	_, err = Sync(ctx, client, desired, SyncOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "db nightly "+token, client.checks[0].Tags)
+
+	plan, err := PlanSync(ctx, client, desired, SyncOptions{})
+	require.NoError(t, err)
+	require.Empty(t, plan.Pending())
+}
@@ -0,0 +1,244 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// PingRetrierOptions configures a PingRetrier
+type PingRetrierOptions struct {
+	// Window is how long after its first attempt a ping keeps being retried, defaults to 10 minutes
+	Window time.Duration
+
+	// Backoff is the wait before the first background retry, doubling for each later retry
+	// up to MaxBackoff. Defaults to 5 seconds and 1 minute.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+
+	// OnFailure is called from the retrying goroutine when a ping is given up on: its window
+	// passed, the ping endpoint answered with an error or the PingRetrier was stopped
+	OnFailure func(ping PingRequest, err error)
+}
+
+// PingRetrier rides out network partitions: pings which fail without reaching the ping
+// endpoint (DNS failures, refused connections, timeouts) are retried in the background
+// while Ping returns immediately, so a job's success isn't held up or lost by a network blip.
+// Pings to the same URL are delivered in order, a success can't be overtaken by the retried
+// start ping before it.
+type PingRetrier struct {
+	client Pinger
+	opts   PingRetrierOptions
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu      sync.Mutex
+	queues  map[string][]queuedPing // by URL, while its first ping is being retried
+	pending int
+	idle    chan struct{} // closed once pending drops to zero
+}
+
+// queuedPing is a ping waiting in its URL's queue
+type queuedPing struct {
+	ctx      context.Context
+	ping     PingRequest
+	deadline time.Time
+
+	// lastErr is the error of the ping's first attempt, nil when it wasn't sent yet
+	lastErr error
+}
+
+// NewPingRetrier creates a PingRetrier which sends pings with client
+func NewPingRetrier(client Pinger, opts PingRetrierOptions) *PingRetrier {
+	if opts.Window <= 0 {
+		opts.Window = 10 * time.Minute
+	}
+	if opts.Backoff <= 0 {
+		opts.Backoff = 5 * time.Second
+	}
+	if opts.MaxBackoff < opts.Backoff {
+		opts.MaxBackoff = max(time.Minute, opts.Backoff)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &PingRetrier{
+		client: client,
+		opts:   opts,
+		ctx:    ctx,
+		cancel: cancel,
+		queues: make(map[string][]queuedPing),
+	}
+}
+
+// Ping sends the ping once. When that fails with a network error the ping is retried in the
+// background and Ping returns the first attempt's result without an error, the outcome is
+// only reported through OnFailure. Every other error is returned as is. While earlier pings
+// to pingURL are being retried the ping is queued behind them instead of being sent, and
+// Ping returns a result without attempts.
+func (r *PingRetrier) Ping(ctx context.Context, pingURL, body string, opts ...PingOption) (*PingResult, error) {
+	ping := PingRequest{URL: pingURL, Body: body, Options: opts}
+
+	r.mu.Lock()
+	if _, retrying := r.queues[pingURL]; retrying && r.ctx.Err() == nil {
+		r.enqueueLocked(queuedPing{ctx: ctx, ping: ping, deadline: time.Now().Add(r.opts.Window)})
+		r.mu.Unlock()
+		return &PingResult{URL: pingURL}, nil
+	}
+	r.mu.Unlock()
+
+	result, err := r.client.Ping(ctx, pingURL, body, opts...)
+	if !networkError(ctx, result, err) {
+		return result, err
+	}
+
+	deadline := time.Now().Add(r.opts.Window)
+	if result != nil && !result.SentAt.IsZero() {
+		deadline = result.SentAt.Add(r.opts.Window)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.ctx.Err() != nil {
+		return result, err // stopped
+	}
+	r.enqueueLocked(queuedPing{ctx: ctx, ping: ping, deadline: deadline, lastErr: err})
+	return result, nil
+}
+
+// enqueueLocked adds ping to its URL's queue, starting a goroutine to work through the queue
+// when it's new
+func (r *PingRetrier) enqueueLocked(ping queuedPing) {
+	if r.pending == 0 {
+		r.idle = make(chan struct{})
+	}
+	r.pending++
+
+	queue, exists := r.queues[ping.ping.URL]
+	r.queues[ping.ping.URL] = append(queue, ping)
+	if !exists {
+		go r.drain(ping.ping.URL)
+	}
+}
+
+// drain delivers (or gives up on) the pings queued for pingURL in order, until none are left
+func (r *PingRetrier) drain(pingURL string) {
+	for {
+		r.mu.Lock()
+		queue := r.queues[pingURL]
+		if len(queue) == 0 {
+			delete(r.queues, pingURL)
+			r.mu.Unlock()
+			return
+		}
+		next := queue[0]
+		r.mu.Unlock()
+
+		r.deliver(next)
+
+		r.mu.Lock()
+		r.queues[pingURL] = r.queues[pingURL][1:]
+		r.pending--
+		if r.pending == 0 {
+			close(r.idle)
+		}
+		r.mu.Unlock()
+	}
+}
+
+func (r *PingRetrier) deliver(next queuedPing) {
+	// Keep the caller's values (e.g. trace spans) but not its cancellation,
+	// the job which pinged has likely returned by now
+	ctx, cancel := context.WithCancel(context.WithoutCancel(next.ctx))
+	defer cancel()
+	defer context.AfterFunc(r.ctx, cancel)()
+
+	if err := r.retry(ctx, next.ping, next.deadline, next.lastErr); err != nil && r.opts.OnFailure != nil {
+		r.opts.OnFailure(next.ping, err)
+	}
+}
+
+// retry resends ping with capped backoff until it's delivered, the ping endpoint answers
+// with an error or deadline passes. lastErr is the error of the previous attempt, a ping
+// without one is sent right away.
+func (r *PingRetrier) retry(ctx context.Context, ping PingRequest, deadline time.Time, lastErr error) error {
+	wait := r.opts.Backoff
+	if lastErr == nil {
+		wait = 0
+	}
+	for {
+		at := time.Now().Add(wait)
+		if at.After(deadline) {
+			at = deadline
+		}
+		if err := sleepUntil(ctx, at); err != nil {
+			return fmt.Errorf("ping retrier: stopped: %w", errors.Join(err, lastErr))
+		}
+
+		result, err := r.client.Ping(ctx, ping.URL, ping.Body, ping.Options...)
+		switch {
+		case err == nil:
+			return nil
+		case ctx.Err() != nil:
+			return fmt.Errorf("ping retrier: stopped: %w", errors.Join(ctx.Err(), lastErr))
+		case !networkError(ctx, result, err):
+			return fmt.Errorf("ping retrier: %w", err)
+		case !time.Now().Before(deadline):
+			return fmt.Errorf("ping retrier: gave up after %s: %w", r.opts.Window, err)
+		}
+		lastErr = err
+		wait = min(max(wait*2, r.opts.Backoff), r.opts.MaxBackoff)
+	}
+}
+
+// Pending returns how many pings are being retried
+func (r *PingRetrier) Pending() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.pending
+}
+
+// Wait blocks until every ping being retried was delivered or given up on, or ctx is done
+func (r *PingRetrier) Wait(ctx context.Context) error {
+	r.mu.Lock()
+	idle := r.idle
+	pending := r.pending
+	r.mu.Unlock()
+	if pending == 0 {
+		return nil
+	}
+
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Stop gives up on every ping being retried, calling OnFailure for each, and waits for
+// their goroutines to finish. Later pings aren't retried.
+func (r *PingRetrier) Stop() {
+	r.mu.Lock()
+	r.cancel()
+	r.mu.Unlock()
+
+	r.Wait(context.Background())
+}
+
+// networkError reports whether a ping failed without getting a response, for reasons
+// which may pass (as opposed to a closed client, a bad URL or an untrusted certificate)
+func networkError(ctx context.Context, result *PingResult, err error) bool {
+	if err == nil || result == nil || ctx.Err() != nil {
+		return false
+	}
+	if result.Attempts == 0 || result.StatusCode != 0 {
+		return false
+	}
+	var tooLarge *ResponseTooLargeError
+	if errors.As(err, &tooLarge) || errors.Is(err, context.Canceled) || errors.Is(err, ErrClientClosed) {
+		return false
+	}
+	return retryableError(err)
+}
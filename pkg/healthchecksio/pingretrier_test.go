@@ -0,0 +1,174 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// partitionedPinger fails its first failures pings with a network error, or with status
+// when it's set
+type partitionedPinger struct {
+	Client
+
+	mu       sync.Mutex
+	failures int
+	status   int
+	attempts []string
+}
+
+func (p *partitionedPinger) Ping(ctx context.Context, pingURL, body string, opts ...PingOption) (*PingResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.attempts = append(p.attempts, pingURL+" "+body)
+	if len(p.attempts) > p.failures {
+		return &PingResult{URL: pingURL, Attempts: 1, StatusCode: 200}, nil
+	}
+	if p.status != 0 {
+		return &PingResult{URL: pingURL, Attempts: 1, StatusCode: p.status}, errors.New("ping failed")
+	}
+	return &PingResult{URL: pingURL, Attempts: 1}, &AttemptError{Attempts: 1, Err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}
+}
+
+func (p *partitionedPinger) sent() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.attempts)
+}
+
+func TestPingRetrier(t *testing.T) {
+	pinger := &partitionedPinger{failures: 3}
+	var failed []error
+	retrier := NewPingRetrier(pinger, PingRetrierOptions{
+		Backoff:   time.Millisecond,
+		OnFailure: func(ping PingRequest, err error) { failed = append(failed, err) },
+	})
+	defer retrier.Stop()
+
+	_, err := retrier.Ping(context.Background(), "https://hc-ping.com/abc", "done")
+	require.NoError(t, err)
+
+	require.NoError(t, retrier.Wait(context.Background()))
+	require.Equal(t, 4, pinger.sent())
+	require.Zero(t, retrier.Pending())
+	require.Empty(t, failed)
+}
+
+func TestPingRetrier_Order(t *testing.T) {
+	pinger := &partitionedPinger{failures: 3}
+	retrier := NewPingRetrier(pinger, PingRetrierOptions{Backoff: time.Millisecond})
+	defer retrier.Stop()
+
+	// The success waits for the start ping being retried instead of overtaking it
+	ctx := context.Background()
+	_, err := retrier.Ping(ctx, "https://hc-ping.com/abc", "", WithStart())
+	require.NoError(t, err)
+	result, err := retrier.Ping(ctx, "https://hc-ping.com/abc", "done")
+	require.NoError(t, err)
+	require.Zero(t, result.Attempts)
+	require.Equal(t, 2, retrier.Pending())
+
+	require.NoError(t, retrier.Wait(ctx))
+	require.Equal(t, []string{
+		"https://hc-ping.com/abc ",
+		"https://hc-ping.com/abc ",
+		"https://hc-ping.com/abc ",
+		"https://hc-ping.com/abc ", // the start ping is delivered
+		"https://hc-ping.com/abc done",
+	}, pinger.attempts)
+
+	_, err = retrier.Ping(ctx, "https://hc-ping.com/abc", "again")
+	require.NoError(t, err)
+	require.Zero(t, retrier.Pending())
+}
+
+func TestPingRetrier_Window(t *testing.T) {
+	pinger := &partitionedPinger{failures: 1000}
+	failed := make(chan error, 1)
+	retrier := NewPingRetrier(pinger, PingRetrierOptions{
+		Window:     30 * time.Millisecond,
+		Backoff:    time.Millisecond,
+		MaxBackoff: 5 * time.Millisecond,
+		OnFailure:  func(ping PingRequest, err error) { failed <- err },
+	})
+	defer retrier.Stop()
+
+	started := time.Now()
+	_, err := retrier.Ping(context.Background(), "https://hc-ping.com/abc", "")
+	require.NoError(t, err)
+
+	select {
+	case err := <-failed:
+		require.ErrorContains(t, err, "gave up after 30ms")
+		require.ErrorContains(t, err, "connection refused")
+		require.Less(t, time.Since(started), time.Second)
+	case <-time.After(5 * time.Second):
+		t.Fatal("ping wasn't given up on")
+	}
+}
+
+func TestPingRetrier_Rejected(t *testing.T) {
+	// Pings the endpoint answered aren't retried
+	pinger := &partitionedPinger{failures: 1, status: 400}
+	retrier := NewPingRetrier(pinger, PingRetrierOptions{Backoff: time.Millisecond})
+	defer retrier.Stop()
+
+	_, err := retrier.Ping(context.Background(), "https://hc-ping.com/abc", "")
+	require.ErrorContains(t, err, "ping failed")
+	require.Zero(t, retrier.Pending())
+	require.Equal(t, 1, pinger.sent())
+}
+
+func TestPingRetrier_Stop(t *testing.T) {
+	pinger := &partitionedPinger{failures: 1000}
+	var mu sync.Mutex
+	var failed []PingRequest
+	var errs []error
+	retrier := NewPingRetrier(pinger, PingRetrierOptions{
+		Backoff: time.Hour,
+		OnFailure: func(ping PingRequest, err error) {
+			mu.Lock()
+			failed = append(failed, ping)
+			errs = append(errs, err)
+			mu.Unlock()
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	_, err := retrier.Ping(ctx, "https://hc-ping.com/abc", "", WithFail())
+	require.NoError(t, err)
+	cancel() // the caller's context doesn't end retries
+	require.Equal(t, 1, retrier.Pending())
+
+	retrier.Stop()
+	require.Len(t, failed, 1)
+	require.Equal(t, "https://hc-ping.com/abc", failed[0].URL)
+	require.Len(t, failed[0].Options, 1)
+	require.ErrorIs(t, errs[0], context.Canceled)
+
+	// Stopped retriers return network errors
+	_, err = retrier.Ping(context.Background(), "https://hc-ping.com/abc", "")
+	require.ErrorContains(t, err, "connection refused")
+}
+
+func TestNetworkError(t *testing.T) {
+	ctx := context.Background()
+	refused := &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+
+	require.True(t, networkError(ctx, &PingResult{Attempts: 1}, refused))
+	require.False(t, networkError(ctx, &PingResult{Attempts: 1}, nil))
+	require.False(t, networkError(ctx, &PingResult{Attempts: 1, StatusCode: 500}, errors.New("ping failed with 500")))
+	require.False(t, networkError(ctx, &PingResult{}, ErrClientClosed))
+	require.False(t, networkError(ctx, nil, errors.New("parsing ping url")))
+	require.False(t, networkError(ctx, &PingResult{Attempts: 1}, errors.New(`Post "ftp://x": unsupported protocol scheme "ftp"`)))
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	require.False(t, networkError(canceled, &PingResult{Attempts: 1}, refused))
+}
@@ -0,0 +1,132 @@
+package healthchecksio
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+)
+
+// PingTarget is a ping URL parsed once up front. Agents sending many pings to the
+// same check should prefer it over Ping, which parses and rebuilds the URL every call.
+type PingTarget struct {
+	client *client
+
+	success string
+	start   string
+	fail    string
+	log     string
+}
+
+// PingTarget parses pingURL and precomputes the address of every ping kind
+func (c *client) PingTarget(pingURL string) (*PingTarget, error) {
+	addr, err := url.Parse(pingURL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing ping url: %v", err)
+	}
+	return &PingTarget{
+		client:  c,
+		success: addr.String(),
+		start:   WithStart()(addr).String(),
+		fail:    WithFail()(addr).String(),
+		log:     WithLog()(addr).String(),
+	}, nil
+}
+
+// Success sends a success ping
+func (t *PingTarget) Success(ctx context.Context, body []byte) (*PingResult, error) {
+	return t.send(ctx, t.success, body)
+}
+
+// Start sends a start ping
+func (t *PingTarget) Start(ctx context.Context, body []byte) (*PingResult, error) {
+	return t.send(ctx, t.start, body)
+}
+
+// Fail sends a failure ping
+func (t *PingTarget) Fail(ctx context.Context, body []byte) (*PingResult, error) {
+	return t.send(ctx, t.fail, body)
+}
+
+// Log sends a log ping
+func (t *PingTarget) Log(ctx context.Context, body []byte) (*PingResult, error) {
+	return t.send(ctx, t.log, body)
+}
+
+func (t *PingTarget) send(ctx context.Context, address string, body []byte) (*PingResult, error) {
+	ctx, span := t.client.startSpan(ctx, "ping")
+	defer span.End()
+
+	// Only rebuild the precomputed address for pings with options from WithPingContext
+	if len(pingOptionsFromContext(ctx)) > 0 {
+		addr, err := pingAddress(ctx, address, nil)
+		if err != nil {
+			return nil, err
+		}
+		address = addr.String()
+	}
+
+	// Only pay for attributes when someone is collecting them
+	if span.IsRecording() {
+		span.SetAttributes(attr("check.ping_url", address))
+	}
+	body, err := t.client.pingBody(ctx, body)
+	if err != nil {
+		return nil, fmt.Errorf("ping: %w", err)
+	}
+	var reader io.Reader
+	if len(body) > 0 {
+		reader = bytes.NewReader(body)
+	}
+	return t.client.sendPing(ctx, address, reader)
+}
+
+var pingUserAgent = []string{"go-healthchecks-client"}
+
+// pingBuffers holds buffers for reading ping error responses
+var pingBuffers = sync.Pool{
+	New: func() any {
+		buf := make([]byte, 1024)
+		return &buf
+	},
+}
+
+// sendPing POSTs body to address. body should be a *bytes.Reader, *bytes.Buffer or
+// *strings.Reader (or nil) so it can be sent again when the ping is retried.
+// The result is returned alongside errors once the ping was attempted.
+func (c *client) sendPing(ctx context.Context, address string, body io.Reader) (*PingResult, error) {
+	tracker := &attemptTracker{}
+	req, err := http.NewRequestWithContext(context.WithValue(ctx, attemptTrackerKey{}, tracker), "POST", address, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header["User-Agent"] = pingUserAgent // shared, avoids allocating a new slice per ping
+
+	resp, err := c.send(req, "ping")
+	result := tracker.pingResult(address, resp)
+	if err != nil {
+		return result, &AttemptError{Attempts: result.Attempts, Err: fmt.Errorf("ping: %w", err)}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		buf := pingBuffers.Get().(*[]byte)
+		defer pingBuffers.Put(buf)
+
+		n, _ := io.ReadFull(resp.Body, *buf)
+		return result, &AttemptError{Attempts: result.Attempts, Err: fmt.Errorf("ping failed with %d: %v", resp.StatusCode, string((*buf)[:n]))}
+	}
+
+	// Drain the body so the connection can be reused
+	if _, err := io.Copy(io.Discard, resp.Body); err != nil {
+		var tooLarge *ResponseTooLargeError
+		if errors.As(err, &tooLarge) {
+			return result, fmt.Errorf("ping: %w", err)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,58 @@
+package healthchecksio
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// okTransport answers every request with an empty 200 so benchmarks only measure the client
+type okTransport struct{}
+
+func (okTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		io.Copy(io.Discard, req.Body)
+		req.Body.Close()
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       http.NoBody,
+		Request:    req,
+	}, nil
+}
+
+func BenchmarkPing(b *testing.B) {
+	ctx := context.Background()
+
+	c := NewClient("").(*client)
+	c.httpClient = &http.Client{Transport: okTransport{}}
+
+	pingURL := "https://hc-ping.com/5bf66975-d4c7-4bf5-bcc8-b8d8a82ea278"
+	body := strings.Repeat("x", 256)
+
+	b.Run("Ping", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			if _, err := c.Ping(ctx, pingURL, body); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("PingTarget", func(b *testing.B) {
+		target, err := c.PingTarget(pingURL)
+		if err != nil {
+			b.Fatal(err)
+		}
+		bs := []byte(body)
+
+		b.ReportAllocs()
+		for b.Loop() {
+			if _, err := target.Success(ctx, bs); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,91 @@
+package healthchecksio_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPingTarget(t *testing.T) {
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	var paths, bodies []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bs, _ := io.ReadAll(r.Body)
+
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		bodies = append(bodies, string(bs))
+		mu.Unlock()
+
+		if r.URL.Path == "/bad/fail" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte("not found"))
+		}
+	}))
+	defer srv.Close()
+
+	client := healthchecksio.NewClient("")
+	target, err := client.PingTarget(srv.URL + "/5bf66975")
+	require.NoError(t, err)
+
+	_, err = target.Start(ctx, nil)
+	require.NoError(t, err)
+	_, err = target.Log(ctx, []byte("halfway"))
+	require.NoError(t, err)
+	_, err = target.Success(ctx, []byte("done"))
+	require.NoError(t, err)
+	_, err = target.Fail(ctx, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"/5bf66975/start", "/5bf66975/log", "/5bf66975", "/5bf66975/fail"}, paths)
+	require.Equal(t, []string{"", "halfway", "done", ""}, bodies)
+
+	bad, err := client.PingTarget(srv.URL + "/bad")
+	require.NoError(t, err)
+	_, err = bad.Fail(ctx, nil)
+	require.ErrorContains(t, err, "ping failed with 404: not found")
+}
+
+func TestPingResult(t *testing.T) {
+	ctx := context.Background()
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}))
+	defer srv.Close()
+
+	client := healthchecksio.NewClient("", healthchecksio.WithRetryEngine(healthchecksio.ExponentialBackoff(2, time.Millisecond, time.Millisecond)))
+
+	before := time.Now()
+	result, err := client.Ping(ctx, srv.URL+"/5bf66975", "", healthchecksio.WithFail())
+	require.NoError(t, err)
+	require.Equal(t, srv.URL+"/5bf66975/fail", result.URL)
+	require.Equal(t, 2, result.Attempts)
+	require.Equal(t, http.StatusOK, result.StatusCode)
+	require.WithinDuration(t, before, result.SentAt, time.Second)
+	require.GreaterOrEqual(t, result.Latency, 5*time.Millisecond)
+
+	// Results are returned with errors once the ping was attempted
+	target, err := client.PingTarget("http://127.0.0.1:1/5bf66975")
+	require.NoError(t, err)
+	result, err = target.Success(ctx, nil)
+	require.Error(t, err)
+	require.Equal(t, 3, result.Attempts)
+	require.Zero(t, result.StatusCode)
+}
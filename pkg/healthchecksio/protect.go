@@ -0,0 +1,84 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ErrDeleteProtected is returned by DeleteCheck when WithDeleteProtection refuses the delete
+var ErrDeleteProtected = errors.New("healthchecksio: delete protected")
+
+// DeleteProtection guards checks against scripted deletes, see WithDeleteProtection
+type DeleteProtection struct {
+	// RequireConfirmation makes DeleteCheck refuse checks unless it's called with
+	// WithConfirmation of the check's slug, or its UUID when it has no slug
+	RequireConfirmation bool
+
+	// ProtectedTags are tags of checks DeleteCheck always refuses to delete
+	ProtectedTags []string
+}
+
+// WithDeleteProtection makes DeleteCheck read the check first and refuse, with
+// ErrDeleteProtected, to delete it when it has a protected tag or the delete wasn't
+// confirmed. Helpers which delete checks (ApplyGroup, ApplySync, PruneStaleChecks,
+// PurgeArchived, TransferChecks and cutovers) only confirm when their options set
+// ConfirmDeletes, protected tags still apply to them.
+func WithDeleteProtection(protection DeleteProtection) ClientOption {
+	return func(c *client) {
+		c.deleteProtection = &protection
+	}
+}
+
+// WithConfirmation confirms a delete of the check whose slug (or UUID without a slug) is token
+func WithConfirmation(token string) CallOption {
+	return func(o *callOptions) {
+		o.confirmation = token
+	}
+}
+
+// ConfirmDelete confirms deleting check, for callers which already hold the check they're deleting
+func ConfirmDelete(check Check) CallOption {
+	return WithConfirmation(confirmationToken(check))
+}
+
+// confirmDelete confirms deleting check for helpers whose caller set ConfirmDeletes
+func confirmDelete(confirm bool, check Check) []CallOption {
+	if !confirm {
+		return nil
+	}
+	return []CallOption{ConfirmDelete(check)}
+}
+
+func confirmationToken(check Check) string {
+	if check.Slug != "" {
+		return check.Slug
+	}
+	return check.UUID
+}
+
+// guardDelete returns an error wrapping ErrDeleteProtected when the client's DeleteProtection refuses the delete
+func (c *client) guardDelete(ctx context.Context, uuid string, opts []CallOption) error {
+	p := c.deleteProtection
+	if p == nil || (!p.RequireConfirmation && len(p.ProtectedTags) == 0) {
+		return nil
+	}
+	o := c.callOptions(opts)
+
+	check, err := c.GetCheck(ctx, uuid, WithAPIKey(o.apiKey), WithoutCache())
+	if err != nil {
+		return fmt.Errorf("delete check: %w", err)
+	}
+	tags := strings.Fields(check.Tags)
+	for _, tag := range p.ProtectedTags {
+		if slices.Contains(tags, tag) {
+			return fmt.Errorf("delete check: %w: %s has protected tag %q", ErrDeleteProtected, check.Name, tag)
+		}
+	}
+	if p.RequireConfirmation && o.confirmation != confirmationToken(*check) {
+		return fmt.Errorf("delete check: %w: %s needs WithConfirmation of its slug", ErrDeleteProtected, check.Name)
+	}
+	return nil
+}
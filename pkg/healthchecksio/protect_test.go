@@ -0,0 +1,113 @@
+package healthchecksio_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithDeleteProtection(t *testing.T) {
+	checks := map[string]string{
+		"1": `{"uuid":"1","name":"Backup","slug":"backup","tags":"prod db"}`,
+		"2": `{"uuid":"2","name":"Report","slug":"report","tags":"staging"}`,
+		"3": `{"uuid":"3","name":"Cleanup","tags":"staging"}`,
+	}
+	var mu sync.Mutex
+	var deleted []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uuid := strings.TrimPrefix(r.URL.Path, "/checks/")
+		if r.Method == "DELETE" {
+			mu.Lock()
+			deleted = append(deleted, uuid)
+			mu.Unlock()
+		}
+		w.Write([]byte(checks[uuid]))
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := healthchecksio.NewClient("key",
+		healthchecksio.WithBaseURL(srv.URL),
+		healthchecksio.WithDeleteProtection(healthchecksio.DeleteProtection{
+			RequireConfirmation: true,
+			ProtectedTags:       []string{"prod"},
+		}),
+	)
+
+	// Protected tags can't be deleted, even when confirmed
+	_, err := client.DeleteCheck(ctx, "1", healthchecksio.WithConfirmation("backup"))
+	require.ErrorIs(t, err, healthchecksio.ErrDeleteProtected)
+	require.ErrorContains(t, err, `Backup has protected tag "prod"`)
+
+	_, err = client.DeleteCheck(ctx, "2")
+	require.ErrorIs(t, err, healthchecksio.ErrDeleteProtected)
+	_, err = client.DeleteCheck(ctx, "2", healthchecksio.WithConfirmation("backup"))
+	require.ErrorIs(t, err, healthchecksio.ErrDeleteProtected)
+	require.Empty(t, deleted)
+
+	_, err = client.DeleteCheck(ctx, "2", healthchecksio.WithConfirmation("report"))
+	require.NoError(t, err)
+
+	// Checks without a slug are confirmed with their UUID
+	_, err = client.DeleteCheck(ctx, "3", healthchecksio.WithConfirmation("3"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"2", "3"}, deleted)
+
+	// Bulk deletes aren't confirmed unless the caller opts in
+	group := []healthchecksio.Check{
+		{UUID: "1", Name: "Backup", Slug: "backup"},
+		{UUID: "2", Name: "Report", Slug: "report"},
+	}
+	deleted = nil
+	err = healthchecksio.ApplyGroup(ctx, client, healthchecksio.GroupDelete, group, healthchecksio.GroupOptions{})
+	require.ErrorIs(t, err, healthchecksio.ErrDeleteProtected)
+	require.Empty(t, deleted)
+
+	// and still skip protected checks when they do
+	err = healthchecksio.ApplyGroup(ctx, client, healthchecksio.GroupDelete, group, healthchecksio.GroupOptions{
+		Concurrency:    1,
+		ConfirmDeletes: true,
+	})
+	require.ErrorIs(t, err, healthchecksio.ErrDeleteProtected)
+	require.Equal(t, []string{"2"}, deleted)
+}
+
+func TestWithDeleteProtection_SyncPrune(t *testing.T) {
+	check := `{"uuid":"1","name":"Report","slug":"report","tags":"staging"}`
+	var deleted []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/checks/":
+			w.Write([]byte(`{"checks":[` + check + `]}`))
+		case r.Method == "DELETE":
+			deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/checks/"))
+			w.Write([]byte(check))
+		default:
+			w.Write([]byte(check))
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := healthchecksio.NewClient("key",
+		healthchecksio.WithBaseURL(srv.URL),
+		healthchecksio.WithDeleteProtection(healthchecksio.DeleteProtection{RequireConfirmation: true}),
+	)
+
+	// Pruning is refused unless the caller confirms the deletes
+	plan, err := healthchecksio.Sync(ctx, client, nil, healthchecksio.SyncOptions{Prune: true})
+	require.ErrorIs(t, err, healthchecksio.ErrDeleteProtected)
+	require.Len(t, plan.Pending(), 1)
+	require.Empty(t, deleted)
+
+	_, err = healthchecksio.Sync(ctx, client, nil, healthchecksio.SyncOptions{Prune: true, ConfirmDeletes: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1"}, deleted)
+}
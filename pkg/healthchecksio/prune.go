@@ -0,0 +1,84 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// PruneOptions configures PruneStaleChecks
+type PruneOptions struct {
+	// Filter limits which checks are considered
+	Filter GetChecks
+
+	// Prefix limits pruning to checks whose slug starts with it
+	Prefix string
+
+	// OlderThan is how long ago a check must have last pinged to be stale
+	OlderThan time.Duration
+
+	// NeverPinged also prunes checks which have never received a ping. The API doesn't report
+	// when checks were created so this includes checks created moments ago.
+	NeverPinged bool
+
+	// Pause pauses stale checks instead of deleting them
+	Pause bool
+
+	// DryRun returns the stale checks without changing them
+	DryRun bool
+
+	// ConfirmDeletes confirms deleting stale checks for WithDeleteProtection's RequireConfirmation
+	ConfirmDeletes bool
+}
+
+// PruneStaleChecks finds checks whose last ping is older than opts.OlderThan and deletes (or pauses) them.
+// The stale checks are returned, all of them are attempted and failures are joined together.
+func PruneStaleChecks(ctx context.Context, client ManagementClient, opts PruneOptions) ([]Check, error) {
+	if opts.OlderThan <= 0 && !opts.NeverPinged {
+		return nil, errors.New("prune stale checks: OlderThan or NeverPinged is required")
+	}
+
+	checks, err := client.GetChecks(ctx, opts.Filter)
+	if err != nil {
+		return nil, fmt.Errorf("prune stale checks: %w", err)
+	}
+
+	cutoff := time.Now().Add(-opts.OlderThan)
+
+	var stale []Check
+	for _, check := range checks.Checks {
+		if !strings.HasPrefix(check.Slug, opts.Prefix) {
+			continue
+		}
+		if opts.Pause && check.Status == string(StatusPaused) {
+			continue
+		}
+
+		last, pinged := check.LastPingTime()
+		switch {
+		case !pinged && opts.NeverPinged:
+		case pinged && opts.OlderThan > 0 && last.Before(cutoff):
+		default:
+			continue
+		}
+		stale = append(stale, check)
+	}
+	if opts.DryRun {
+		return stale, nil
+	}
+
+	var errs []error
+	for _, check := range stale {
+		if opts.Pause {
+			_, err = client.PauseCheck(ctx, check.UUID)
+		} else {
+			_, err = client.DeleteCheck(ctx, check.UUID, confirmDelete(opts.ConfirmDeletes, check)...)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("prune %s: %w", check.Slug, err))
+		}
+	}
+	return stale, errors.Join(errs...)
+}
@@ -0,0 +1,64 @@
+package healthchecksio
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruneStaleChecks(t *testing.T) {
+	ctx := context.Background()
+	ago := func(d time.Duration) string {
+		return time.Now().Add(-d).UTC().Format(time.RFC3339)
+	}
+	newMock := func() *memoryClient {
+		return &memoryClient{
+			checks: []Check{
+				{UUID: "1", Slug: "ci-1234", LastPing: ago(30 * 24 * time.Hour), Status: "down"},
+				{UUID: "2", Slug: "ci-5678", LastPing: ago(time.Hour), Status: "up"},
+				{UUID: "3", Slug: "ci-never", Status: "new"},
+				{UUID: "4", Slug: "backup", LastPing: ago(60 * 24 * time.Hour), Status: "down"},
+			},
+		}
+	}
+
+	mock := newMock()
+	stale, err := PruneStaleChecks(ctx, mock, PruneOptions{
+		Prefix:    "ci-",
+		OlderThan: 7 * 24 * time.Hour,
+		DryRun:    true,
+	})
+	require.NoError(t, err)
+	require.Len(t, stale, 1)
+	require.Equal(t, "ci-1234", stale[0].Slug)
+	require.Empty(t, mock.calls)
+
+	_, err = PruneStaleChecks(ctx, mock, PruneOptions{
+		Prefix:      "ci-",
+		OlderThan:   7 * 24 * time.Hour,
+		NeverPinged: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"delete ci-1234", "delete ci-never"}, mock.calls)
+
+	mock = newMock()
+	_, err = PruneStaleChecks(ctx, mock, PruneOptions{
+		OlderThan: 7 * 24 * time.Hour,
+		Pause:     true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"pause ci-1234", "pause backup"}, mock.calls)
+
+	// Already paused checks are left alone
+	_, err = PruneStaleChecks(ctx, mock, PruneOptions{
+		OlderThan: 7 * 24 * time.Hour,
+		Pause:     true,
+	})
+	require.NoError(t, err)
+	require.Len(t, mock.calls, 2)
+
+	_, err = PruneStaleChecks(ctx, mock, PruneOptions{})
+	require.ErrorContains(t, err, "OlderThan or NeverPinged is required")
+}
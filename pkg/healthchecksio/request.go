@@ -0,0 +1,134 @@
+package healthchecksio
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+)
+
+// apiRequest describes one management API call
+type apiRequest struct {
+	// name prefixes errors, e.g. "get checks"
+	name string
+
+	// endpoint names the call in Stats and its span, e.g. "get-checks"
+	endpoint string
+
+	// attrs are added to the call's span
+	attrs []Attribute
+
+	method string
+	path   []string
+	query  url.Values
+
+	// address replaces path and query when set, for following pagination links
+	address *url.URL
+
+	// body is JSON encoded with the client's codec, json.RawMessage and []byte are sent as-is
+	body any
+
+	// status is the expected response status, zero accepts any 2xx
+	status int
+
+	opts []CallOption
+}
+
+// doJSON performs req and decodes its JSON response into a T
+func doJSON[T any](ctx context.Context, c *client, req apiRequest) (*T, error) {
+	ctx, span := c.startSpan(ctx, req.endpoint, req.attrs...)
+	defer span.End()
+
+	resp, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var out T
+	if err := c.decode(resp.Body, &out); err != nil {
+		return nil, fmt.Errorf("%s: %w", req.name, err)
+	}
+	return &out, nil
+}
+
+// doRequest builds and sends req, returning an error unless the response has the expected status.
+// Callers must close the response body.
+func (c *client) doRequest(ctx context.Context, req apiRequest) (*http.Response, error) {
+	address := req.address
+	if address == nil {
+		var err error
+		address, err = c.buildAddress(req.path...)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", req.name, err)
+		}
+		if len(req.query) > 0 {
+			address.RawQuery = req.query.Encode()
+		}
+	}
+
+	var err error
+	var reqBody []byte
+	switch b := req.body.(type) {
+	case nil:
+	case json.RawMessage:
+		reqBody = b
+	case []byte:
+		reqBody = b
+	default:
+		reqBody, err = c.marshal(b)
+		if err != nil {
+			return nil, fmt.Errorf("%s: encoding body: %w", req.name, err)
+		}
+	}
+
+	var bodyReader io.Reader
+	if reqBody != nil {
+		bodyReader = bytes.NewReader(reqBody)
+	}
+	tracker := &attemptTracker{}
+	opts := c.callOptions(req.opts)
+	ctx = context.WithValue(ctx, attemptTrackerKey{}, tracker)
+	if opts.noCache {
+		ctx = context.WithValue(ctx, noCacheKey{}, true)
+	}
+	r, err := http.NewRequestWithContext(ctx, req.method, address.String(), bodyReader)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", req.name, err)
+	}
+	r.Header.Set("X-Api-Key", opts.apiKey)
+
+	// One ID for every attempt of the call, so retries can be matched up in the server's logs
+	requestID := opts.requestID
+	if requestID == "" {
+		requestID = uuid.NewString()
+	}
+	r.Header.Set("X-Request-Id", requestID)
+	spanFromContext(ctx).SetAttributes(attr("http.request_id", requestID))
+	if reqBody != nil {
+		r.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.send(r, req.endpoint)
+	if err != nil {
+		return nil, tracker.callError(requestID, fmt.Errorf("%s: %w", req.name, err))
+	}
+
+	expected := resp.StatusCode == req.status
+	if req.status == 0 {
+		expected = resp.StatusCode >= 200 && resp.StatusCode <= 299
+	}
+	if !expected {
+		defer resp.Body.Close()
+
+		var apiErr Error
+		json.NewDecoder(resp.Body).Decode(&apiErr)
+		return nil, tracker.callError(requestID, fmt.Errorf("%s failed with %d: %v", req.name, resp.StatusCode, apiErr))
+	}
+	return resp, nil
+}
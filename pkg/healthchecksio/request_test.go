@@ -0,0 +1,153 @@
+package healthchecksio_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorPrefixes(t *testing.T) {
+	var requestID atomic.Value
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID.Store(r.Header.Get("X-Request-Id"))
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"not found"}`))
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	calls := map[string]func(client healthchecksio.Client) error{
+		"create check": func(client healthchecksio.Client) error {
+			_, err := client.CreateCheck(ctx, &healthchecksio.CreateCheck{})
+			return err
+		},
+		"get checks": func(client healthchecksio.Client) error {
+			_, err := client.GetChecks(ctx, healthchecksio.GetChecks{})
+			return err
+		},
+		"get check": func(client healthchecksio.Client) error {
+			_, err := client.GetCheck(ctx, "abc")
+			return err
+		},
+		"update check": func(client healthchecksio.Client) error {
+			_, err := client.UpdateCheck(ctx, "abc", &healthchecksio.UpdateCheck{})
+			return err
+		},
+		"delete check": func(client healthchecksio.Client) error {
+			_, err := client.DeleteCheck(ctx, "abc")
+			return err
+		},
+		"pause check": func(client healthchecksio.Client) error {
+			_, err := client.PauseCheck(ctx, "abc")
+			return err
+		},
+		"resume check": func(client healthchecksio.Client) error {
+			_, err := client.ResumeCheck(ctx, "abc")
+			return err
+		},
+		"get pings": func(client healthchecksio.Client) error {
+			_, err := client.GetPings(ctx, "abc")
+			return err
+		},
+		"get ping body": func(client healthchecksio.Client) error {
+			_, err := client.GetPingBody(ctx, "abc", 1)
+			return err
+		},
+		"get channels": func(client healthchecksio.Client) error {
+			_, err := client.GetChannels(ctx)
+			return err
+		},
+		"get flips": func(client healthchecksio.Client) error {
+			_, err := client.GetFlips(ctx, "abc", healthchecksio.GetFlipsRequest{})
+			return err
+		},
+	}
+
+	client := healthchecksio.NewClient("key", healthchecksio.WithBaseURL(srv.URL))
+	broken := healthchecksio.NewClient("key", healthchecksio.WithBaseURL("http://[::1"))
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			err := call(client)
+			require.EqualError(t, err, name+" failed with 404: not found (request "+requestID.Load().(string)+")")
+			id, ok := healthchecksio.RequestID(err)
+			require.True(t, ok)
+			require.Equal(t, requestID.Load(), id)
+
+			require.ErrorContains(t, call(broken), name+": problem parsing baseAddress")
+		})
+	}
+}
+
+func TestGetChecksTags(t *testing.T) {
+	var query url.Values
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte(`{"checks":[]}`))
+	}))
+	defer srv.Close()
+
+	client := healthchecksio.NewClient("key", healthchecksio.WithBaseURL(srv.URL))
+	_, err := client.GetChecks(context.Background(), healthchecksio.GetChecks{
+		Slug: "nightly",
+		Tags: []string{"prod", "team:billing"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, url.Values{
+		"slug": []string{"nightly"},
+		"tag":  []string{"prod", "team:billing"},
+	}, query)
+}
+
+func TestRequestID(t *testing.T) {
+	var ids []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ids = append(ids, r.Header.Get("X-Request-Id"))
+		if len(ids) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{"checks":[]}`))
+	}))
+	defer srv.Close()
+
+	var hooked string
+	client := healthchecksio.NewClient("key",
+		healthchecksio.WithBaseURL(srv.URL),
+		healthchecksio.WithRetries(1, time.Millisecond, time.Millisecond),
+		healthchecksio.WithOnResponse(func(req *http.Request, resp *http.Response, took time.Duration) {
+			hooked = req.Header.Get("X-Request-Id")
+		}),
+	)
+	ctx := context.Background()
+
+	// Retries are sent with the same ID
+	_, err := client.GetChecks(ctx, healthchecksio.GetChecks{})
+	require.NoError(t, err)
+	require.Len(t, ids, 2)
+	require.NotEmpty(t, ids[0])
+	require.Equal(t, ids[0], ids[1])
+	require.Equal(t, ids[0], hooked)
+
+	// Every call gets its own, unless one is passed in
+	_, err = client.GetChecks(ctx, healthchecksio.GetChecks{})
+	require.NoError(t, err)
+	require.NotEqual(t, ids[0], ids[2])
+
+	_, err = client.GetChecks(ctx, healthchecksio.GetChecks{}, healthchecksio.WithRequestID("upstream-123"))
+	require.NoError(t, err)
+	require.Equal(t, "upstream-123", ids[3])
+
+	// Calls which were never sent don't name an ID
+	client.Close()
+	_, err = client.GetChecks(ctx, healthchecksio.GetChecks{})
+	_, ok := healthchecksio.RequestID(err)
+	require.False(t, ok)
+}
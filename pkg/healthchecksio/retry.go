@@ -0,0 +1,123 @@
+package healthchecksio
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy decides how a response status is retried
+type RetryPolicy int
+
+const (
+	// NoRetry fails the request immediately
+	NoRetry RetryPolicy = iota + 1
+
+	// RetryWithBackoff retries with exponential backoff
+	RetryWithBackoff
+
+	// RetryAfterHeader waits for the duration in the Retry-After header,
+	// falling back to exponential backoff when it's missing
+	RetryAfterHeader
+)
+
+// WithRetryPolicy overrides how responses with the given status code are retried.
+//
+// By default 429 responses honor Retry-After, 5xx responses (except 501) use
+// exponential backoff and every other status fails immediately.
+func WithRetryPolicy(status int, policy RetryPolicy) ClientOption {
+	return func(c *client) {
+		if c.retryPolicies == nil {
+			c.retryPolicies = make(map[int]RetryPolicy)
+		}
+		c.retryPolicies[status] = policy
+	}
+}
+
+// WithRetries retries with exponential backoff, see ExponentialBackoff
+func WithRetries(max int, waitMin, waitMax time.Duration) ClientOption {
+	return WithRetryEngine(ExponentialBackoff(max, waitMin, waitMax))
+}
+
+func (c *client) retryPolicy(status int) RetryPolicy {
+	if policy, exists := c.retryPolicies[status]; exists {
+		return policy
+	}
+	switch {
+	case status == http.StatusTooManyRequests:
+		return RetryAfterHeader
+	case status == http.StatusNotImplemented:
+		return NoRetry
+	case status >= 500:
+		return RetryWithBackoff
+	}
+	return NoRetry
+}
+
+// shouldRetry classifies the outcome of an attempt with the client's retry policies,
+// wait is the delay requested through Retry-After.
+func (c *client) shouldRetry(ctx context.Context, resp *http.Response, err error) (retry bool, wait time.Duration) {
+	recordAttempt(ctx, resp, err)
+
+	if ctx.Err() != nil {
+		return false, 0
+	}
+	if err != nil {
+		return retryableError(err), 0
+	}
+
+	switch c.retryPolicy(resp.StatusCode) {
+	case NoRetry:
+		return false, 0
+	case RetryAfterHeader:
+		wait, _ = parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+	}
+	return true, wait
+}
+
+var (
+	redirectsError = regexp.MustCompile(`stopped after \d+ redirects\z`)
+	schemeError    = regexp.MustCompile(`unsupported protocol scheme`)
+	headerError    = regexp.MustCompile(`invalid header`)
+)
+
+// retryableError reports whether a network error is worth retrying. Errors which will
+// recur on every attempt (redirect loops, bad URLs and headers, untrusted certificates,
+// oversized bodies read for response hooks) aren't.
+func retryableError(err error) bool {
+	var certErr *tls.CertificateVerificationError
+	var authorityErr x509.UnknownAuthorityError
+	var tooLarge *ResponseTooLargeError
+	if errors.As(err, &certErr) || errors.As(err, &authorityErr) || errors.As(err, &tooLarge) {
+		return false
+	}
+
+	msg := err.Error()
+	if redirectsError.MatchString(msg) || schemeError.MatchString(msg) || headerError.MatchString(msg) {
+		return false
+	}
+	return true
+}
+
+// parseRetryAfter reads a Retry-After header in either delay-seconds or HTTP-date form
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	when, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	return max(when.Sub(now), 0), true
+}
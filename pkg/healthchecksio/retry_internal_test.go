@@ -0,0 +1,74 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	wait, ok := parseRetryAfter("120", now)
+	require.True(t, ok)
+	require.Equal(t, 2*time.Minute, wait)
+
+	wait, ok = parseRetryAfter("Sat, 01 Mar 2025 12:00:30 GMT", now)
+	require.True(t, ok)
+	require.Equal(t, 30*time.Second, wait)
+
+	wait, ok = parseRetryAfter("Sat, 01 Mar 2025 11:00:00 GMT", now)
+	require.True(t, ok)
+	require.Zero(t, wait)
+
+	_, ok = parseRetryAfter("", now)
+	require.False(t, ok)
+	_, ok = parseRetryAfter("-1", now)
+	require.False(t, ok)
+	_, ok = parseRetryAfter("soon", now)
+	require.False(t, ok)
+}
+
+func TestShouldRetry(t *testing.T) {
+	c := &client{}
+	ctx := context.Background()
+
+	resp := &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"7"}},
+	}
+	retry, wait := c.shouldRetry(ctx, resp, nil)
+	require.True(t, retry)
+	require.Equal(t, 7*time.Second, wait)
+
+	// 503 doesn't honor Retry-After unless configured
+	resp.StatusCode = http.StatusServiceUnavailable
+	retry, wait = c.shouldRetry(ctx, resp, nil)
+	require.True(t, retry)
+	require.Zero(t, wait)
+
+	c.retryPolicies = map[int]RetryPolicy{http.StatusServiceUnavailable: RetryAfterHeader}
+	_, wait = c.shouldRetry(ctx, resp, nil)
+	require.Equal(t, 7*time.Second, wait)
+
+	retry, _ = c.shouldRetry(ctx, nil, errors.New("connection refused"))
+	require.True(t, retry)
+	retry, _ = c.shouldRetry(ctx, nil, errors.New(`unsupported protocol scheme "ftp"`))
+	require.False(t, retry)
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	retry, _ = c.shouldRetry(canceled, nil, context.Canceled)
+	require.False(t, retry)
+}
+
+func TestExponentialWait(t *testing.T) {
+	require.Equal(t, time.Second, exponentialWait(time.Second, 4*time.Second, 0))
+	require.Equal(t, 2*time.Second, exponentialWait(time.Second, 4*time.Second, 1))
+	require.Equal(t, 4*time.Second, exponentialWait(time.Second, 4*time.Second, 5))
+	require.Equal(t, 4*time.Second, exponentialWait(time.Second, 4*time.Second, 5000))
+}
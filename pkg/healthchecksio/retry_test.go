@@ -0,0 +1,274 @@
+package healthchecksio_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func statusServer(t *testing.T, status int, header http.Header) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		for k, v := range header {
+			w.Header()[k] = v
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(`{"error":"example"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &attempts
+}
+
+func TestRetryClassification(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		status   int
+		header   http.Header
+		attempts int32
+	}{
+		{status: http.StatusBadRequest, attempts: 1},
+		{status: http.StatusUnauthorized, attempts: 1},
+		{status: http.StatusForbidden, attempts: 1},
+		{status: http.StatusNotFound, attempts: 1},
+		{status: http.StatusNotImplemented, attempts: 1},
+		{status: http.StatusInternalServerError, attempts: 3},
+		{status: http.StatusBadGateway, attempts: 3},
+		{status: http.StatusTooManyRequests, header: http.Header{"Retry-After": []string{"0"}}, attempts: 3},
+	}
+	for _, tc := range cases {
+		t.Run(http.StatusText(tc.status), func(t *testing.T) {
+			srv, attempts := statusServer(t, tc.status, tc.header)
+
+			client := healthchecksio.NewClient("key",
+				healthchecksio.WithBaseURL(srv.URL),
+				healthchecksio.WithRetries(2, time.Millisecond, 5*time.Millisecond),
+			)
+			_, err := client.GetCheck(ctx, "abc")
+			require.Error(t, err)
+			require.Equal(t, tc.attempts, attempts.Load())
+		})
+	}
+}
+
+func TestWithRetryPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	srv, attempts := statusServer(t, http.StatusNotFound, nil)
+	client := healthchecksio.NewClient("key",
+		healthchecksio.WithBaseURL(srv.URL),
+		healthchecksio.WithRetries(2, time.Millisecond, 5*time.Millisecond),
+		healthchecksio.WithRetryPolicy(http.StatusNotFound, healthchecksio.RetryWithBackoff),
+	)
+	_, err := client.GetCheck(ctx, "abc")
+	require.Error(t, err)
+	require.Equal(t, int32(3), attempts.Load())
+
+	srv, attempts = statusServer(t, http.StatusServiceUnavailable, nil)
+	client = healthchecksio.NewClient("key",
+		healthchecksio.WithBaseURL(srv.URL),
+		healthchecksio.WithRetries(2, time.Millisecond, 5*time.Millisecond),
+		healthchecksio.WithRetryPolicy(http.StatusServiceUnavailable, healthchecksio.NoRetry),
+	)
+	_, err = client.GetCheck(ctx, "abc")
+	require.ErrorContains(t, err, "get check failed with 503")
+	require.Equal(t, int32(1), attempts.Load())
+}
+
+func TestNoRetries(t *testing.T) {
+	srv, attempts := statusServer(t, http.StatusServiceUnavailable, nil)
+
+	client := healthchecksio.NewClient("key",
+		healthchecksio.WithBaseURL(srv.URL),
+		healthchecksio.WithRetryEngine(healthchecksio.NoRetries()),
+	)
+	_, err := client.GetCheck(context.Background(), "abc")
+	require.ErrorContains(t, err, "get check failed with 503")
+	require.Equal(t, int32(1), attempts.Load())
+}
+
+func TestRetriesResendBody(t *testing.T) {
+	var bodies []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bs, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(bs))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"uuid":"abc"}`))
+	}))
+	defer srv.Close()
+
+	client := healthchecksio.NewClient("key",
+		healthchecksio.WithBaseURL(srv.URL),
+		healthchecksio.WithRetries(2, time.Millisecond, 5*time.Millisecond),
+	)
+	_, err := client.CreateCheck(context.Background(), &healthchecksio.CreateCheck{Name: "nightly"})
+	require.NoError(t, err)
+	require.Len(t, bodies, 2)
+	require.Equal(t, bodies[0], bodies[1])
+	require.Contains(t, bodies[1], `"name":"nightly"`)
+}
+
+func TestCancelDuringRetryWait(t *testing.T) {
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	client := healthchecksio.NewClient("key",
+		healthchecksio.WithBaseURL(srv.URL),
+		healthchecksio.WithRetries(5, time.Minute, time.Minute),
+	)
+	calls := map[string]func(ctx context.Context) error{
+		"GetChecks": func(ctx context.Context) error {
+			_, err := client.GetChecks(ctx, healthchecksio.GetChecks{})
+			return err
+		},
+		"GetCheck": func(ctx context.Context) error {
+			_, err := client.GetCheck(ctx, "abc")
+			return err
+		},
+		"GetPings": func(ctx context.Context) error {
+			_, err := client.GetPings(ctx, "abc")
+			return err
+		},
+		"GetPingBody": func(ctx context.Context) error {
+			_, err := client.GetPingBody(ctx, "abc", 1)
+			return err
+		},
+		"GetFlips": func(ctx context.Context) error {
+			_, err := client.GetFlips(ctx, "abc", healthchecksio.GetFlipsRequest{})
+			return err
+		},
+		"GetChannels": func(ctx context.Context) error {
+			_, err := client.GetChannels(ctx)
+			return err
+		},
+		"CreateCheck": func(ctx context.Context) error {
+			_, err := client.CreateCheck(ctx, &healthchecksio.CreateCheck{Name: "backup"})
+			return err
+		},
+		"UpdateCheck": func(ctx context.Context) error {
+			_, err := client.UpdateCheck(ctx, "abc", &healthchecksio.UpdateCheck{Grace: 60})
+			return err
+		},
+		"DeleteCheck": func(ctx context.Context) error {
+			_, err := client.DeleteCheck(ctx, "abc")
+			return err
+		},
+		"PauseCheck": func(ctx context.Context) error {
+			_, err := client.PauseCheck(ctx, "abc")
+			return err
+		},
+		"ResumeCheck": func(ctx context.Context) error {
+			_, err := client.ResumeCheck(ctx, "abc")
+			return err
+		},
+		"Do": func(ctx context.Context) error {
+			return client.Do(ctx, "GET", "/checks/", nil, nil)
+		},
+		"Ping": func(ctx context.Context) error {
+			_, err := client.Ping(ctx, srv.URL+"/abc", "")
+			return err
+		},
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			attempts.Store(0)
+			ctx, cancel := context.WithCancel(context.Background())
+			time.AfterFunc(50*time.Millisecond, cancel)
+
+			started := time.Now()
+			err := call(ctx)
+			require.Less(t, time.Since(started), 5*time.Second)
+			require.ErrorIs(t, err, context.Canceled)
+
+			n, ok := healthchecksio.Attempts(err)
+			require.True(t, ok)
+			require.Equal(t, 1, n)
+			require.Equal(t, int32(1), attempts.Load())
+		})
+	}
+}
+
+// eagerEngine retries immediately and without checking ctx
+type eagerEngine struct{}
+
+func (eagerEngine) Do(ctx context.Context, attempt func(ctx context.Context) healthchecksio.AttemptResult) (*http.Response, error) {
+	var result healthchecksio.AttemptResult
+	for range 5 {
+		result = attempt(ctx)
+		if !result.Retry {
+			break
+		}
+		result.Discard()
+	}
+	return result.Response, result.Err
+}
+
+func TestNoAttemptsAfterCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		cancel()
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	client := healthchecksio.NewClient("key",
+		healthchecksio.WithBaseURL(srv.URL),
+		healthchecksio.WithRetryEngine(eagerEngine{}),
+	)
+	_, err := client.GetCheck(ctx, "abc")
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, int32(1), attempts.Load())
+}
+
+func TestAttemptsInErrors(t *testing.T) {
+	srv, _ := statusServer(t, http.StatusServiceUnavailable, nil)
+
+	client := healthchecksio.NewClient("key",
+		healthchecksio.WithBaseURL(srv.URL),
+		healthchecksio.WithRetries(2, time.Millisecond, 5*time.Millisecond),
+	)
+	_, err := client.GetCheck(context.Background(), "abc")
+	require.ErrorContains(t, err, `get check failed with 503: example (after 3 attempts, request `)
+
+	n, ok := healthchecksio.Attempts(err)
+	require.True(t, ok)
+	require.Equal(t, 3, n)
+
+	_, err = client.Ping(context.Background(), srv.URL+"/abc", "")
+	require.ErrorContains(t, err, "ping failed with 503")
+	n, _ = healthchecksio.Attempts(err)
+	require.Equal(t, 3, n)
+
+	// Nothing is sent with a context which is already done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = client.GetCheck(ctx, "abc")
+	n, ok = healthchecksio.Attempts(err)
+	require.True(t, ok)
+	require.Zero(t, n)
+}
@@ -0,0 +1,72 @@
+package healthchecksio
+
+import (
+	"time"
+)
+
+// LastPingTime returns when the check last received a ping, false if it never has
+func (c Check) LastPingTime() (time.Time, bool) {
+	value, ok := c.LastPing.(string)
+	if !ok || value == "" {
+		return time.Time{}, false
+	}
+	when, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return when, true
+}
+
+// ExpectedNextPing computes when check should next ping from its last ping and
+// its timeout or cron schedule (in the check's timezone). A started check is expected
+// to finish immediately, its grace period is the time allowed for the run.
+//
+// ok is false when no prediction is possible: the check is new or paused, has never
+// pinged, or its schedule can't be parsed.
+func ExpectedNextPing(check Check) (time.Time, bool) {
+	switch CheckStatus(check.Status) {
+	case StatusNew, StatusPaused:
+		return time.Time{}, false
+	}
+
+	last, ok := check.LastPingTime()
+	if !ok {
+		return time.Time{}, false
+	}
+	if check.Started {
+		return last, true
+	}
+
+	if check.Schedule != "" {
+		loc := time.UTC
+		if check.Timezone != "" {
+			l, err := time.LoadLocation(check.Timezone)
+			if err != nil {
+				return time.Time{}, false
+			}
+			loc = l
+		}
+		sched, err := parseCron(check.Schedule)
+		if err != nil {
+			return time.Time{}, false
+		}
+		next := sched.next(last.In(loc))
+		return next, !next.IsZero()
+	}
+
+	if check.Timeout <= 0 {
+		return time.Time{}, false
+	}
+	return last.Add(time.Duration(check.Timeout) * time.Second), true
+}
+
+// IsRunningLate reports whether check has missed its expected ping at now, and whether
+// it's still inside the grace period (late, but not yet marked down by the server).
+func IsRunningLate(check Check, now time.Time) (late, inGrace bool) {
+	expected, ok := ExpectedNextPing(check)
+	if !ok || !now.After(expected) {
+		return false, false
+	}
+	deadline := expected.Add(time.Duration(check.Grace) * time.Second)
+	return true, !now.After(deadline)
+}
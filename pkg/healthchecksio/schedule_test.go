@@ -0,0 +1,76 @@
+package healthchecksio_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpectedNextPing(t *testing.T) {
+	t.Run("timeout", func(t *testing.T) {
+		check := healthchecksio.Check{
+			Status:   "up",
+			LastPing: "2025-03-14T10:00:00+00:00",
+			Timeout:  3600,
+			Grace:    600,
+		}
+		next, ok := healthchecksio.ExpectedNextPing(check)
+		require.True(t, ok)
+		require.True(t, next.Equal(time.Date(2025, time.March, 14, 11, 0, 0, 0, time.UTC)))
+
+		late, inGrace := healthchecksio.IsRunningLate(check, time.Date(2025, time.March, 14, 10, 59, 0, 0, time.UTC))
+		require.False(t, late)
+		require.False(t, inGrace)
+
+		late, inGrace = healthchecksio.IsRunningLate(check, time.Date(2025, time.March, 14, 11, 5, 0, 0, time.UTC))
+		require.True(t, late)
+		require.True(t, inGrace)
+
+		late, inGrace = healthchecksio.IsRunningLate(check, time.Date(2025, time.March, 14, 11, 11, 0, 0, time.UTC))
+		require.True(t, late)
+		require.False(t, inGrace)
+	})
+
+	t.Run("schedule with timezone", func(t *testing.T) {
+		check := healthchecksio.Check{
+			Status:   "up",
+			LastPing: "2025-03-14T08:05:00+00:00", // 03:05 in Chicago
+			Schedule: "0 3 * * *",
+			Timezone: "America/Chicago",
+			Grace:    3600,
+		}
+		next, ok := healthchecksio.ExpectedNextPing(check)
+		require.True(t, ok)
+		require.True(t, next.Equal(time.Date(2025, time.March, 15, 8, 0, 0, 0, time.UTC)), next.UTC().String())
+	})
+
+	t.Run("started", func(t *testing.T) {
+		check := healthchecksio.Check{
+			Status:   "started",
+			Started:  true,
+			LastPing: "2025-03-14T10:00:00+00:00",
+			Timeout:  86400,
+			Grace:    300,
+		}
+		late, inGrace := healthchecksio.IsRunningLate(check, time.Date(2025, time.March, 14, 10, 4, 0, 0, time.UTC))
+		require.True(t, late)
+		require.True(t, inGrace)
+	})
+
+	t.Run("unpredictable", func(t *testing.T) {
+		checks := []healthchecksio.Check{
+			{Status: "new", Timeout: 60},
+			{Status: "paused", LastPing: "2025-03-14T10:00:00+00:00", Timeout: 60},
+			{Status: "up", LastPing: nil, Timeout: 60},
+			{Status: "up", LastPing: "2025-03-14T10:00:00+00:00", Schedule: "bogus"},
+			{Status: "up", LastPing: "2025-03-14T10:00:00+00:00", Schedule: "* * * * *", Timezone: "Nowhere/Special"},
+		}
+		for _, check := range checks {
+			_, ok := healthchecksio.ExpectedNextPing(check)
+			require.False(t, ok)
+		}
+	})
+}
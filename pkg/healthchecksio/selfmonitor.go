@@ -0,0 +1,64 @@
+package healthchecksio
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// WithSelfMonitor makes the client ping pingURL every interval while it can reach the
+// Healthchecks API, so a check alerts when this integration silently breaks (expired key,
+// egress changes). If no API call succeeded during the interval the client probes the API
+// itself, sending a failure ping with the error when that probe fails.
+//
+// The monitor runs in the background until Close is called.
+func WithSelfMonitor(pingURL string, interval time.Duration) ClientOption {
+	return func(c *client) {
+		c.selfMonitorURL = pingURL
+		c.selfMonitorInterval = interval
+	}
+}
+
+func (c *client) startSelfMonitor() {
+	if c.selfMonitorURL == "" || c.selfMonitorInterval <= 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	c.onClose(func() error {
+		cancel()
+		<-done
+		return nil
+	})
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(c.selfMonitorInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				c.selfMonitorTick(ctx)
+			}
+		}
+	}()
+}
+
+func (c *client) selfMonitorTick(ctx context.Context) {
+	since := time.Now().Add(-c.selfMonitorInterval)
+	if time.Unix(0, c.lastAPISuccess.Load()).Before(since) {
+		// Nothing succeeded recently, the host app may just be idle so check ourselves
+		if err := c.Do(ctx, "GET", "/checks/?slug=healthchecksio-self-monitor", nil, nil); err != nil {
+			if ctx.Err() == nil {
+				c.Ping(ctx, c.selfMonitorURL, fmt.Sprintf("healthchecks API unreachable: %v", err), WithFail())
+			}
+			return
+		}
+	}
+	c.Ping(ctx, c.selfMonitorURL, "")
+}
@@ -0,0 +1,67 @@
+package healthchecksio
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelfMonitor(t *testing.T) {
+	var apiDown atomic.Bool
+
+	var mu sync.Mutex
+	var pings []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/checks/":
+			if apiDown.Load() {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(`{"error":"wrong api key"}`))
+				return
+			}
+			w.Write([]byte(`{"checks":[]}`))
+
+		case "/ping/self", "/ping/self/fail":
+			bs, _ := io.ReadAll(r.Body)
+			mu.Lock()
+			pings = append(pings, r.URL.Path+" "+string(bs))
+			mu.Unlock()
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient("key",
+		WithBaseURL(srv.URL+"/api"),
+		WithSelfMonitor(srv.URL+"/ping/self", 10*time.Millisecond),
+	)
+
+	received := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), pings...)
+	}
+
+	require.Eventually(t, func() bool {
+		return len(received()) >= 2
+	}, time.Second, 5*time.Millisecond)
+	require.Equal(t, "/ping/self ", received()[0])
+
+	apiDown.Store(true)
+	require.Eventually(t, func() bool {
+		got := received()
+		return strings.HasPrefix(got[len(got)-1], "/ping/self/fail healthchecks API unreachable: GET /checks/?slug=healthchecksio-self-monitor failed with 401: wrong api key (request ")
+	}, time.Second, 5*time.Millisecond)
+
+	// Close stops the monitor
+	require.NoError(t, c.Close())
+	count := len(received())
+	time.Sleep(50 * time.Millisecond)
+	require.Len(t, received(), count)
+}
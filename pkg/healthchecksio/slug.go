@@ -0,0 +1,64 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrNotFound is returned by lookups which matched no check
+var ErrNotFound = errors.New("healthchecksio: check not found")
+
+// GetChecksBySlugExact returns the check with slug, or ErrNotFound when there's none.
+// Slugs are unique within a project so more than one match is reported as an error.
+func GetChecksBySlugExact(ctx context.Context, client CheckReader, slug string, opts ...CallOption) (*Check, error) {
+	if slug == "" {
+		return nil, errors.New("get check by slug: empty slug")
+	}
+
+	list, err := client.GetChecks(ctx, GetChecks{Slug: slug}, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("get check by slug: %w", err)
+	}
+
+	var found []Check
+	for _, check := range list.Checks {
+		if check.Slug == slug {
+			found = append(found, check)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("get check by slug %q: %w", slug, ErrNotFound)
+	case 1:
+		return &found[0], nil
+	}
+	return nil, fmt.Errorf("get check by slug %q: found %d checks", slug, len(found))
+}
+
+// ConflictError is returned when a check would be created with the slug or name of an existing check
+type ConflictError struct {
+	// Field is "slug" or "name"
+	Field string
+	Value string
+
+	Existing Check
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("%s %q is already used by check %s", e.Field, e.Value, e.Existing.UUID)
+}
+
+// CheckSlugAvailable reports whether no check in the project has slug
+func CheckSlugAvailable(ctx context.Context, client CheckReader, slug string, opts ...CallOption) (bool, error) {
+	list, err := client.GetChecks(ctx, GetChecks{Slug: slug}, opts...)
+	if err != nil {
+		return false, fmt.Errorf("check slug available: %w", err)
+	}
+	for _, check := range list.Checks {
+		if check.Slug == slug {
+			return false, nil
+		}
+	}
+	return true, nil
+}
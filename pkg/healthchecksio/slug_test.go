@@ -0,0 +1,48 @@
+package healthchecksio
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetChecksBySlugExact(t *testing.T) {
+	ctx := context.Background()
+	mock := &memoryClient{
+		checks: []Check{
+			{UUID: "1", Slug: "nightly"},
+			{UUID: "2", Slug: "hourly"},
+			{UUID: "3", Slug: "hourly"},
+		},
+	}
+
+	check, err := GetChecksBySlugExact(ctx, mock, "nightly")
+	require.NoError(t, err)
+	require.Equal(t, "1", check.UUID)
+
+	_, err = GetChecksBySlugExact(ctx, mock, "weekly")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	_, err = GetChecksBySlugExact(ctx, mock, "hourly")
+	require.EqualError(t, err, `get check by slug "hourly": found 2 checks`)
+	require.NotErrorIs(t, err, ErrNotFound)
+
+	_, err = GetChecksBySlugExact(ctx, mock, "")
+	require.Error(t, err)
+}
+
+func TestCheckSlugAvailable(t *testing.T) {
+	ctx := context.Background()
+	mock := &memoryClient{
+		checks: []Check{{UUID: "1", Slug: "nightly"}},
+	}
+
+	available, err := CheckSlugAvailable(ctx, mock, "nightly")
+	require.NoError(t, err)
+	require.False(t, available)
+
+	available, err = CheckSlugAvailable(ctx, mock, "weekly")
+	require.NoError(t, err)
+	require.True(t, available)
+}
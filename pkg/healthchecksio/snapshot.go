@@ -0,0 +1,351 @@
+package healthchecksio
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Snapshot is the full list of checks, configuration and status, at a point in time
+type Snapshot struct {
+	Taken  time.Time `json:"taken"`
+	Checks []Check   `json:"checks"`
+}
+
+// SnapshotStore persists encoded snapshots by name. Names sort in the order snapshots were
+// taken, so object stores such as S3 only need to map them onto keys under a prefix.
+type SnapshotStore interface {
+	// Put stores the snapshot read from r under name
+	Put(ctx context.Context, name string, r io.Reader) error
+
+	// Get opens the snapshot stored under name
+	Get(ctx context.Context, name string) (io.ReadCloser, error)
+
+	// List returns the names of every stored snapshot
+	List(ctx context.Context) ([]string, error)
+}
+
+// DirSnapshotStore is a SnapshotStore keeping each snapshot as a JSON file in a directory
+type DirSnapshotStore struct {
+	dir string
+}
+
+// NewDirSnapshotStore creates a DirSnapshotStore in dir, which is created on the first Put
+func NewDirSnapshotStore(dir string) *DirSnapshotStore {
+	return &DirSnapshotStore{dir: dir}
+}
+
+// Put writes the snapshot to a temp file renamed into place, so readers never see a partial snapshot
+func (s *DirSnapshotStore) Put(ctx context.Context, name string, r io.Reader) error {
+	return replaceFile(s.dir, name, r)
+}
+
+// replaceFile writes r to a temp file in dir renamed to name, creating dir when it's missing
+func replaceFile(dir, name string, r io.Reader) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(dir, "."+name+"-*")
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, r)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), filepath.Join(dir, name))
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+	return err
+}
+
+// Get opens the snapshot file called name
+func (s *DirSnapshotStore) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	return os.Open(filepath.Join(s.dir, name))
+}
+
+// List returns the snapshot files in the directory, which is empty until the first Put
+func (s *DirSnapshotStore) List(ctx context.Context) ([]string, error) {
+	entries, err := os.ReadDir(s.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() && !strings.HasPrefix(entry.Name(), ".") && strings.HasSuffix(entry.Name(), ".json") {
+			names = append(names, entry.Name())
+		}
+	}
+	return names, nil
+}
+
+// snapshotName is the store name of a snapshot taken at, which sorts chronologically
+func snapshotName(at time.Time) string {
+	return at.UTC().Format("20060102T150405.000000000Z") + ".json"
+}
+
+// ListSnapshots returns the names of the snapshots in store, oldest first
+func ListSnapshots(ctx context.Context, store SnapshotStore) ([]string, error) {
+	names, err := store.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list snapshots: %w", err)
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+// LoadSnapshot reads and decodes the snapshot stored under name
+func LoadSnapshot(ctx context.Context, store SnapshotStore, name string) (*Snapshot, error) {
+	rc, err := store.Get(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("load snapshot %s: %w", name, err)
+	}
+	defer rc.Close()
+
+	var snapshot Snapshot
+	if err := json.NewDecoder(rc).Decode(&snapshot); err != nil {
+		return nil, fmt.Errorf("load snapshot %s: %w", name, err)
+	}
+	return &snapshot, nil
+}
+
+// SnapshotChangeKind describes how a check differs between two snapshots
+type SnapshotChangeKind string
+
+const (
+	SnapshotAdded   SnapshotChangeKind = "added"
+	SnapshotRemoved SnapshotChangeKind = "removed"
+	SnapshotChanged SnapshotChangeKind = "changed"
+)
+
+// SnapshotChange is one check which differs between two snapshots
+type SnapshotChange struct {
+	Kind SnapshotChangeKind `json:"kind"`
+	UUID string             `json:"uuid"`
+	Name string             `json:"name"`
+
+	// Fields are the JSON names of the changed fields, empty for added and removed checks
+	Fields []string `json:"fields,omitempty"`
+
+	// Before and After are the check in the older and newer snapshot, nil when it's missing
+	Before *Check `json:"before,omitempty"`
+	After  *Check `json:"after,omitempty"`
+}
+
+// DiffSnapshots returns the checks added, removed or changed between older and newer,
+// ordered as they appear in newer followed by removed checks. Fields which change with
+// every ping, such as n_pings and last_ping, aren't treated as changes.
+func DiffSnapshots(older, newer *Snapshot) []SnapshotChange {
+	before := make(map[string]*Check, len(older.Checks))
+	for i := range older.Checks {
+		before[older.Checks[i].UUID] = &older.Checks[i]
+	}
+
+	var changes []SnapshotChange
+	seen := make(map[string]bool, len(newer.Checks))
+	for i := range newer.Checks {
+		after := &newer.Checks[i]
+		seen[after.UUID] = true
+
+		prev, exists := before[after.UUID]
+		if !exists {
+			changes = append(changes, SnapshotChange{Kind: SnapshotAdded, UUID: after.UUID, Name: after.Name, After: after})
+			continue
+		}
+		if fields := diffSnapshotCheck(prev, after); len(fields) > 0 {
+			changes = append(changes, SnapshotChange{
+				Kind:   SnapshotChanged,
+				UUID:   after.UUID,
+				Name:   after.Name,
+				Fields: fields,
+				Before: prev,
+				After:  after,
+			})
+		}
+	}
+	for i := range older.Checks {
+		prev := &older.Checks[i]
+		if !seen[prev.UUID] {
+			changes = append(changes, SnapshotChange{Kind: SnapshotRemoved, UUID: prev.UUID, Name: prev.Name, Before: prev})
+		}
+	}
+	return changes
+}
+
+// diffSnapshotCheck compares two versions of a check by their encoded fields, which unlike
+// auditChanges includes fields that aren't modeled on Check
+func diffSnapshotCheck(before, after *Check) []string {
+	fieldsOf := func(check *Check) map[string]json.RawMessage {
+		out := make(map[string]json.RawMessage)
+		bs, _ := json.Marshal(check)
+		json.Unmarshal(bs, &out)
+		for _, name := range volatileCheckFields {
+			delete(out, name)
+		}
+		return out
+	}
+	b, a := fieldsOf(before), fieldsOf(after)
+
+	var changed []string
+	for name := range b {
+		if other, exists := a[name]; !exists || !bytes.Equal(compactJSON(b[name]), compactJSON(other)) {
+			changed = append(changed, name)
+		}
+	}
+	for name := range a {
+		if _, exists := b[name]; !exists {
+			changed = append(changed, name)
+		}
+	}
+	slices.Sort(changed)
+	return changed
+}
+
+func compactJSON(raw json.RawMessage) []byte {
+	var buf bytes.Buffer
+	if json.Compact(&buf, raw) != nil {
+		return raw
+	}
+	return buf.Bytes()
+}
+
+// SnapshotterOptions configures a Snapshotter
+type SnapshotterOptions struct {
+	// Interval between snapshots, defaults to one hour
+	Interval time.Duration
+
+	// Filter limits which checks are recorded
+	Filter GetChecks
+
+	// SkipUnchanged doesn't store a snapshot when nothing differs from the previous one
+	SkipUnchanged bool
+}
+
+// Snapshotter periodically records the check list to a SnapshotStore, building the change
+// history the Healthchecks API doesn't keep
+type Snapshotter struct {
+	client CheckReader
+	store  SnapshotStore
+	opts   SnapshotterOptions
+	now    func() time.Time
+
+	mu      sync.Mutex
+	last    *Snapshot
+	loaded  bool
+	onError []func(error)
+}
+
+// NewSnapshotter creates a Snapshotter recording checks matching opts.Filter into store
+func NewSnapshotter(client CheckReader, store SnapshotStore, opts SnapshotterOptions) *Snapshotter {
+	if opts.Interval <= 0 {
+		opts.Interval = time.Hour
+	}
+	return &Snapshotter{
+		client: client,
+		store:  store,
+		opts:   opts,
+		now:    time.Now,
+	}
+}
+
+// OnError registers fn to be called when taking a snapshot fails
+func (s *Snapshotter) OnError(fn func(error)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.onError = append(s.onError, fn)
+}
+
+// Run takes snapshots until ctx is cancelled
+func (s *Snapshotter) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.Take(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			s.mu.Lock()
+			handlers := s.onError
+			s.mu.Unlock()
+
+			for _, fn := range handlers {
+				fn(err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Take records a snapshot and returns how it differs from the previous one. The previous
+// snapshot is read back from the store on the first call, so history continues across restarts.
+func (s *Snapshotter) Take(ctx context.Context) ([]SnapshotChange, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.loaded {
+		last, err := s.latest(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("take snapshot: %w", err)
+		}
+		s.last, s.loaded = last, true
+	}
+
+	list, err := s.client.GetChecks(ctx, s.opts.Filter)
+	if err != nil {
+		return nil, fmt.Errorf("take snapshot: %w", err)
+	}
+	snapshot := &Snapshot{Taken: s.now(), Checks: list.Checks}
+
+	previous := s.last
+	if previous == nil {
+		previous = &Snapshot{}
+	}
+	changes := DiffSnapshots(previous, snapshot)
+	if s.opts.SkipUnchanged && s.last != nil && len(changes) == 0 {
+		return nil, nil
+	}
+
+	bs, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("take snapshot: %w", err)
+	}
+	if err := s.store.Put(ctx, snapshotName(snapshot.Taken), bytes.NewReader(bs)); err != nil {
+		return nil, fmt.Errorf("take snapshot: %w", err)
+	}
+	s.last = snapshot
+	return changes, nil
+}
+
+// latest loads the newest snapshot in the store, or nil when it's empty
+func (s *Snapshotter) latest(ctx context.Context) (*Snapshot, error) {
+	names, err := ListSnapshots(ctx, s.store)
+	if err != nil || len(names) == 0 {
+		return nil, err
+	}
+	return LoadSnapshot(ctx, s.store, names[len(names)-1])
+}
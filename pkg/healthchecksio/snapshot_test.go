@@ -0,0 +1,92 @@
+package healthchecksio
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotter(t *testing.T) {
+	ctx := context.Background()
+	client := &memoryClient{
+		checks: []Check{
+			{UUID: "1", Name: "backup", Slug: "backup", Status: "up", Timeout: 3600, NPings: 10},
+			{UUID: "2", Name: "report", Slug: "report", Status: "up", Timeout: 86400},
+		},
+	}
+	store := NewDirSnapshotStore(t.TempDir())
+
+	now := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+	s := NewSnapshotter(client, store, SnapshotterOptions{SkipUnchanged: true})
+	s.now = func() time.Time { return now }
+
+	changes, err := s.Take(ctx)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	require.Equal(t, SnapshotAdded, changes[0].Kind)
+
+	// Pings alone aren't a change worth storing
+	client.checks[0].NPings = 11
+	now = now.Add(time.Hour)
+	changes, err = s.Take(ctx)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	names, err := ListSnapshots(ctx, store)
+	require.NoError(t, err)
+	require.Len(t, names, 1)
+
+	// A new Snapshotter picks up where the stored history left off
+	client.checks[0].Status = "down"
+	client.checks[0].Timeout = 7200
+	client.checks = client.checks[:1]
+	client.checks = append(client.checks, Check{UUID: "3", Name: "cleanup", Slug: "cleanup", Status: "new"})
+
+	s = NewSnapshotter(client, store, SnapshotterOptions{})
+	s.now = func() time.Time { return now }
+	changes, err = s.Take(ctx)
+	require.NoError(t, err)
+	require.Len(t, changes, 3)
+
+	require.Equal(t, SnapshotChanged, changes[0].Kind)
+	require.Equal(t, "1", changes[0].UUID)
+	require.Equal(t, []string{"status", "timeout"}, changes[0].Fields)
+	require.Equal(t, 3600, changes[0].Before.Timeout)
+	require.Equal(t, 7200, changes[0].After.Timeout)
+
+	require.Equal(t, SnapshotAdded, changes[1].Kind)
+	require.Equal(t, "cleanup", changes[1].Name)
+	require.Equal(t, SnapshotRemoved, changes[2].Kind)
+	require.Equal(t, "report", changes[2].Name)
+
+	names, err = ListSnapshots(ctx, store)
+	require.NoError(t, err)
+	require.Equal(t, []string{"20250101T120000.000000000Z.json", "20250101T130000.000000000Z.json"}, names)
+
+	first, err := LoadSnapshot(ctx, store, names[0])
+	require.NoError(t, err)
+	last, err := LoadSnapshot(ctx, store, names[1])
+	require.NoError(t, err)
+	require.Len(t, DiffSnapshots(first, last), 3)
+	require.Empty(t, DiffSnapshots(last, last))
+}
+
+func TestDiffSnapshotsUnknownFields(t *testing.T) {
+	var older, newer Snapshot
+	require.NoError(t, json.Unmarshal([]byte(`{"taken":"2025-01-01T00:00:00Z","checks":[{"uuid":"1","name":"a","status":"up","n_pings":1}]}`), &older))
+	require.NoError(t, json.Unmarshal([]byte(`{"taken":"2025-01-02T00:00:00Z","checks":[{"uuid":"1","name":"a","status":"up","n_pings":5,"start_grace":60}]}`), &newer))
+
+	changes := DiffSnapshots(&older, &newer)
+	require.Len(t, changes, 1)
+	require.Equal(t, []string{"start_grace"}, changes[0].Fields)
+}
+
+func TestDirSnapshotStoreEmpty(t *testing.T) {
+	store := NewDirSnapshotStore(t.TempDir() + "/missing")
+	names, err := ListSnapshots(context.Background(), store)
+	require.NoError(t, err)
+	require.Empty(t, names)
+}
@@ -0,0 +1,175 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"io"
+	"maps"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Stats is a snapshot of a client's counters since it was created
+type Stats struct {
+	// Requests counts API calls by endpoint (e.g. "get-checks"), not including retries
+	Requests map[string]uint64
+
+	// Retries counts additional attempts made after a failed attempt
+	Retries uint64
+
+	// Failures counts API calls which returned an error or an error status
+	Failures uint64
+
+	// CacheHits and CacheMisses count calls served by and missing WithCache
+	CacheHits   uint64
+	CacheMisses uint64
+
+	PingsSent   uint64
+	PingsFailed uint64
+}
+
+type clientStats struct {
+	mu    sync.Mutex
+	stats Stats
+}
+
+// Stats returns a snapshot of the client's counters
+func (c *client) Stats() Stats {
+	c.stats.mu.Lock()
+	defer c.stats.mu.Unlock()
+
+	out := c.stats.stats
+	out.Requests = maps.Clone(c.stats.stats.Requests)
+	if out.Requests == nil {
+		out.Requests = make(map[string]uint64)
+	}
+	return out
+}
+
+func (s *clientStats) update(fn func(*Stats)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fn(&s.stats)
+}
+
+// countRetries counts attempt as a retry, attempt is zero for the first try
+func (s *clientStats) countRetries(attempt int) {
+	if attempt > 0 {
+		s.update(func(st *Stats) { st.Retries++ })
+	}
+}
+
+// send performs req and records it in the client's stats under endpoint
+func (c *client) send(req *http.Request, endpoint string) (resp *http.Response, err error) {
+	if c.closed.Load() {
+		return nil, ErrClientClosed
+	}
+	if err := c.checkAccess(req, endpoint); err != nil {
+		return nil, err
+	}
+	if c.cache != nil {
+		if cachedEndpoints[endpoint] {
+			return c.sendCached(req, endpoint)
+		}
+		if !c.dryRun && (mutatingEndpoints[endpoint] || (endpoint == "do" && req.Method != http.MethodGet)) {
+			defer func() {
+				if err == nil && resp.StatusCode < 300 {
+					c.invalidateCache(req)
+				}
+			}()
+		}
+	}
+	if mutatingEndpoints[endpoint] {
+		if c.dryRun {
+			return c.dryRunResponse(req, endpoint)
+		}
+		if c.audit != nil {
+			return c.sendAudited(req, endpoint)
+		}
+	}
+	return c.roundTrip(req, endpoint)
+}
+
+// roundTrip performs req with the client's RetryEngine, counting it in the client's stats
+func (c *client) roundTrip(req *http.Request, endpoint string) (*http.Response, error) {
+	req, done := trackAttempts(req)
+	resp, err := c.retry.Do(req.Context(), c.attempt(req))
+	done()
+	if err == nil {
+		limit := c.maxResponseBytes
+		if endpoint == "ping" {
+			limit = c.maxPingResponseBytes
+		}
+		// net/http already enforces a Content-Length within the limit
+		if resp.ContentLength < 0 || resp.ContentLength > limit {
+			resp.Body = limitBody(resp.Body, limit)
+		}
+	}
+	failed := err != nil || resp.StatusCode >= 400
+	if !failed && endpoint != "ping" {
+		c.lastAPISuccess.Store(time.Now().UnixNano())
+	}
+
+	c.stats.update(func(st *Stats) {
+		if endpoint == "ping" {
+			st.PingsSent++
+			if failed {
+				st.PingsFailed++
+			}
+			return
+		}
+
+		if st.Requests == nil {
+			st.Requests = make(map[string]uint64)
+		}
+		st.Requests[endpoint]++
+		if failed {
+			st.Failures++
+		}
+	})
+	return resp, err
+}
+
+// attempt returns the func a RetryEngine calls to send req once, each call after
+// the first sends a fresh copy of the request body.
+func (c *client) attempt(req *http.Request) func(ctx context.Context) AttemptResult {
+	n := 0
+	return func(ctx context.Context) AttemptResult {
+		// Never start another attempt once the caller gave up, whatever the engine does
+		if err := ctx.Err(); err != nil {
+			return AttemptResult{Err: err}
+		}
+		attempt := req.WithContext(ctx)
+		if n > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return AttemptResult{Err: err}
+			}
+			attempt.Body = body
+		}
+		c.beforeAttempt(attempt, n)
+		n++
+
+		resp, err := c.httpClient.Do(attempt)
+		retry, wait := c.shouldRetry(ctx, resp, err)
+		return AttemptResult{Response: resp, Err: err, Retry: retry, Wait: wait}
+	}
+}
+
+// requestBody returns a copy of req's body without consuming it
+func requestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody == nil {
+		return nil, errors.New("request body can't be read twice")
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	return io.ReadAll(body)
+}
@@ -0,0 +1,68 @@
+package healthchecksio_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Stats(t *testing.T) {
+	ctx := context.Background()
+
+	var flaky int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/checks/":
+			w.Write([]byte(`{"checks":[]}`))
+		case "/checks/flaky":
+			if flaky++; flaky < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Write([]byte(`{"uuid":"flaky"}`))
+		case "/ping/ok":
+			w.Write([]byte("OK"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":"not found"}`))
+		}
+	}))
+	defer srv.Close()
+
+	client := healthchecksio.NewClient("key",
+		healthchecksio.WithBaseURL(srv.URL),
+		healthchecksio.WithRetries(3, time.Millisecond, time.Millisecond),
+	)
+	require.Empty(t, client.Stats().Requests)
+
+	_, err := client.GetChecks(ctx, healthchecksio.GetChecks{})
+	require.NoError(t, err)
+	_, err = client.GetChecks(ctx, healthchecksio.GetChecks{})
+	require.NoError(t, err)
+	_, err = client.GetCheck(ctx, "flaky")
+	require.NoError(t, err)
+	_, err = client.GetCheck(ctx, "missing")
+	require.Error(t, err)
+
+	_, err = client.Ping(ctx, srv.URL+"/ping/ok", "")
+	require.NoError(t, err)
+	_, err = client.Ping(ctx, srv.URL+"/ping/missing", "")
+	require.Error(t, err)
+
+	stats := client.Stats()
+	require.Equal(t, map[string]uint64{"get-checks": 2, "get-check": 2}, stats.Requests)
+	require.Equal(t, uint64(2), stats.Retries)
+	require.Equal(t, uint64(1), stats.Failures)
+	require.Equal(t, uint64(2), stats.PingsSent)
+	require.Equal(t, uint64(1), stats.PingsFailed)
+
+	// Snapshots are copies
+	stats.Requests["get-checks"] = 100
+	require.Equal(t, uint64(2), client.Stats().Requests["get-checks"])
+}
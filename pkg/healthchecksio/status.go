@@ -0,0 +1,13 @@
+package healthchecksio
+
+// CheckStatus is the status of a check as reported by the API
+type CheckStatus string
+
+const (
+	StatusNew     CheckStatus = "new"
+	StatusUp      CheckStatus = "up"
+	StatusGrace   CheckStatus = "grace"
+	StatusDown    CheckStatus = "down"
+	StatusPaused  CheckStatus = "paused"
+	StatusStarted CheckStatus = "started"
+)
@@ -0,0 +1,386 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Manifest declares the checks a project should contain, keyed by slug
+type Manifest struct {
+	Checks []CreateCheck `json:"checks"`
+
+	// Descriptions renders every check's description from a template, see SyncOptions.Descriptions
+	Descriptions *ManifestDescriptions `json:"descriptions,omitempty"`
+}
+
+// SyncAction is the operation a SyncChange performs
+type SyncAction string
+
+const (
+	SyncCreate    SyncAction = "create"
+	SyncUpdate    SyncAction = "update"
+	SyncDelete    SyncAction = "delete"
+	SyncUnchanged SyncAction = "unchanged"
+)
+
+// SyncChange is one step of a SyncPlan
+type SyncChange struct {
+	Action SyncAction
+	Slug   string
+
+	// Desired is the manifest entry, nil for deletes
+	Desired *CreateCheck
+
+	// Existing is the current check, nil for creates
+	Existing *Check
+
+	// Fields lists the JSON names of fields which differ for updates
+	Fields []string
+}
+
+// SyncPlan is the set of changes needed to make a project match a manifest
+type SyncPlan struct {
+	Changes []SyncChange
+
+	// ConfirmDeletes is copied from SyncOptions and makes ApplySync confirm its deletes
+	ConfirmDeletes bool
+}
+
+// Pending returns the changes which modify the project
+func (p *SyncPlan) Pending() []SyncChange {
+	var out []SyncChange
+	for _, ch := range p.Changes {
+		if ch.Action != SyncUnchanged {
+			out = append(out, ch)
+		}
+	}
+	return out
+}
+
+// SyncOptions configures PlanSync and Sync
+type SyncOptions struct {
+	// Filter limits which existing checks are considered part of the managed set
+	Filter GetChecks
+
+	// Prune deletes managed checks which are not in the manifest
+	Prune bool
+
+	// DryRun makes Sync return the plan without applying it
+	DryRun bool
+
+	// Namespace is applied to every desired check and limits the managed set to checks inside it
+	Namespace Namespace
+
+	// DetectConflicts fails the plan with a *ConflictError when a check to be created has the
+	// slug or name of an existing check outside the managed set, instead of creating a near-duplicate
+	DetectConflicts bool
+
+	// RequireOwner fails the plan when a desired check doesn't declare an owner:<owner> tag
+	RequireOwner bool
+
+	// Descriptions renders the desired checks' descriptions before they're namespaced,
+	// usually a Manifest's Descriptions
+	Descriptions *ManifestDescriptions
+
+	// ConfirmDeletes confirms pruning for WithDeleteProtection's RequireConfirmation,
+	// checks with protected tags are still kept
+	ConfirmDeletes bool
+}
+
+// Namespace scopes managed checks to an environment so the same manifest can be
+// applied safely to several environments in one project.
+type Namespace struct {
+	// Prefix is prepended to every check's name and slug, e.g. "prod-"
+	Prefix string
+
+	// Tags are added to every check, e.g. "env:prod"
+	Tags []string
+}
+
+// EnvironmentNamespace returns the conventional namespace for env, a "<env>-" prefix and an "env:<env>" tag
+func EnvironmentNamespace(env string) Namespace {
+	if env == "" {
+		return Namespace{}
+	}
+	return Namespace{
+		Prefix: env + "-",
+		Tags:   []string{"env:" + env},
+	}
+}
+
+// Apply returns check with the namespace's prefix and tags added
+func (n Namespace) Apply(check CreateCheck) CreateCheck {
+	if n.Prefix != "" {
+		if check.Name != "" {
+			check.Name = n.Prefix + check.Name
+		}
+		if check.Slug != "" {
+			check.Slug = n.Prefix + check.Slug
+		}
+	}
+
+	tags := strings.Fields(check.Tags)
+	for _, tag := range n.Tags {
+		if !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	check.Tags = strings.Join(tags, " ")
+
+	return check
+}
+
+// Contains reports whether check has the namespace's slug prefix and tags
+func (n Namespace) Contains(check Check) bool {
+	if !strings.HasPrefix(check.Slug, n.Prefix) {
+		return false
+	}
+	tags := strings.Fields(check.Tags)
+	for _, tag := range n.Tags {
+		if !slices.Contains(tags, tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// detectConflicts returns a *ConflictError for the first planned create which collides with
+// a check outside the managed set
+func detectConflicts(ctx context.Context, client CheckReader, plan *SyncPlan, managed []Check, opts SyncOptions) error {
+	var creates []*CreateCheck
+	for _, ch := range plan.Changes {
+		if ch.Action == SyncCreate {
+			creates = append(creates, ch.Desired)
+		}
+	}
+	if len(creates) == 0 {
+		return nil
+	}
+
+	all, err := client.GetChecks(ctx, GetChecks{})
+	if err != nil {
+		return err
+	}
+	for _, want := range creates {
+		for _, check := range all.Checks {
+			if slices.ContainsFunc(managed, func(m Check) bool { return m.UUID == check.UUID }) {
+				continue
+			}
+			if check.Slug == want.Slug {
+				return &ConflictError{Field: "slug", Value: want.Slug, Existing: check}
+			}
+			if want.Name != "" && check.Name == want.Name {
+				return &ConflictError{Field: "name", Value: want.Name, Existing: check}
+			}
+		}
+	}
+	return nil
+}
+
+// PlanSync compares desired checks against the project and returns the changes needed.
+// Checks are matched by slug, which every desired check must set.
+func PlanSync(ctx context.Context, client CheckReader, desired []CreateCheck, opts SyncOptions) (*SyncPlan, error) {
+	existing, err := client.GetChecks(ctx, opts.Filter)
+	if err != nil {
+		return nil, fmt.Errorf("plan sync: %w", err)
+	}
+	existing.Checks = slices.DeleteFunc(existing.Checks, func(check Check) bool {
+		return !opts.Namespace.Contains(check)
+	})
+
+	if opts.Descriptions != nil {
+		desired, err = opts.Descriptions.Apply(desired)
+		if err != nil {
+			return nil, fmt.Errorf("plan sync: %w", err)
+		}
+	}
+
+	namespaced := make([]CreateCheck, len(desired))
+	for i := range desired {
+		namespaced[i] = opts.Namespace.Apply(desired[i])
+	}
+	desired = namespaced
+
+	if opts.RequireOwner {
+		if err := validateDesiredOwnership(desired); err != nil {
+			return nil, fmt.Errorf("plan sync: %w", err)
+		}
+	}
+
+	bySlug := make(map[string]*Check, len(existing.Checks))
+	for i := range existing.Checks {
+		bySlug[existing.Checks[i].Slug] = &existing.Checks[i]
+	}
+
+	plan := &SyncPlan{ConfirmDeletes: opts.ConfirmDeletes}
+	wanted := make(map[string]bool, len(desired))
+	for i := range desired {
+		want := &desired[i]
+		if want.Slug == "" {
+			return nil, fmt.Errorf("plan sync: check %q is missing a slug", want.Name)
+		}
+		if wanted[want.Slug] {
+			return nil, fmt.Errorf("plan sync: duplicate slug %q", want.Slug)
+		}
+		wanted[want.Slug] = true
+
+		current, exists := bySlug[want.Slug]
+		if !exists {
+			plan.Changes = append(plan.Changes, SyncChange{
+				Action:  SyncCreate,
+				Slug:    want.Slug,
+				Desired: want,
+			})
+			continue
+		}
+
+		if want.Tags != "" {
+			want.Tags = withStateTags(want.Tags, current.Tags)
+		}
+		change := SyncChange{
+			Action:   SyncUnchanged,
+			Slug:     want.Slug,
+			Desired:  want,
+			Existing: current,
+			Fields:   diffCheck(want, current),
+		}
+		if len(change.Fields) > 0 {
+			change.Action = SyncUpdate
+		}
+		plan.Changes = append(plan.Changes, change)
+	}
+
+	if opts.DetectConflicts {
+		if err := detectConflicts(ctx, client, plan, existing.Checks, opts); err != nil {
+			return nil, fmt.Errorf("plan sync: %w", err)
+		}
+	}
+
+	if opts.Prune {
+		for i := range existing.Checks {
+			current := &existing.Checks[i]
+			if !wanted[current.Slug] {
+				plan.Changes = append(plan.Changes, SyncChange{
+					Action:   SyncDelete,
+					Slug:     current.Slug,
+					Existing: current,
+				})
+			}
+		}
+	}
+	return plan, nil
+}
+
+// isStateTag reports whether tag holds state written by a helper rather than configuration
+// (PauseCheckFor's paused-until and ArchiveCheck's archived tags), which manifests can't know about
+func isStateTag(tag string) bool {
+	return isPausedUntilTag(tag) || isArchivedTag(tag)
+}
+
+// withStateTags returns tags with the state tags of existing added, so a sync which sets
+// a check's tags doesn't silently drop them
+func withStateTags(tags, existing string) string {
+	fields := strings.Fields(tags)
+	for _, tag := range strings.Fields(existing) {
+		if isStateTag(tag) && !slices.Contains(fields, tag) {
+			fields = append(fields, tag)
+		}
+	}
+	return strings.Join(fields, " ")
+}
+
+// ApplySync performs every pending change in plan. All changes are attempted, failures are joined together.
+func ApplySync(ctx context.Context, client CheckWriter, plan *SyncPlan) error {
+	var errs []error
+	for _, change := range plan.Pending() {
+		var err error
+		switch change.Action {
+		case SyncCreate:
+			_, err = client.CreateCheck(ctx, change.Desired)
+		case SyncUpdate:
+			_, err = client.UpdateCheck(ctx, change.Existing.UUID, change.Desired.toUpdate())
+		case SyncDelete:
+			_, err = client.DeleteCheck(ctx, change.Existing.UUID, confirmDelete(plan.ConfirmDeletes, *change.Existing)...)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s %s: %w", change.Action, change.Slug, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Sync makes the project match desired, returning the plan which was applied
+func Sync(ctx context.Context, client ManagementClient, desired []CreateCheck, opts SyncOptions) (*SyncPlan, error) {
+	plan, err := PlanSync(ctx, client, desired, opts)
+	if err != nil {
+		return nil, err
+	}
+	if opts.DryRun {
+		return plan, nil
+	}
+	return plan, ApplySync(ctx, client, plan)
+}
+
+// diffCheck returns the JSON names of fields set on want which differ from current.
+// Zero values on want are treated as unspecified, matching the API's omitempty semantics.
+// Both sides are normalized first, so values the API reformats don't show up as drift.
+func diffCheck(desired *CreateCheck, existing *Check) []string {
+	want, current := normalizeDesired(*desired), normalizeExisting(*existing)
+
+	// The API leaves timeout out of cron checks and schedule and tz out of simple checks
+	cron := want.Schedule != "" || (current.Schedule != "" && want.Timeout == 0)
+
+	var fields []string
+	diff := func(name string, changed bool) {
+		if changed {
+			fields = append(fields, name)
+		}
+	}
+
+	diff("name", want.Name != "" && want.Name != current.Name)
+	diff("tags", want.Tags != "" && want.Tags != current.Tags)
+	diff("desc", want.Description != "" && want.Description != current.Desc)
+	diff("timeout", !cron && want.Timeout != 0 && want.Timeout != current.Timeout)
+	diff("grace", want.Grace != 0 && want.Grace != current.Grace)
+	diff("schedule", want.Schedule != "" && want.Schedule != current.Schedule)
+	diff("tz", cron && want.Timezone != "" && want.Timezone != current.Timezone)
+	diff("manual_resume", want.ManualResume && !current.ManualResume)
+	diff("methods", want.Methods != "" && want.Methods != current.Methods)
+	diff("channels", want.Channels != "" && want.Channels != current.Channels)
+	diff("start_kw", want.StartKeywords != "" && want.StartKeywords != current.StartKw)
+	diff("success_kw", want.SuccessKeywords != "" && want.SuccessKeywords != current.SuccessKw)
+	diff("failure_kw", want.FailureKeywords != "" && want.FailureKeywords != current.FailureKw)
+	diff("filter_subject", want.FilterSubject && !current.FilterSubject)
+	diff("filter_body", want.FilterBody && !current.FilterBody)
+	diff("filter_http_body", want.FilterHttpBody && !current.FilterHTTPBody)
+	diff("filter_default_fail", want.FilterDefaultFail && !current.FilterDefaultFail)
+
+	return slices.Clip(fields)
+}
+
+// toUpdate converts a CreateCheck into the equivalent UpdateCheck
+func (c *CreateCheck) toUpdate() *UpdateCheck {
+	return &UpdateCheck{
+		Name:              c.Name,
+		Slug:              c.Slug,
+		Tags:              c.Tags,
+		Description:       c.Description,
+		Timeout:           c.Timeout,
+		Grace:             c.Grace,
+		Schedule:          c.Schedule,
+		Timezone:          c.Timezone,
+		ManualResume:      c.ManualResume,
+		Methods:           c.Methods,
+		Channels:          c.Channels,
+		StartKeywords:     c.StartKeywords,
+		SuccessKeywords:   c.SuccessKeywords,
+		FailureKeywords:   c.FailureKeywords,
+		FilterSubject:     c.FilterSubject,
+		FilterBody:        c.FilterBody,
+		FilterHttpBody:    c.FilterHttpBody,
+		FilterDefaultFail: c.FilterDefaultFail,
+	}
+}
@@ -0,0 +1,166 @@
+package healthchecksio
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSync(t *testing.T) {
+	ctx := context.Background()
+	mock := &memoryClient{
+		checks: []Check{
+			{UUID: "1", Slug: "backup", Name: "Backup", Grace: 60},
+			{UUID: "2", Slug: "reports", Name: "Reports", Grace: 300},
+			{UUID: "3", Slug: "old-job", Name: "Old"},
+		},
+	}
+
+	desired := []CreateCheck{
+		{Slug: "backup", Name: "Backup", Grace: 60},
+		{Slug: "reports", Name: "Reports", Grace: 600},
+		{Slug: "new-job", Name: "New", Timeout: 3600},
+	}
+
+	plan, err := PlanSync(ctx, mock, desired, SyncOptions{Prune: true})
+	require.NoError(t, err)
+
+	actions := make(map[string]SyncAction)
+	for _, ch := range plan.Changes {
+		actions[ch.Slug] = ch.Action
+	}
+	require.Equal(t, map[string]SyncAction{
+		"backup":  SyncUnchanged,
+		"reports": SyncUpdate,
+		"new-job": SyncCreate,
+		"old-job": SyncDelete,
+	}, actions)
+	require.Len(t, plan.Pending(), 3)
+	require.Equal(t, []string{"grace"}, plan.Changes[1].Fields)
+
+	// Dry runs don't modify anything
+	_, err = Sync(ctx, mock, desired, SyncOptions{Prune: true, DryRun: true})
+	require.NoError(t, err)
+	require.Empty(t, mock.calls)
+
+	_, err = Sync(ctx, mock, desired, SyncOptions{Prune: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{"update reports", "create new-job", "delete old-job"}, mock.calls)
+
+	// A second sync is a no-op
+	plan, err = PlanSync(ctx, mock, desired, SyncOptions{Prune: true})
+	require.NoError(t, err)
+	require.Empty(t, plan.Pending())
+}
+
+func TestPlanSync_Errors(t *testing.T) {
+	ctx := context.Background()
+	mock := &memoryClient{}
+
+	_, err := PlanSync(ctx, mock, []CreateCheck{{Name: "no slug"}}, SyncOptions{})
+	require.ErrorContains(t, err, `check "no slug" is missing a slug`)
+
+	_, err = PlanSync(ctx, mock, []CreateCheck{{Slug: "a"}, {Slug: "a"}}, SyncOptions{})
+	require.ErrorContains(t, err, `duplicate slug "a"`)
+}
+
+func TestPlanSync_Schedule(t *testing.T) {
+	ctx := context.Background()
+	mock := &memoryClient{
+		checks: []Check{
+			{UUID: "1", Slug: "nightly", Schedule: "0 3 * * *", Timezone: "UTC"},
+		},
+	}
+
+	plan, err := PlanSync(ctx, mock, []CreateCheck{
+		{Slug: "nightly", Schedule: "0 4 * * *", Timezone: "America/Chicago"},
+	}, SyncOptions{})
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 1)
+	require.Equal(t, []string{"schedule", "tz"}, plan.Changes[0].Fields)
+}
+
+func TestSync_Namespace(t *testing.T) {
+	ctx := context.Background()
+	mock := &memoryClient{
+		checks: []Check{
+			{UUID: "1", Slug: "backup", Name: "Backup"},
+			{UUID: "2", Slug: "staging-backup", Name: "staging-Backup", Tags: "db env:staging"},
+			{UUID: "3", Slug: "staging-old", Name: "staging-Old", Tags: "env:staging"},
+			{UUID: "4", Slug: "prod-backup", Name: "prod-Backup", Tags: "db env:prod"},
+		},
+	}
+	desired := []CreateCheck{
+		{Slug: "backup", Name: "Backup", Tags: "db"},
+		{Slug: "reports", Name: "Reports"},
+	}
+
+	for _, env := range []string{"staging", "prod"} {
+		_, err := Sync(ctx, mock, desired, SyncOptions{
+			Prune:     true,
+			Namespace: EnvironmentNamespace(env),
+		})
+		require.NoError(t, err)
+	}
+
+	// Checks outside either namespace are never touched
+	require.Equal(t, []string{
+		"create staging-reports",
+		"delete staging-old",
+		"create prod-reports",
+	}, mock.calls)
+
+	created, err := mock.GetChecks(ctx, GetChecks{Slug: "prod-reports"})
+	require.NoError(t, err)
+	require.Len(t, created.Checks, 1)
+	require.Equal(t, "prod-Reports", created.Checks[0].Name)
+	require.Equal(t, "env:prod", created.Checks[0].Tags)
+}
+
+func TestNamespace_Apply(t *testing.T) {
+	ns := EnvironmentNamespace("prod")
+	got := ns.Apply(CreateCheck{Slug: "backup", Tags: "db env:prod"})
+	require.Equal(t, CreateCheck{Slug: "prod-backup", Tags: "db env:prod"}, got)
+
+	require.True(t, ns.Contains(Check{Slug: "prod-backup", Tags: "env:prod db"}))
+	require.False(t, ns.Contains(Check{Slug: "prod-backup", Tags: "db"}))
+	require.False(t, ns.Contains(Check{Slug: "backup", Tags: "env:prod"}))
+
+	require.True(t, Namespace{}.Contains(Check{Slug: "anything"}))
+}
+
+func TestPlanSync_DetectConflicts(t *testing.T) {
+	ctx := context.Background()
+	mock := &memoryClient{
+		checks: []Check{
+			{UUID: "1", Slug: "backup", Name: "Backup"},
+			{UUID: "2", Slug: "reports", Name: "Reports", Tags: "managed"},
+		},
+	}
+	opts := SyncOptions{
+		Filter:          GetChecks{Tags: []string{"managed"}},
+		DetectConflicts: true,
+	}
+
+	// "backup" exists but isn't managed by us
+	_, err := PlanSync(ctx, mock, []CreateCheck{{Slug: "backup", Tags: "managed"}}, opts)
+	var conflict *ConflictError
+	require.ErrorAs(t, err, &conflict)
+	require.Equal(t, "slug", conflict.Field)
+	require.Equal(t, "1", conflict.Existing.UUID)
+
+	_, err = PlanSync(ctx, mock, []CreateCheck{{Slug: "backup-v2", Name: "Backup", Tags: "managed"}}, opts)
+	require.ErrorAs(t, err, &conflict)
+	require.Equal(t, "name", conflict.Field)
+
+	// Managed checks are updated rather than conflicting
+	plan, err := PlanSync(ctx, mock, []CreateCheck{{Slug: "reports", Name: "Reports", Tags: "managed"}}, opts)
+	require.NoError(t, err)
+	require.Empty(t, plan.Pending())
+
+	opts.DetectConflicts = false
+	plan, err = PlanSync(ctx, mock, []CreateCheck{{Slug: "backup", Tags: "managed"}}, opts)
+	require.NoError(t, err)
+	require.Equal(t, SyncCreate, plan.Changes[0].Action)
+}
@@ -0,0 +1,322 @@
+package healthchecksio
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrUnsupportedSchedule is returned for timers whose schedule has no Healthchecks equivalent,
+// e.g. OnCalendar expressions with seconds or years, or timers which only fire once
+var ErrUnsupportedSchedule = errors.New("schedule can't be expressed as a Healthchecks schedule")
+
+// SystemdTimerOptions configures ParseSystemdTimer
+type SystemdTimerOptions struct {
+	// Timezone is used for OnCalendar expressions without one, the API defaults to UTC
+	Timezone string
+
+	// Tags are set on every check
+	Tags string
+}
+
+// ParseSystemdTimer converts a .timer unit into a check. OnCalendar expressions become cron
+// schedules and OnUnitActiveSec/OnUnitInactiveSec become simple period checks. name is the
+// unit's file name, e.g. "backup.timer".
+func ParseSystemdTimer(name string, r io.Reader, opts SystemdTimerOptions) (*CreateCheck, error) {
+	unit, err := parseUnitFile(r)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	base := strings.TrimSuffix(name, ".timer")
+
+	check := &CreateCheck{
+		Name:     base,
+		Slug:     slugify(base),
+		Tags:     opts.Tags,
+		Timezone: opts.Timezone,
+	}
+	if desc := unit.get("Unit", "Description"); desc != "" {
+		check.Name = desc
+	}
+
+	calendars := unit.all("Timer", "OnCalendar")
+	period := unit.get("Timer", "OnUnitActiveSec")
+	if period == "" {
+		period = unit.get("Timer", "OnUnitInactiveSec")
+	}
+	switch {
+	case len(calendars) > 1:
+		return nil, fmt.Errorf("%s: several OnCalendar expressions: %w", name, ErrUnsupportedSchedule)
+	case len(calendars) == 1:
+		schedule, tz, err := onCalendarToCron(calendars[0])
+		if err != nil {
+			return nil, fmt.Errorf("%s: OnCalendar=%s: %w", name, calendars[0], err)
+		}
+		check.Schedule = schedule
+		if tz != "" {
+			check.Timezone = tz
+		}
+		check.Description = fmt.Sprintf("Imported from %s: OnCalendar=%s", name, calendars[0])
+	case period != "":
+		timeout, err := parseTimespan(period)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		check.Timeout = int(timeout.Seconds())
+		check.Timezone = ""
+		check.Description = fmt.Sprintf("Imported from %s: every %s", name, period)
+	default:
+		return nil, fmt.Errorf("%s: no recurring trigger: %w", name, ErrUnsupportedSchedule)
+	}
+
+	if service := unit.get("Timer", "Unit"); service != "" {
+		check.Description += "\nActivates " + service
+	}
+	if delay := unit.get("Timer", "RandomizedDelaySec"); delay != "" {
+		grace, err := parseTimespan(delay)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		check.Grace = max(60, int(grace.Seconds())+60)
+	}
+	return check, nil
+}
+
+// unitFile holds the settings of a systemd unit by section, later values are appended
+type unitFile map[string]map[string][]string
+
+func parseUnitFile(r io.Reader) (unitFile, error) {
+	unit := make(unitFile)
+	section := ""
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			section = line[1 : len(line)-1]
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid line %q", line)
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		if unit[section] == nil {
+			unit[section] = make(map[string][]string)
+		}
+		if value == "" {
+			delete(unit[section], key) // an empty assignment resets the list
+			continue
+		}
+		unit[section][key] = append(unit[section][key], value)
+	}
+	return unit, scanner.Err()
+}
+
+func (u unitFile) all(section, key string) []string {
+	return u[section][key]
+}
+
+func (u unitFile) get(section, key string) string {
+	values := u[section][key]
+	if len(values) == 0 {
+		return ""
+	}
+	return values[len(values)-1]
+}
+
+// calendarShorthands are the OnCalendar shorthands, see systemd.time(7)
+var calendarShorthands = map[string]string{
+	"minutely":     "* * * * *",
+	"hourly":       "0 * * * *",
+	"daily":        "0 0 * * *",
+	"weekly":       "0 0 * * 1",
+	"monthly":      "0 0 1 * *",
+	"quarterly":    "0 0 1 1,4,7,10 *",
+	"semiannually": "0 0 1 1,7 *",
+	"yearly":       "0 0 1 1 *",
+	"annually":     "0 0 1 1 *",
+}
+
+// onCalendarToCron translates a systemd calendar expression ("[weekdays] [date] [time] [timezone]")
+// into a cron expression and its timezone, when it fires at most once a minute on whole minutes.
+func onCalendarToCron(expr string) (schedule, tz string, err error) {
+	fields := strings.Fields(expr)
+	if n := len(fields); n > 1 && isTimezone(fields[n-1]) {
+		tz, fields = fields[n-1], fields[:n-1]
+	}
+	if len(fields) == 1 {
+		if cron, exists := calendarShorthands[strings.ToLower(fields[0])]; exists {
+			return cron, tz, nil
+		}
+	}
+
+	dow, month, dom, hour, minute := "*", "*", "*", "0", "0"
+	if len(fields) > 0 && isWeekdays(fields[0]) {
+		if dow, err = calendarWeekdays(fields[0]); err != nil {
+			return "", "", err
+		}
+		fields = fields[1:]
+	}
+	if len(fields) > 0 && strings.Contains(fields[0], "-") && !strings.Contains(fields[0], ":") {
+		parts := strings.Split(fields[0], "-")
+		if len(parts) == 2 {
+			parts = append([]string{"*"}, parts...)
+		}
+		if len(parts) != 3 || parts[0] != "*" {
+			return "", "", fmt.Errorf("date %s: %w", fields[0], ErrUnsupportedSchedule)
+		}
+		if month, err = calendarComponent(parts[1], 12); err != nil {
+			return "", "", err
+		}
+		if dom, err = calendarComponent(parts[2], 31); err != nil {
+			return "", "", err
+		}
+		fields = fields[1:]
+	}
+	if len(fields) > 0 && strings.Contains(fields[0], ":") {
+		parts := strings.Split(fields[0], ":")
+		if len(parts) == 3 {
+			if s := strings.TrimLeft(parts[2], "0"); s != "" {
+				return "", "", fmt.Errorf("seconds %s: %w", parts[2], ErrUnsupportedSchedule)
+			}
+			parts = parts[:2]
+		}
+		if len(parts) != 2 {
+			return "", "", fmt.Errorf("time %s: %w", fields[0], ErrUnsupportedSchedule)
+		}
+		if hour, err = calendarComponent(parts[0], 23); err != nil {
+			return "", "", err
+		}
+		if minute, err = calendarComponent(parts[1], 59); err != nil {
+			return "", "", err
+		}
+		fields = fields[1:]
+	}
+	if len(fields) > 0 {
+		return "", "", fmt.Errorf("unexpected %q: %w", strings.Join(fields, " "), ErrUnsupportedSchedule)
+	}
+
+	schedule = strings.Join([]string{minute, hour, dom, month, dow}, " ")
+	if _, err := parseCron(schedule); err != nil {
+		return "", "", fmt.Errorf("%w: %v", ErrUnsupportedSchedule, err)
+	}
+	return schedule, tz, nil
+}
+
+// isTimezone reports whether the last field of a calendar expression names a timezone
+func isTimezone(field string) bool {
+	if strings.ContainsAny(field, ":*.,") || isWeekdays(field) {
+		return false
+	}
+	return field == "UTC" || strings.Contains(field, "/")
+}
+
+var weekdayNames = map[string]string{
+	"mon": "mon", "monday": "mon", "tue": "tue", "tuesday": "tue", "wed": "wed", "wednesday": "wed",
+	"thu": "thu", "thursday": "thu", "fri": "fri", "friday": "fri", "sat": "sat", "saturday": "sat",
+	"sun": "sun", "sunday": "sun",
+}
+
+func isWeekdays(field string) bool {
+	first, _, _ := strings.Cut(strings.ToLower(field), ",")
+	first, _, _ = strings.Cut(first, "..")
+	_, exists := weekdayNames[first]
+	return exists
+}
+
+// calendarWeekdays translates "Mon..Fri,Sun" into "mon-fri,sun"
+func calendarWeekdays(field string) (string, error) {
+	var out []string
+	for _, part := range strings.Split(strings.ToLower(field), ",") {
+		from, to, isRange := strings.Cut(part, "..")
+		day, exists := weekdayNames[from]
+		if !exists {
+			return "", fmt.Errorf("weekday %s: %w", from, ErrUnsupportedSchedule)
+		}
+		if isRange {
+			last, exists := weekdayNames[to]
+			if !exists {
+				return "", fmt.Errorf("weekday %s: %w", to, ErrUnsupportedSchedule)
+			}
+			day += "-" + last
+		}
+		out = append(out, day)
+	}
+	return strings.Join(out, ","), nil
+}
+
+// calendarComponent translates one numeric component, e.g. "*", "01,15", "9..17" or "0/15"
+func calendarComponent(field string, max int) (string, error) {
+	var out []string
+	for _, part := range strings.Split(field, ",") {
+		value, step, hasStep := strings.Cut(part, "/")
+		if from, to, isRange := strings.Cut(value, ".."); isRange {
+			value = trimZeros(from) + "-" + trimZeros(to)
+		} else if value != "*" {
+			value = trimZeros(value)
+			if hasStep {
+				value += "-" + strconv.Itoa(max) // cron steps need a range
+			}
+		}
+		if hasStep {
+			value += "/" + trimZeros(step)
+		}
+		out = append(out, value)
+	}
+	return strings.Join(out, ","), nil
+}
+
+func trimZeros(s string) string {
+	if trimmed := strings.TrimLeft(s, "0"); trimmed != "" {
+		return trimmed
+	}
+	return "0"
+}
+
+// timespanUnits are the units of systemd time spans, see systemd.time(7)
+var timespanUnits = map[string]time.Duration{
+	"s": time.Second, "sec": time.Second, "second": time.Second, "seconds": time.Second,
+	"m": time.Minute, "min": time.Minute, "minute": time.Minute, "minutes": time.Minute,
+	"h": time.Hour, "hr": time.Hour, "hour": time.Hour, "hours": time.Hour,
+	"d": 24 * time.Hour, "day": 24 * time.Hour, "days": 24 * time.Hour,
+	"w": 7 * 24 * time.Hour, "week": 7 * 24 * time.Hour, "weeks": 7 * 24 * time.Hour,
+}
+
+// parseTimespan parses a systemd time span like "1h 30min" or "90" (seconds)
+func parseTimespan(span string) (time.Duration, error) {
+	if n, err := strconv.Atoi(span); err == nil {
+		return time.Duration(n) * time.Second, nil
+	}
+
+	var total time.Duration
+	rest := strings.ReplaceAll(span, " ", "")
+	for rest != "" {
+		i := strings.IndexFunc(rest, func(r rune) bool { return r < '0' || r > '9' })
+		if i <= 0 {
+			return 0, fmt.Errorf("invalid time span %q", span)
+		}
+		n, _ := strconv.Atoi(rest[:i])
+		rest = rest[i:]
+
+		j := strings.IndexFunc(rest, func(r rune) bool { return r >= '0' && r <= '9' })
+		if j < 0 {
+			j = len(rest)
+		}
+		unit, exists := timespanUnits[rest[:j]]
+		if !exists {
+			return 0, fmt.Errorf("invalid time span %q", span)
+		}
+		total += time.Duration(n) * unit
+		rest = rest[j:]
+	}
+	return total, nil
+}
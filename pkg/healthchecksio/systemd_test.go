@@ -0,0 +1,104 @@
+package healthchecksio
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOnCalendarToCron(t *testing.T) {
+	cases := map[string]string{
+		"daily":                        "0 0 * * *",
+		"weekly":                       "0 0 * * 1",
+		"*-*-* 03:00:00":               "0 3 * * *",
+		"Mon..Fri 09:30":               "30 9 * * mon-fri",
+		"Sat,Sun *-*-* 10:00:00":       "0 10 * * sat,sun",
+		"*-*-01 04:15:00":              "15 4 1 * *",
+		"*-01,07-01 00:00":             "0 0 1 1,7 *",
+		"*:0/15":                       "0-59/15 * * * *",
+		"*-*-* 08..18:00":              "0 8-18 * * *",
+		"Mon *-*-* 00:00:00 UTC":       "0 0 * * mon",
+		"*-*-* 06:00 America/New_York": "0 6 * * *",
+	}
+	for expr, want := range cases {
+		got, _, err := onCalendarToCron(expr)
+		require.NoError(t, err, expr)
+		require.Equal(t, want, got, expr)
+	}
+
+	_, tz, err := onCalendarToCron("*-*-* 06:00 America/New_York")
+	require.NoError(t, err)
+	require.Equal(t, "America/New_York", tz)
+
+	for _, expr := range []string{"*-*-* 03:00:30", "2025-01-01 00:00", "*:*:0/10", "Mon 25:00"} {
+		_, _, err := onCalendarToCron(expr)
+		require.ErrorIs(t, err, ErrUnsupportedSchedule, expr)
+	}
+}
+
+func TestParseTimespan(t *testing.T) {
+	cases := map[string]time.Duration{
+		"90":        90 * time.Second,
+		"15min":     15 * time.Minute,
+		"1h 30min":  90 * time.Minute,
+		"2d":        48 * time.Hour,
+		"1w":        7 * 24 * time.Hour,
+		"1hour5sec": time.Hour + 5*time.Second,
+	}
+	for span, want := range cases {
+		got, err := parseTimespan(span)
+		require.NoError(t, err, span)
+		require.Equal(t, want, got, span)
+	}
+
+	_, err := parseTimespan("soon")
+	require.Error(t, err)
+}
+
+func TestParseSystemdTimer(t *testing.T) {
+	unit := `[Unit]
+Description=Nightly database backup
+
+[Timer]
+OnCalendar=*-*-* 02:30:00 Europe/Berlin
+RandomizedDelaySec=15min
+Unit=backup.service
+Persistent=true
+
+[Install]
+WantedBy=timers.target
+`
+	check, err := ParseSystemdTimer("db-backup.timer", strings.NewReader(unit), SystemdTimerOptions{Tags: "systemd"})
+	require.NoError(t, err)
+	require.Equal(t, "Nightly database backup", check.Name)
+	require.Equal(t, "db-backup", check.Slug)
+	require.Equal(t, "30 2 * * *", check.Schedule)
+	require.Equal(t, "Europe/Berlin", check.Timezone)
+	require.Equal(t, 960, check.Grace)
+	require.Equal(t, "systemd", check.Tags)
+	require.Equal(t, "Imported from db-backup.timer: OnCalendar=*-*-* 02:30:00 Europe/Berlin\nActivates backup.service", check.Description)
+
+	periodic := "[Timer]\nOnBootSec=5min\nOnUnitActiveSec=1h\n"
+	check, err = ParseSystemdTimer("refresh.timer", strings.NewReader(periodic), SystemdTimerOptions{Timezone: "UTC"})
+	require.NoError(t, err)
+	require.Equal(t, 3600, check.Timeout)
+	require.Empty(t, check.Schedule)
+	require.Empty(t, check.Timezone)
+
+	// An empty assignment resets earlier expressions, like systemd does
+	reset := "[Timer]\nOnCalendar=hourly\nOnCalendar=\nOnCalendar=daily\n"
+	check, err = ParseSystemdTimer("reset.timer", strings.NewReader(reset), SystemdTimerOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "0 0 * * *", check.Schedule)
+
+	for _, body := range []string{
+		"[Timer]\nOnBootSec=10min\n",
+		"[Timer]\nOnCalendar=hourly\nOnCalendar=daily\n",
+	} {
+		_, err := ParseSystemdTimer("x.timer", strings.NewReader(body), SystemdTimerOptions{})
+		require.True(t, errors.Is(err, ErrUnsupportedSchedule), body)
+	}
+}
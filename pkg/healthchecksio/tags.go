@@ -0,0 +1,77 @@
+package healthchecksio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// tagUpdateAttempts bounds how often a tag edit restarts after a concurrent change
+const tagUpdateAttempts = 3
+
+// AddTags adds tags to a check, keeping the tags it already has
+func AddTags(ctx context.Context, client ManagementClient, uuid string, tags ...string) (*Check, error) {
+	check, err := editTags(ctx, client, uuid, func(current []string) []string {
+		for _, tag := range tags {
+			if !slices.Contains(current, tag) {
+				current = append(current, tag)
+			}
+		}
+		return current
+	})
+	if err != nil {
+		return nil, fmt.Errorf("add tags: %w", err)
+	}
+	return check, nil
+}
+
+// RemoveTags removes tags from a check, keeping its other tags
+func RemoveTags(ctx context.Context, client ManagementClient, uuid string, tags ...string) (*Check, error) {
+	check, err := editTags(ctx, client, uuid, func(current []string) []string {
+		return slices.DeleteFunc(current, func(tag string) bool {
+			return slices.Contains(tags, tag)
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("remove tags: %w", err)
+	}
+	return check, nil
+}
+
+// editTags applies edit to a check's tags. The API has no conditional updates, so the check is
+// read again right before writing and the edit starts over when its tags changed in between,
+// which keeps concurrent edits from clobbering each other outside of a very small window.
+func editTags(ctx context.Context, client ManagementClient, uuid string, edit func([]string) []string) (*Check, error) {
+	for range tagUpdateAttempts {
+		check, err := client.GetCheck(ctx, uuid)
+		if err != nil {
+			return nil, err
+		}
+		want := strings.Join(edit(strings.Fields(check.Tags)), " ")
+		if want == normalizeTags(check.Tags) {
+			return check, nil
+		}
+
+		latest, err := client.GetCheck(ctx, uuid, WithoutCache())
+		if err != nil {
+			return nil, err
+		}
+		if latest.Tags != check.Tags {
+			continue
+		}
+
+		// Sent raw so removing the last tag still sends "tags": ""
+		body, err := json.Marshal(map[string]string{"tags": want})
+		if err != nil {
+			return nil, err
+		}
+		return client.UpdateCheckRaw(ctx, uuid, body)
+	}
+	return nil, fmt.Errorf("check %s was modified concurrently %d times", uuid, tagUpdateAttempts)
+}
+
+func normalizeTags(tags string) string {
+	return strings.Join(strings.Fields(tags), " ")
+}
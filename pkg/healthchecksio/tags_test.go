@@ -0,0 +1,76 @@
+package healthchecksio
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddRemoveTags(t *testing.T) {
+	ctx := context.Background()
+	mock := &memoryClient{
+		checks: []Check{{UUID: "1", Slug: "nightly", Tags: "prod  db"}},
+	}
+
+	check, err := AddTags(ctx, mock, "1", "db", "team:billing")
+	require.NoError(t, err)
+	require.Equal(t, "prod db team:billing", check.Tags)
+
+	check, err = RemoveTags(ctx, mock, "1", "prod", "missing")
+	require.NoError(t, err)
+	require.Equal(t, "db team:billing", check.Tags)
+
+	// Removing every tag still sends the update
+	check, err = RemoveTags(ctx, mock, "1", "db", "team:billing")
+	require.NoError(t, err)
+	require.Empty(t, check.Tags)
+
+	// No-op edits don't write
+	mock.calls = nil
+	_, err = RemoveTags(ctx, mock, "1", "db")
+	require.NoError(t, err)
+	require.Empty(t, mock.calls)
+
+	_, err = AddTags(ctx, mock, "404", "db")
+	require.ErrorContains(t, err, "add tags: get check failed with 404")
+}
+
+// racingClient changes a check's tags after it's first read, like a concurrent editor would
+type racingClient struct {
+	*memoryClient
+
+	reads int
+	races int
+}
+
+func (r *racingClient) GetCheck(ctx context.Context, identifier string, opts ...CallOption) (*Check, error) {
+	r.reads++
+	check, err := r.memoryClient.GetCheck(ctx, identifier, opts...)
+	if err == nil && r.reads%2 == 1 && r.races > 0 {
+		r.races--
+		r.mu.Lock()
+		r.checks[r.find(identifier)].Tags += " other-" + string(rune('a'+r.races))
+		r.mu.Unlock()
+	}
+	return check, err
+}
+
+func TestAddTags_ConcurrentEdit(t *testing.T) {
+	ctx := context.Background()
+	client := &racingClient{
+		memoryClient: &memoryClient{
+			checks: []Check{{UUID: "1", Tags: "prod"}},
+		},
+		races: 1,
+	}
+
+	// The concurrent tag is kept rather than clobbered
+	check, err := AddTags(ctx, client, "1", "db")
+	require.NoError(t, err)
+	require.Equal(t, "prod other-a db", check.Tags)
+
+	client.races = tagUpdateAttempts
+	_, err = AddTags(ctx, client, "1", "team:billing")
+	require.EqualError(t, err, "add tags: check 1 was modified concurrently 3 times")
+}
@@ -0,0 +1,100 @@
+package healthchecksio
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Time parses the flip's timestamp
+func (f Flip) Time() (time.Time, error) {
+	return time.Parse(time.RFC3339, f.Timestamp)
+}
+
+// TimelineEvent is one check changing status
+type TimelineEvent struct {
+	Check string
+	At    time.Time
+	Up    bool
+}
+
+// Outage is a period where at least one check was down
+type Outage struct {
+	Start time.Time
+
+	// End is when the last check recovered, zero while the outage is ongoing
+	End time.Time
+
+	// Checks lists every check which was down during the outage, in the order they went down.
+	// More than one check means their outages overlapped.
+	Checks []string
+}
+
+// Timeline is the merged status history of several checks
+type Timeline struct {
+	Events  []TimelineEvent
+	Outages []Outage
+}
+
+// MergeFlips merges the flips of several checks, keyed by a name for each check (slug, UUID, ...),
+// into one time-ordered timeline and groups overlapping downtime into outages.
+// Checks are assumed to be up before their first flip.
+func MergeFlips(flips map[string][]Flip) (*Timeline, error) {
+	timeline := &Timeline{}
+	for check, list := range flips {
+		for _, flip := range list {
+			at, err := flip.Time()
+			if err != nil {
+				return nil, fmt.Errorf("merge flips: %s: %w", check, err)
+			}
+			timeline.Events = append(timeline.Events, TimelineEvent{
+				Check: check,
+				At:    at.UTC(),
+				Up:    flip.Up == 1,
+			})
+		}
+	}
+	slices.SortFunc(timeline.Events, func(a, b TimelineEvent) int {
+		return cmp.Or(a.At.Compare(b.At), cmp.Compare(a.Check, b.Check))
+	})
+
+	down := make(map[string]bool)
+	var current *Outage
+	for _, event := range timeline.Events {
+		if event.Up == !down[event.Check] {
+			continue // repeated flip to the same state
+		}
+		down[event.Check] = !event.Up
+
+		if !event.Up {
+			if current == nil {
+				current = &Outage{Start: event.At}
+			}
+			if !slices.Contains(current.Checks, event.Check) {
+				current.Checks = append(current.Checks, event.Check)
+			}
+			continue
+		}
+
+		if current != nil && !anyDown(down) {
+			current.End = event.At
+			timeline.Outages = append(timeline.Outages, *current)
+			current = nil
+		}
+	}
+	if current != nil {
+		timeline.Outages = append(timeline.Outages, *current)
+	}
+
+	return timeline, nil
+}
+
+func anyDown(down map[string]bool) bool {
+	for _, d := range down {
+		if d {
+			return true
+		}
+	}
+	return false
+}
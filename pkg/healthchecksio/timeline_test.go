@@ -0,0 +1,51 @@
+package healthchecksio_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeFlips(t *testing.T) {
+	timeline, err := healthchecksio.MergeFlips(map[string][]healthchecksio.Flip{
+		"api": {
+			{Timestamp: "2026-03-01T10:00:00+00:00", Up: 0},
+			{Timestamp: "2026-03-01T10:30:00+00:00", Up: 1},
+			{Timestamp: "2026-03-02T08:00:00+00:00", Up: 0},
+		},
+		"db": {
+			{Timestamp: "2026-03-01T10:10:00+00:00", Up: 0},
+			{Timestamp: "2026-03-01T10:45:00+00:00", Up: 1},
+		},
+		"worker": {
+			{Timestamp: "2026-03-01T12:00:00+00:00", Up: 0},
+			{Timestamp: "2026-03-01T12:05:00+00:00", Up: 1},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, timeline.Events, 7)
+	require.Equal(t, "api", timeline.Events[0].Check)
+	require.Equal(t, "db", timeline.Events[1].Check)
+	require.False(t, timeline.Events[1].Up)
+
+	at := func(value string) time.Time {
+		t.Helper()
+		when, err := time.Parse(time.RFC3339, value)
+		require.NoError(t, err)
+		return when
+	}
+	require.Equal(t, []healthchecksio.Outage{
+		{Start: at("2026-03-01T10:00:00Z"), End: at("2026-03-01T10:45:00Z"), Checks: []string{"api", "db"}},
+		{Start: at("2026-03-01T12:00:00Z"), End: at("2026-03-01T12:05:00Z"), Checks: []string{"worker"}},
+		{Start: at("2026-03-02T08:00:00Z"), Checks: []string{"api"}},
+	}, timeline.Outages)
+
+	_, err = healthchecksio.MergeFlips(map[string][]healthchecksio.Flip{
+		"api": {{Timestamp: "yesterday"}},
+	})
+	require.ErrorContains(t, err, "merge flips: api:")
+}
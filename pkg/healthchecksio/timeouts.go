@@ -0,0 +1,56 @@
+package healthchecksio
+
+import (
+	"net"
+	"net/http"
+	"runtime"
+	"time"
+)
+
+// WithDialTimeout bounds how long establishing a connection may take, covering both the
+// TCP dial and the TLS handshake, so unreachable network paths fail fast. Each retry gets its own timeout.
+func WithDialTimeout(timeout time.Duration) ClientOption {
+	return func(c *client) {
+		if transport := c.transport(); transport != nil {
+			transport.DialContext = (&net.Dialer{
+				Timeout:   timeout,
+				KeepAlive: 30 * time.Second,
+			}).DialContext
+			transport.TLSHandshakeTimeout = timeout
+		}
+	}
+}
+
+// WithResponseHeaderTimeout bounds how long to wait for response headers once a request is
+// written. Reading the body isn't limited so large, slowly streamed responses still succeed.
+func WithResponseHeaderTimeout(timeout time.Duration) ClientOption {
+	return func(c *client) {
+		if transport := c.transport(); transport != nil {
+			transport.ResponseHeaderTimeout = timeout
+		}
+	}
+}
+
+// newTransport returns a pooled transport for the client, unlike http.DefaultTransport
+// it isn't shared with (or modified by) the rest of the program.
+func newTransport() *http.Transport {
+	return &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   runtime.GOMAXPROCS(0) + 1,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+}
+
+// transport returns the underlying *http.Transport, nil when it has been replaced
+func (c *client) transport() *http.Transport {
+	transport, _ := c.httpClient.Transport.(*http.Transport)
+	return transport
+}
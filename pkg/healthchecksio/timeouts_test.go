@@ -0,0 +1,51 @@
+package healthchecksio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeouts(t *testing.T) {
+	c := NewClient("key", WithDialTimeout(2*time.Second), WithResponseHeaderTimeout(time.Minute)).(*client)
+
+	transport := c.transport()
+	require.NotNil(t, transport)
+	require.NotNil(t, transport.DialContext)
+	require.Equal(t, 2*time.Second, transport.TLSHandshakeTimeout)
+	require.Equal(t, time.Minute, transport.ResponseHeaderTimeout)
+
+	// Other clients keep their own transport
+	require.Zero(t, NewClient("key").(*client).transport().ResponseHeaderTimeout)
+}
+
+func TestWithResponseHeaderTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/checks/slow" {
+			time.Sleep(100 * time.Millisecond)
+		}
+		w.(http.Flusher).Flush()
+
+		// Bodies may take longer than the header timeout
+		time.Sleep(50 * time.Millisecond)
+		w.Write([]byte(`{"uuid":"abc"}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("key",
+		WithBaseURL(srv.URL),
+		WithRetries(0, 0, 0),
+		WithResponseHeaderTimeout(20*time.Millisecond),
+	)
+
+	check, err := c.GetCheck(context.Background(), "abc")
+	require.NoError(t, err)
+	require.Equal(t, "abc", check.UUID)
+
+	_, err = c.GetCheck(context.Background(), "slow")
+	require.ErrorContains(t, err, "timeout awaiting response headers")
+}
@@ -0,0 +1,99 @@
+package healthchecksio
+
+import (
+	"context"
+)
+
+const defaultSpanPrefix = "healthchecksio-"
+
+// endpoints are the names of every call the client makes, used in Stats and span names
+var endpoints = []string{
+	"create-check", "get-checks", "get-check", "update-check", "delete-check", "pause-check", "resume-check",
+	"get-pings", "get-ping-body", "get-flips", "get-channels", "do", "ping",
+}
+
+// Tracer starts the spans wrapping client calls. The client doesn't trace by default,
+// the healthchecksotel package provides an OpenTelemetry Tracer.
+type Tracer interface {
+	Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span)
+}
+
+// Span is a single traced client call
+type Span interface {
+	End()
+
+	// IsRecording reports whether attributes and events are being collected
+	IsRecording() bool
+
+	SetAttributes(attrs ...Attribute)
+	AddEvent(name string, attrs ...Attribute)
+}
+
+// Attribute is a key/value pair attached to spans and their events.
+// Values are strings, ints or bools.
+type Attribute struct {
+	Key   string
+	Value any
+}
+
+func attr(key string, value any) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// WithTracer traces the client's calls with tracer
+func WithTracer(tracer Tracer) ClientOption {
+	return func(c *client) {
+		c.tracer = tracer
+	}
+}
+
+// WithSpanPrefix replaces the "healthchecksio-" prefix of every span name,
+// e.g. a prefix of "billing.hc." names the GetCheck span "billing.hc.api-get-check".
+func WithSpanPrefix(prefix string) ClientOption {
+	return func(c *client) {
+		c.spanPrefix = prefix
+	}
+}
+
+// buildSpanNames computes span names up front so starting a span doesn't allocate one
+func (c *client) buildSpanNames() {
+	c.spanNames = make(map[string]string, len(endpoints))
+	for _, endpoint := range endpoints {
+		c.spanNames[endpoint] = c.spanPrefix + "api-" + endpoint
+	}
+}
+
+type spanKey struct{}
+
+// startSpan starts the span for a call to endpoint. Recording spans are kept in the
+// returned context so retry hooks further down can find them with spanFromContext.
+func (c *client) startSpan(ctx context.Context, endpoint string, attrs ...Attribute) (context.Context, Span) {
+	if c.tracer == nil {
+		return ctx, noopSpan{}
+	}
+
+	name, exists := c.spanNames[endpoint]
+	if !exists {
+		name = c.spanPrefix + "api-" + endpoint
+	}
+	ctx, span := c.tracer.Start(ctx, name, attrs...)
+	if span.IsRecording() {
+		ctx = context.WithValue(ctx, spanKey{}, span)
+	}
+	return ctx, span
+}
+
+// spanFromContext returns the recording span started by the client, or a no-op span
+func spanFromContext(ctx context.Context) Span {
+	if span, ok := ctx.Value(spanKey{}).(Span); ok {
+		return span
+	}
+	return noopSpan{}
+}
+
+type noopSpan struct{}
+
+func (noopSpan) End()                          {}
+func (noopSpan) IsRecording() bool             { return false }
+func (noopSpan) SetAttributes(...Attribute)    {}
+func (noopSpan) AddEvent(string, ...Attribute) {}
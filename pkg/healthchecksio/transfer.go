@@ -0,0 +1,98 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// TransferOptions configures TransferChecks
+type TransferOptions struct {
+	// Channels is assigned to the new checks, e.g. "*" for every integration in the destination.
+	// Channel UUIDs belong to a project so the source's assignments can't be copied.
+	Channels string
+
+	// PauseOriginals pauses each source check once its copy is created
+	PauseOriginals bool
+
+	// DeleteOriginals deletes each source check once its copy is created
+	DeleteOriginals bool
+
+	// DryRun returns the transfers without creating or changing any check
+	DryRun bool
+
+	// ConfirmDeletes confirms deleting originals for WithDeleteProtection's RequireConfirmation,
+	// checks with protected tags are still refused
+	ConfirmDeletes bool
+}
+
+// Transfer is one check recreated in another project
+type Transfer struct {
+	Source Check
+
+	// Created is the new check, nil for dry runs
+	Created *Check
+
+	// Existed is true when the destination already had a check with the source's slug,
+	// which is then left unchanged and reported as Created
+	Existed bool
+}
+
+// TransferChecks recreates the checks matching filter from src's project in dst's project, which are
+// typically clients created with different API keys. Checks whose slug already exists in the destination
+// are skipped so transfers can be resumed. It stops at the first error, returning the transfers done so far.
+//
+// Ping URLs and ping keys are per project: jobs need the new checks' ping URLs before originals are deleted.
+func TransferChecks(ctx context.Context, src, dst ManagementClient, filter GetChecks, opts TransferOptions) ([]Transfer, error) {
+	if opts.PauseOriginals && opts.DeleteOriginals {
+		return nil, errors.New("transfer checks: PauseOriginals and DeleteOriginals are exclusive")
+	}
+
+	sources, err := src.GetChecks(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("transfer checks: %w", err)
+	}
+	existing, err := dst.GetChecks(ctx, GetChecks{})
+	if err != nil {
+		return nil, fmt.Errorf("transfer checks: %w", err)
+	}
+	bySlug := make(map[string]*Check, len(existing.Checks))
+	for i := range existing.Checks {
+		if slug := existing.Checks[i].Slug; slug != "" {
+			bySlug[slug] = &existing.Checks[i]
+		}
+	}
+
+	var out []Transfer
+	for _, source := range sources.Checks {
+		transfer := Transfer{Source: source}
+		if current, exists := bySlug[source.Slug]; exists && source.Slug != "" {
+			transfer.Created, transfer.Existed = current, true
+			out = append(out, transfer)
+			continue
+		}
+		if opts.DryRun {
+			out = append(out, transfer)
+			continue
+		}
+
+		create := source.toCreate()
+		create.Channels = opts.Channels
+		transfer.Created, err = dst.CreateCheck(ctx, &create)
+		if err != nil {
+			return out, fmt.Errorf("transfer checks: %s: %w", source.UUID, err)
+		}
+		out = append(out, transfer)
+
+		switch {
+		case opts.PauseOriginals:
+			_, err = src.PauseCheck(ctx, source.UUID)
+		case opts.DeleteOriginals:
+			_, err = src.DeleteCheck(ctx, source.UUID, confirmDelete(opts.ConfirmDeletes, source)...)
+		}
+		if err != nil {
+			return out, fmt.Errorf("transfer checks: %s: %w", source.UUID, err)
+		}
+	}
+	return out, nil
+}
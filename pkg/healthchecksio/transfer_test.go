@@ -0,0 +1,46 @@
+package healthchecksio
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferChecks(t *testing.T) {
+	ctx := context.Background()
+	src := &memoryClient{
+		checks: []Check{
+			{UUID: "a", Name: "Backup", Slug: "backup", Tags: "team:billing", Timeout: 3600, Channels: "src-channel"},
+			{UUID: "b", Name: "Reports", Slug: "reports", Tags: "team:billing", Schedule: "0 6 * * *"},
+			{UUID: "c", Name: "Other", Slug: "other"},
+		},
+	}
+	dst := &memoryClient{
+		checks: []Check{{UUID: "existing", Slug: "reports"}},
+	}
+	filter := GetChecks{Tags: []string{"team:billing"}}
+
+	plan, err := TransferChecks(ctx, src, dst, filter, TransferOptions{DryRun: true})
+	require.NoError(t, err)
+	require.Len(t, plan, 2)
+	require.Nil(t, plan[0].Created)
+	require.True(t, plan[1].Existed)
+	require.Empty(t, dst.calls)
+
+	transfers, err := TransferChecks(ctx, src, dst, filter, TransferOptions{
+		Channels:        "*",
+		DeleteOriginals: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, transfers, 2)
+	require.Equal(t, "backup", transfers[0].Created.Slug)
+	require.Equal(t, 3600, transfers[0].Created.Timeout)
+	require.Equal(t, "existing", transfers[1].Created.UUID)
+
+	require.Equal(t, []string{"create backup"}, dst.calls)
+	require.Equal(t, []string{"delete backup"}, src.calls)
+
+	_, err = TransferChecks(ctx, src, dst, filter, TransferOptions{PauseOriginals: true, DeleteOriginals: true})
+	require.Error(t, err)
+}
@@ -0,0 +1,140 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// UptimeReport is a check's availability over a period
+type UptimeReport struct {
+	Check Check     `json:"check"`
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+
+	// Uptime is the fraction of the period the check was up, from 0 to 1
+	Uptime   float64       `json:"uptime"`
+	Downtime time.Duration `json:"downtime"`
+
+	// Outages are the check's down periods, clipped to the report's period
+	Outages []Outage `json:"outages"`
+}
+
+// ComputeUptime measures check's availability from start to end from its flips. The state at
+// start comes from the last flip before it. Without one the check is taken to be in the
+// opposite state of its first flip, or its current state when it never flipped.
+func ComputeUptime(check Check, flips []Flip, start, end time.Time) (*UptimeReport, error) {
+	if !end.After(start) {
+		return nil, errors.New("compute uptime: end must be after start")
+	}
+
+	events := make([]TimelineEvent, 0, len(flips))
+	for _, flip := range flips {
+		at, err := flip.Time()
+		if err != nil {
+			return nil, fmt.Errorf("compute uptime: %w", err)
+		}
+		events = append(events, TimelineEvent{Check: check.Name, At: at, Up: flip.Up == 1})
+	}
+	slices.SortFunc(events, func(a, b TimelineEvent) int { return a.At.Compare(b.At) })
+
+	up := CheckStatus(check.Status) != StatusDown
+	if len(events) > 0 {
+		up = !events[0].Up
+	}
+
+	report := &UptimeReport{Check: check, Start: start, End: end, Outages: []Outage{}}
+	var downSince time.Time
+	if !up {
+		downSince = start
+	}
+	for _, event := range events {
+		if event.At.After(end) {
+			break
+		}
+		at := event.At
+		if at.Before(start) {
+			at = start
+		}
+		switch {
+		case up && !event.Up:
+			downSince = at
+		case !up && event.Up:
+			report.addOutage(downSince, at)
+		}
+		up = event.Up
+	}
+	if !up {
+		report.addOutage(downSince, time.Time{})
+	}
+
+	report.Uptime = 1 - report.Downtime.Seconds()/end.Sub(start).Seconds()
+	return report, nil
+}
+
+// addOutage records an outage, a zero end means it's still ongoing at the report's end
+func (r *UptimeReport) addOutage(start, end time.Time) {
+	until := end
+	if until.IsZero() {
+		until = r.End
+	}
+	if !until.After(start) {
+		return // down and back up before the period started
+	}
+	r.Downtime += until.Sub(start)
+	r.Outages = append(r.Outages, Outage{Start: start, End: end, Checks: []string{r.Check.Name}})
+}
+
+// Period is a span of time a check was up or down
+type Period struct {
+	Start time.Time
+	End   time.Time
+	Up    bool
+}
+
+// Periods returns the up and down periods covering the report from start to end, in order,
+// so series and charts start from the same state ComputeUptime measured
+func (r *UptimeReport) Periods() []Period {
+	var periods []Period
+	at := r.Start
+	for _, outage := range r.Outages {
+		if outage.Start.After(at) {
+			periods = append(periods, Period{Start: at, End: outage.Start, Up: true})
+		}
+		end := outage.End
+		if end.IsZero() {
+			end = r.End
+		}
+		periods = append(periods, Period{Start: outage.Start, End: end})
+		at = end
+	}
+	if r.End.After(at) {
+		periods = append(periods, Period{Start: at, End: r.End, Up: true})
+	}
+	return periods
+}
+
+// ReportUptime computes the availability of every check matching filter from start to end
+func ReportUptime(ctx context.Context, client CheckReader, filter GetChecks, start, end time.Time) ([]UptimeReport, error) {
+	list, err := client.GetChecks(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("report uptime: %w", err)
+	}
+
+	reports := make([]UptimeReport, 0, len(list.Checks))
+	for _, check := range list.Checks {
+		// Flips before start are needed for the state the period starts in
+		flips, err := client.GetFlips(ctx, check.UUID, GetFlipsRequest{End: end.Unix()})
+		if err != nil {
+			return nil, fmt.Errorf("report uptime: %s: %w", check.Name, err)
+		}
+		report, err := ComputeUptime(check, flips.Flips, start, end)
+		if err != nil {
+			return nil, fmt.Errorf("report uptime: %s: %w", check.Name, err)
+		}
+		reports = append(reports, *report)
+	}
+	return reports, nil
+}
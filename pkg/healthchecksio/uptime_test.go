@@ -0,0 +1,76 @@
+package healthchecksio
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeUptime(t *testing.T) {
+	start := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(10 * time.Hour)
+	check := Check{Name: "backup", Status: "up"}
+
+	report, err := ComputeUptime(check, []Flip{
+		{Timestamp: "2025-02-28T12:00:00Z", Up: 0}, // down before the period
+		{Timestamp: "2025-03-01T01:00:00Z", Up: 1},
+		{Timestamp: "2025-03-01T05:00:00Z", Up: 0},
+		{Timestamp: "2025-03-01T06:00:00Z", Up: 1},
+		{Timestamp: "2025-03-01T12:00:00Z", Up: 0}, // after the period
+	}, start, end)
+	require.NoError(t, err)
+
+	require.Equal(t, 2*time.Hour, report.Downtime)
+	require.InDelta(t, 0.8, report.Uptime, 0.0001)
+	require.Equal(t, []Outage{
+		{Start: start, End: start.Add(time.Hour), Checks: []string{"backup"}},
+		{Start: start.Add(5 * time.Hour), End: start.Add(6 * time.Hour), Checks: []string{"backup"}},
+	}, report.Outages)
+	require.Equal(t, []Period{
+		{Start: start, End: start.Add(time.Hour)},
+		{Start: start.Add(time.Hour), End: start.Add(5 * time.Hour), Up: true},
+		{Start: start.Add(5 * time.Hour), End: start.Add(6 * time.Hour)},
+		{Start: start.Add(6 * time.Hour), End: end, Up: true},
+	}, report.Periods())
+
+	// Ongoing outages have no end
+	report, err = ComputeUptime(check, []Flip{
+		{Timestamp: "2025-03-01T08:00:00Z", Up: 0},
+	}, start, end)
+	require.NoError(t, err)
+	require.Equal(t, 2*time.Hour, report.Downtime)
+	require.True(t, report.Outages[0].End.IsZero())
+	require.Equal(t, []Period{
+		{Start: start, End: start.Add(8 * time.Hour), Up: true},
+		{Start: start.Add(8 * time.Hour), End: end},
+	}, report.Periods())
+
+	// Without flips the current status is used
+	report, err = ComputeUptime(Check{Status: "down"}, nil, start, end)
+	require.NoError(t, err)
+	require.Zero(t, report.Uptime)
+
+	_, err = ComputeUptime(check, nil, end, start)
+	require.Error(t, err)
+}
+
+func TestReportUptime(t *testing.T) {
+	end := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+	client := &memoryClient{
+		checks: []Check{
+			{UUID: "1", Name: "backup", Tags: "db", Status: "up"},
+			{UUID: "2", Name: "web", Tags: "www", Status: "up"},
+		},
+		flips: map[string][]Flip{
+			"1": {{Timestamp: "2025-03-01T11:00:00Z", Up: 0}, {Timestamp: "2025-03-01T11:30:00Z", Up: 1}},
+		},
+	}
+
+	reports, err := ReportUptime(context.Background(), client, GetChecks{Tags: []string{"db"}}, end.Add(-2*time.Hour), end)
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	require.Equal(t, "1", reports[0].Check.UUID)
+	require.Equal(t, 0.75, reports[0].Uptime)
+}
@@ -0,0 +1,50 @@
+package healthchecksio
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// WaitOptions configures WaitForStatus
+type WaitOptions struct {
+	// Interval is the wait before the first re-check, doubling after each one. Defaults to 2 seconds.
+	Interval time.Duration
+
+	// MaxInterval caps the wait between checks, defaults to 30 seconds
+	MaxInterval time.Duration
+}
+
+// WaitForStatus polls the check until its status is want, e.g. StatusUp once a newly created
+// check receives its first ping, and returns the check. Bound the wait with ctx's deadline,
+// the error then says which status the check was left in. Responses are never served from
+// the client's cache and API errors are returned immediately, the client already retries them.
+func WaitForStatus(ctx context.Context, client CheckReader, identifier string, want CheckStatus, opts WaitOptions) (*Check, error) {
+	if opts.Interval <= 0 {
+		opts.Interval = 2 * time.Second
+	}
+	if opts.MaxInterval <= 0 {
+		opts.MaxInterval = 30 * time.Second
+	}
+
+	var last string
+	wait := opts.Interval
+	for {
+		check, err := client.GetCheck(ctx, identifier, WithoutCache())
+		if err != nil {
+			if last != "" && ctx.Err() != nil {
+				return nil, fmt.Errorf("wait for status: check %s is %s, not %s: %w", identifier, last, want, ctx.Err())
+			}
+			return nil, fmt.Errorf("wait for status: %w", err)
+		}
+		if CheckStatus(check.Status) == want {
+			return check, nil
+		}
+		last = check.Status
+
+		if err := sleepUntil(ctx, time.Now().Add(wait)); err != nil {
+			return nil, fmt.Errorf("wait for status: check %s is %s, not %s: %w", identifier, last, want, err)
+		}
+		wait = min(2*wait, opts.MaxInterval)
+	}
+}
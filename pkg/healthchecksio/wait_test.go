@@ -0,0 +1,72 @@
+package healthchecksio_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitForStatus(t *testing.T) {
+	var polls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/checks/abc", r.URL.Path)
+		if polls.Add(1) < 3 {
+			w.Write([]byte(`{"uuid":"abc","status":"new"}`))
+			return
+		}
+		w.Write([]byte(`{"uuid":"abc","status":"up"}`))
+	}))
+	defer srv.Close()
+
+	// A cache doesn't hide the status changing
+	client := healthchecksio.NewClient("key",
+		healthchecksio.WithBaseURL(srv.URL),
+		healthchecksio.WithCache(healthchecksio.NewMemoryCache(), time.Hour),
+	)
+	check, err := healthchecksio.WaitForStatus(context.Background(), client, "abc", healthchecksio.StatusUp, healthchecksio.WaitOptions{
+		Interval: 5 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "up", check.Status)
+	require.Equal(t, int32(3), polls.Load())
+}
+
+func TestWaitForStatusTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"uuid":"abc","status":"new"}`))
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	client := healthchecksio.NewClient("key", healthchecksio.WithBaseURL(srv.URL))
+	_, err := healthchecksio.WaitForStatus(ctx, client, "abc", healthchecksio.StatusUp, healthchecksio.WaitOptions{
+		Interval:    5 * time.Millisecond,
+		MaxInterval: 10 * time.Millisecond,
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.ErrorContains(t, err, "wait for status: check abc is new, not up")
+}
+
+func TestWaitForStatusError(t *testing.T) {
+	var polls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"not found"}`))
+	}))
+	defer srv.Close()
+
+	client := healthchecksio.NewClient("key", healthchecksio.WithBaseURL(srv.URL))
+	_, err := healthchecksio.WaitForStatus(context.Background(), client, "abc", healthchecksio.StatusUp, healthchecksio.WaitOptions{})
+	require.ErrorContains(t, err, "wait for status: get check failed with 404")
+	require.Equal(t, int32(1), polls.Load())
+}
@@ -0,0 +1,366 @@
+package healthchecksio
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// StatusChange is emitted by a Watcher when a check's status differs from the previous poll.
+// From is empty the first time a check is seen.
+type StatusChange struct {
+	Check Check
+	From  CheckStatus
+	To    CheckStatus
+	At    time.Time
+
+	// LastSeen is when the check was last seen as From, the change happened since then
+	LastSeen time.Time
+
+	// Offline is set for changes which happened while the Watcher wasn't running,
+	// found by comparing with the state in WatcherOptions.Store
+	Offline bool
+}
+
+// WatcherOptions configures a Watcher
+type WatcherOptions struct {
+	// Interval between polls of GetChecks, defaults to one minute
+	Interval time.Duration
+
+	// Filter limits which checks are watched
+	Filter GetChecks
+
+	// Debounce is how long a check must stay down before OnDown callbacks are invoked.
+	// Zero invokes them on the first poll which observes the check as down.
+	Debounce time.Duration
+
+	// Store keeps the last seen statuses across restarts, so checks aren't reported as new
+	// and alerts aren't repeated. It's loaded on the first poll and saved after every poll.
+	Store WatcherStore
+}
+
+// Watcher polls checks and notifies registered callbacks about status transitions.
+// Callbacks are invoked synchronously from the polling goroutine.
+type Watcher struct {
+	client CheckReader
+	opts   WatcherOptions
+	now    func() time.Time
+
+	mu          sync.Mutex
+	states      map[string]*watchState
+	loaded      bool
+	onChange    []func(StatusChange)
+	onPoll      []func([]Check, time.Time)
+	onDown      []func(Check)
+	onRecovered []func(Check)
+	onError     []func(error)
+}
+
+type watchState struct {
+	status    CheckStatus
+	seen      time.Time
+	downSince time.Time
+	alerted   bool
+
+	// restored is set until a state loaded from the store has been compared with a poll
+	restored bool
+}
+
+// NewWatcher creates a Watcher for checks matching opts.Filter
+func NewWatcher(client CheckReader, opts WatcherOptions) *Watcher {
+	if opts.Interval <= 0 {
+		opts.Interval = time.Minute
+	}
+	return &Watcher{
+		client: client,
+		opts:   opts,
+		now:    time.Now,
+		states: make(map[string]*watchState),
+	}
+}
+
+// OnChange registers fn to be called for every observed status change
+func (w *Watcher) OnChange(fn func(StatusChange)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.onChange = append(w.onChange, fn)
+}
+
+// OnPoll registers fn to be called with every watched check after each successful poll,
+// following the OnChange calls. Unlike OnChange it also sees checks whose status a restored
+// Store already knew, and tells which checks are gone.
+func (w *Watcher) OnPoll(fn func(checks []Check, at time.Time)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.onPoll = append(w.onPoll, fn)
+}
+
+// OnDown registers fn to be called once a check has been down for the Debounce period
+func (w *Watcher) OnDown(fn func(Check)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.onDown = append(w.onDown, fn)
+}
+
+// OnRecovered registers fn to be called when a check OnDown was called for is up again
+func (w *Watcher) OnRecovered(fn func(Check)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.onRecovered = append(w.onRecovered, fn)
+}
+
+// OnError registers fn to be called when polling fails
+func (w *Watcher) OnError(fn func(error)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.onError = append(w.onError, fn)
+}
+
+// Run polls until ctx is cancelled
+func (w *Watcher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(w.opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := w.Poll(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			w.mu.Lock()
+			handlers := w.onError
+			w.mu.Unlock()
+
+			for _, fn := range handlers {
+				fn(err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll fetches checks once, invokes callbacks and returns the observed changes
+func (w *Watcher) Poll(ctx context.Context) ([]StatusChange, error) {
+	if err := w.restore(ctx); err != nil {
+		return nil, err
+	}
+	list, err := w.client.GetChecks(ctx, w.opts.Filter)
+	if err != nil {
+		return nil, err
+	}
+	now := w.now()
+
+	w.mu.Lock()
+	var changes []StatusChange
+	var down, recovered []Check
+
+	seen := make(map[string]bool, len(list.Checks))
+	for _, check := range list.Checks {
+		seen[check.UUID] = true
+		status := CheckStatus(check.Status)
+
+		state, exists := w.states[check.UUID]
+		if !exists {
+			state = &watchState{}
+			w.states[check.UUID] = state
+		}
+		if !exists || state.status != status {
+			changes = append(changes, StatusChange{
+				Check:    check,
+				From:     state.status,
+				To:       status,
+				At:       now,
+				LastSeen: state.seen,
+				Offline:  state.restored,
+			})
+		}
+		state.status, state.seen, state.restored = status, now, false
+
+		switch status {
+		case StatusDown:
+			if state.downSince.IsZero() {
+				state.downSince = now
+			}
+			if !state.alerted && now.Sub(state.downSince) >= w.opts.Debounce {
+				state.alerted = true
+				down = append(down, check)
+			}
+		case StatusUp:
+			state.downSince = time.Time{}
+			if state.alerted {
+				state.alerted = false
+				recovered = append(recovered, check)
+			}
+		default:
+			state.downSince = time.Time{}
+		}
+	}
+	for uuid := range w.states {
+		if !seen[uuid] {
+			delete(w.states, uuid)
+		}
+	}
+
+	onChange, onPoll, onDown, onRecovered := w.onChange, w.onPoll, w.onDown, w.onRecovered
+	var saved *WatcherState
+	if w.opts.Store != nil {
+		saved = w.stateLocked(now)
+	}
+	w.mu.Unlock()
+
+	for _, change := range changes {
+		for _, fn := range onChange {
+			fn(change)
+		}
+	}
+	for _, fn := range onPoll {
+		fn(list.Checks, now)
+	}
+	for _, check := range down {
+		for _, fn := range onDown {
+			fn(check)
+		}
+	}
+	for _, check := range recovered {
+		for _, fn := range onRecovered {
+			fn(check)
+		}
+	}
+
+	// Saved once callbacks ran, so a crash repeats events rather than losing them
+	if saved != nil {
+		if err := w.opts.Store.Save(ctx, saved); err != nil {
+			return changes, fmt.Errorf("save watcher state: %w", err)
+		}
+	}
+	return changes, nil
+}
+
+// restore loads the state saved by a previous run of the Watcher, once
+func (w *Watcher) restore(ctx context.Context) error {
+	w.mu.Lock()
+	loaded := w.loaded || w.opts.Store == nil
+	w.mu.Unlock()
+	if loaded {
+		return nil
+	}
+
+	saved, err := w.opts.Store.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("load watcher state: %w", err)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.loaded {
+		return nil
+	}
+	w.loaded = true
+	if saved == nil {
+		return nil
+	}
+	for uuid, check := range saved.Checks {
+		w.states[uuid] = &watchState{
+			status:    check.Status,
+			seen:      check.LastSeen,
+			downSince: check.DownSince,
+			alerted:   check.Alerted,
+			restored:  true,
+		}
+	}
+	return nil
+}
+
+func (w *Watcher) stateLocked(now time.Time) *WatcherState {
+	state := &WatcherState{
+		Saved:  now,
+		Checks: make(map[string]WatchedCheck, len(w.states)),
+	}
+	for uuid, s := range w.states {
+		state.Checks[uuid] = WatchedCheck{
+			Status:    s.status,
+			LastSeen:  s.seen,
+			DownSince: s.downSince,
+			Alerted:   s.alerted,
+		}
+	}
+	return state
+}
+
+// WatcherStore persists a Watcher's state between runs
+type WatcherStore interface {
+	// Load returns the saved state, nil when nothing was saved yet
+	Load(ctx context.Context) (*WatcherState, error)
+
+	// Save replaces the saved state
+	Save(ctx context.Context, state *WatcherState) error
+}
+
+// WatcherState is what a Watcher knows about the checks it watches
+type WatcherState struct {
+	Saved  time.Time               `json:"saved"`
+	Checks map[string]WatchedCheck `json:"checks"`
+}
+
+// WatchedCheck is the last seen state of one check, keyed by its UUID in WatcherState
+type WatchedCheck struct {
+	Status   CheckStatus `json:"status"`
+	LastSeen time.Time   `json:"last_seen"`
+
+	// DownSince is when the check was first seen down, zero unless it's down
+	DownSince time.Time `json:"down_since,omitzero"`
+
+	// Alerted is set once OnDown was called, until OnRecovered is
+	Alerted bool `json:"alerted,omitempty"`
+}
+
+// FileWatcherStore is a WatcherStore keeping the state in a JSON file
+type FileWatcherStore struct {
+	path string
+}
+
+// NewFileWatcherStore creates a FileWatcherStore saving to path, its directory is created on the first save
+func NewFileWatcherStore(path string) *FileWatcherStore {
+	return &FileWatcherStore{path: path}
+}
+
+// Load reads the state file, returning nil when it doesn't exist yet
+func (s *FileWatcherStore) Load(ctx context.Context) (*WatcherState, error) {
+	bs, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var state WatcherState
+	if err := json.Unmarshal(bs, &state); err != nil {
+		return nil, fmt.Errorf("%s: %w", s.path, err)
+	}
+	return &state, nil
+}
+
+// Save writes the state to a temp file renamed over the state file
+func (s *FileWatcherStore) Save(ctx context.Context, state *WatcherState) error {
+	bs, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	return replaceFile(filepath.Dir(s.path), filepath.Base(s.path), bytes.NewReader(bs))
+}
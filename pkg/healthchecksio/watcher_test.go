@@ -0,0 +1,183 @@
+package healthchecksio
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type mockChecksClient struct {
+	Client
+
+	checks []Check
+	err    error
+}
+
+func (m *mockChecksClient) GetChecks(ctx context.Context, params GetChecks, opts ...CallOption) (*CheckListResponse, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &CheckListResponse{Checks: m.checks}, nil
+}
+
+func TestWatcher_DownAndRecovered(t *testing.T) {
+	ctx := context.Background()
+	mock := &mockChecksClient{}
+
+	w := NewWatcher(mock, WatcherOptions{
+		Debounce: 2 * time.Minute,
+	})
+	now := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+	w.now = func() time.Time { return now }
+
+	var changes []StatusChange
+	var down, recovered []string
+	w.OnChange(func(c StatusChange) { changes = append(changes, c) })
+	w.OnDown(func(c Check) { down = append(down, c.UUID) })
+	w.OnRecovered(func(c Check) { recovered = append(recovered, c.UUID) })
+
+	poll := func(status string) {
+		t.Helper()
+
+		mock.checks = []Check{{UUID: "a", Status: status}}
+		_, err := w.Poll(ctx)
+		require.NoError(t, err)
+		now = now.Add(time.Minute)
+	}
+
+	poll("up")
+	require.Len(t, changes, 1)
+	require.Equal(t, CheckStatus(""), changes[0].From)
+
+	poll("down")
+	poll("down") // still within debounce
+	require.Empty(t, down)
+
+	poll("down")
+	require.Equal(t, []string{"a"}, down)
+
+	poll("down") // only alert once
+	require.Len(t, down, 1)
+
+	poll("up")
+	require.Equal(t, []string{"a"}, recovered)
+	require.Len(t, changes, 3) // up, down, up
+}
+
+func TestWatcher_FlappingDebounced(t *testing.T) {
+	ctx := context.Background()
+	mock := &mockChecksClient{}
+
+	w := NewWatcher(mock, WatcherOptions{
+		Debounce: 5 * time.Minute,
+	})
+	now := time.Now()
+	w.now = func() time.Time { return now }
+
+	var down, recovered int
+	w.OnDown(func(Check) { down++ })
+	w.OnRecovered(func(Check) { recovered++ })
+
+	for _, status := range []string{"down", "up", "down", "up", "down", "up"} {
+		mock.checks = []Check{{UUID: "a", Status: status}}
+		_, err := w.Poll(ctx)
+		require.NoError(t, err)
+		now = now.Add(time.Minute)
+	}
+	require.Zero(t, down)
+	require.Zero(t, recovered)
+}
+
+type failingWatcherStore struct{}
+
+func (failingWatcherStore) Load(ctx context.Context) (*WatcherState, error) {
+	return nil, errors.New("unavailable")
+}
+
+func (failingWatcherStore) Save(ctx context.Context, state *WatcherState) error {
+	return nil
+}
+
+func TestWatcher_Store(t *testing.T) {
+	ctx := context.Background()
+	mock := &mockChecksClient{}
+	store := NewFileWatcherStore(filepath.Join(t.TempDir(), "state", "watcher.json"))
+	now := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	var changes []StatusChange
+	var down, recovered []string
+	start := func() *Watcher {
+		w := NewWatcher(mock, WatcherOptions{Store: store})
+		w.now = func() time.Time { return now }
+		w.OnChange(func(c StatusChange) { changes = append(changes, c) })
+		w.OnDown(func(c Check) { down = append(down, c.UUID) })
+		w.OnRecovered(func(c Check) { recovered = append(recovered, c.UUID) })
+		return w
+	}
+	poll := func(w *Watcher, checks ...Check) {
+		t.Helper()
+
+		changes, down, recovered = nil, nil, nil
+		mock.checks = checks
+		_, err := w.Poll(ctx)
+		require.NoError(t, err)
+	}
+
+	poll(start(), Check{UUID: "a", Status: "up"}, Check{UUID: "b", Status: "down"})
+	require.Len(t, changes, 2)
+	require.Equal(t, []string{"b"}, down)
+	firstPoll := now
+
+	// After a restart unchanged checks aren't reported again, nor alerted on
+	now = now.Add(time.Hour)
+	poll(start(), Check{UUID: "a", Status: "up"}, Check{UUID: "b", Status: "down"}, Check{UUID: "c", Status: "new"})
+	require.Len(t, changes, 1)
+	require.Equal(t, "c", changes[0].Check.UUID)
+	require.False(t, changes[0].Offline)
+	require.Empty(t, down)
+
+	// Changes while stopped are reported as offline
+	now = now.Add(time.Hour)
+	w := start()
+	poll(w, Check{UUID: "a", Status: "down"}, Check{UUID: "b", Status: "up"}, Check{UUID: "c", Status: "new"})
+	require.Len(t, changes, 2)
+	require.Equal(t, StatusChange{
+		Check:    Check{UUID: "a", Status: "down"},
+		From:     StatusUp,
+		To:       StatusDown,
+		At:       now,
+		LastSeen: firstPoll.Add(time.Hour),
+		Offline:  true,
+	}, changes[0])
+	require.True(t, changes[1].Offline)
+	require.Equal(t, []string{"a"}, down)
+	require.Equal(t, []string{"b"}, recovered)
+
+	// Later polls by the same Watcher aren't offline
+	now = now.Add(time.Minute)
+	poll(w, Check{UUID: "a", Status: "up"})
+	require.Len(t, changes, 1)
+	require.False(t, changes[0].Offline)
+
+	saved, err := store.Load(ctx)
+	require.NoError(t, err)
+	require.Equal(t, now, saved.Saved)
+	require.Equal(t, map[string]WatchedCheck{"a": {Status: StatusUp, LastSeen: now}}, saved.Checks)
+}
+
+func TestWatcher_StoreLoadFails(t *testing.T) {
+	mock := &mockChecksClient{checks: []Check{{UUID: "a", Status: "up"}}}
+	w := NewWatcher(mock, WatcherOptions{Store: failingWatcherStore{}})
+
+	var changes int
+	w.OnChange(func(StatusChange) { changes++ })
+
+	// Nothing is reported until the previous state is known
+	_, err := w.Poll(context.Background())
+	require.ErrorContains(t, err, "load watcher state: unavailable")
+	require.Zero(t, changes)
+}
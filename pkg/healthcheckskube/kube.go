@@ -0,0 +1,197 @@
+// Package healthcheckskube keeps a check for every Kubernetes CronJob. It has no Kubernetes
+// client of its own: callers implement Lister (or wrap a function in ListerFunc) on top of the
+// client they already use, and apply the returned patches with it.
+package healthcheckskube
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+)
+
+const (
+	// PingURLAnnotation is set on CronJobs to the ping URL of their check
+	PingURLAnnotation = "healthchecks.io/ping-url"
+
+	// PingURLEnv is the environment variable containers read their ping URL from
+	PingURLEnv = "HEALTHCHECKS_PING_URL"
+)
+
+// CronJob is the part of a batch/v1 CronJob needed to monitor it
+type CronJob struct {
+	Namespace string
+	Name      string
+	Schedule  string
+
+	// TimeZone is spec.timeZone, empty for the controller's timezone
+	TimeZone string
+
+	Suspend bool
+
+	// Containers are the names of the job template's containers
+	Containers []string
+}
+
+// Lister lists CronJobs in namespace (every namespace when empty) matching labelSelector
+type Lister interface {
+	ListCronJobs(ctx context.Context, namespace, labelSelector string) ([]CronJob, error)
+}
+
+// ListerFunc adapts a function, e.g. one calling client-go's BatchV1().CronJobs(namespace).List, to Lister
+type ListerFunc func(ctx context.Context, namespace, labelSelector string) ([]CronJob, error)
+
+// ListCronJobs calls f
+func (f ListerFunc) ListCronJobs(ctx context.Context, namespace, labelSelector string) ([]CronJob, error) {
+	return f(ctx, namespace, labelSelector)
+}
+
+// Options configures Provision
+type Options struct {
+	// Namespace limits discovery to one namespace, empty for every namespace
+	Namespace string
+
+	// LabelSelector limits discovery to matching CronJobs, e.g. "team=billing"
+	LabelSelector string
+
+	// Tags are set on every check and identify the managed set, e.g. "k8s cluster:prod"
+	Tags string
+
+	// Timezone is used for CronJobs without spec.timeZone, i.e. the kube-controller-manager's timezone
+	Timezone string
+
+	// Grace is the grace time in seconds of every check, the API defaults to an hour
+	Grace int
+
+	// IncludeSuspended also creates checks for suspended CronJobs
+	IncludeSuspended bool
+
+	// Prune deletes managed checks whose CronJob no longer exists
+	Prune bool
+
+	// DryRun plans changes without applying them, Provisioned.Check is only set for existing checks
+	DryRun bool
+}
+
+// Provisioned is a CronJob and its check
+type Provisioned struct {
+	CronJob CronJob
+	Check   *healthchecksio.Check
+
+	// Patch is a strategic merge patch which sets the PingURLAnnotation and the
+	// PingURLEnv variable of every container, for `kubectl patch cronjob --type strategic`
+	Patch []byte
+}
+
+// Slug returns the slug of job's check, "<namespace>-<name>"
+func Slug(job CronJob) string {
+	return job.Namespace + "-" + job.Name
+}
+
+// Checks returns the check for each CronJob
+func Checks(jobs []CronJob, opts Options) []healthchecksio.CreateCheck {
+	out := make([]healthchecksio.CreateCheck, 0, len(jobs))
+	for _, job := range jobs {
+		if job.Suspend && !opts.IncludeSuspended {
+			continue
+		}
+		tz := job.TimeZone
+		if tz == "" {
+			tz = opts.Timezone
+		}
+		out = append(out, healthchecksio.CreateCheck{
+			Name:        job.Namespace + "/" + job.Name,
+			Slug:        Slug(job),
+			Tags:        opts.Tags,
+			Description: fmt.Sprintf("Kubernetes CronJob %s in namespace %s", job.Name, job.Namespace),
+			Schedule:    job.Schedule,
+			Timezone:    tz,
+			Grace:       opts.Grace,
+		})
+	}
+	return out
+}
+
+// Provision creates or updates a check for every discovered CronJob and returns the patch
+// each CronJob needs to ping it. Checks are matched by slug within opts.Tags.
+func Provision(ctx context.Context, lister Lister, client healthchecksio.ManagementClient, opts Options) ([]Provisioned, error) {
+	if opts.Tags == "" {
+		return nil, errors.New("provision cronjobs: Tags is required to identify managed checks")
+	}
+
+	jobs, err := lister.ListCronJobs(ctx, opts.Namespace, opts.LabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("provision cronjobs: listing: %w", err)
+	}
+
+	filter := healthchecksio.GetChecks{Tags: strings.Fields(opts.Tags)}
+	_, err = healthchecksio.Sync(ctx, client, Checks(jobs, opts), healthchecksio.SyncOptions{
+		Filter: filter,
+		Prune:  opts.Prune,
+		DryRun: opts.DryRun,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("provision cronjobs: %w", err)
+	}
+
+	checks, err := client.GetChecks(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("provision cronjobs: %w", err)
+	}
+	bySlug := make(map[string]*healthchecksio.Check, len(checks.Checks))
+	for i := range checks.Checks {
+		bySlug[checks.Checks[i].Slug] = &checks.Checks[i]
+	}
+
+	var out []Provisioned
+	for _, job := range jobs {
+		check, exists := bySlug[Slug(job)]
+		if !exists {
+			if !opts.DryRun && (!job.Suspend || opts.IncludeSuspended) {
+				return out, fmt.Errorf("provision cronjobs: check %s missing after sync", Slug(job))
+			}
+			continue
+		}
+		patch, err := PingPatch(job, check.PingURL)
+		if err != nil {
+			return out, fmt.Errorf("provision cronjobs: %w", err)
+		}
+		out = append(out, Provisioned{CronJob: job, Check: check, Patch: patch})
+	}
+	return out, nil
+}
+
+// PingPatch returns the strategic merge patch giving job's containers pingURL
+func PingPatch(job CronJob, pingURL string) ([]byte, error) {
+	type env struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	}
+	type container struct {
+		Name string `json:"name"`
+		Env  []env  `json:"env"`
+	}
+	containers := make([]container, len(job.Containers))
+	for i, name := range job.Containers {
+		containers[i] = container{Name: name, Env: []env{{Name: PingURLEnv, Value: pingURL}}}
+	}
+
+	patch := map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{PingURLAnnotation: pingURL},
+		},
+		"spec": map[string]any{
+			"jobTemplate": map[string]any{
+				"spec": map[string]any{
+					"template": map[string]any{
+						"spec": map[string]any{"containers": containers},
+					},
+				},
+			},
+		},
+	}
+	return json.Marshal(patch)
+}
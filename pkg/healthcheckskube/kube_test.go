@@ -0,0 +1,130 @@
+package healthcheckskube_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthcheckskube"
+
+	"github.com/stretchr/testify/require"
+)
+
+type staticLister []healthcheckskube.CronJob
+
+func (l staticLister) ListCronJobs(ctx context.Context, namespace, labelSelector string) ([]healthcheckskube.CronJob, error) {
+	var out []healthcheckskube.CronJob
+	for _, job := range l {
+		if namespace == "" || job.Namespace == namespace {
+			out = append(out, job)
+		}
+	}
+	return out, nil
+}
+
+// fakeChecks stores checks created through the management API
+type fakeChecks struct {
+	healthchecksio.ManagementClient
+
+	checks []healthchecksio.Check
+}
+
+func (f *fakeChecks) GetChecks(ctx context.Context, params healthchecksio.GetChecks, opts ...healthchecksio.CallOption) (*healthchecksio.CheckListResponse, error) {
+	out := &healthchecksio.CheckListResponse{}
+	for _, check := range f.checks {
+		tags := strings.Fields(check.Tags)
+		if slices.ContainsFunc(params.Tags, func(tag string) bool { return !slices.Contains(tags, tag) }) {
+			continue
+		}
+		out.Checks = append(out.Checks, check)
+	}
+	return out, nil
+}
+
+func (f *fakeChecks) CreateCheck(ctx context.Context, create *healthchecksio.CreateCheck, opts ...healthchecksio.CallOption) (*healthchecksio.Check, error) {
+	check := healthchecksio.Check{
+		UUID:     fmt.Sprintf("uuid-%d", len(f.checks)+1),
+		Name:     create.Name,
+		Slug:     create.Slug,
+		Tags:     create.Tags,
+		Desc:     create.Description,
+		Schedule: create.Schedule,
+		Timezone: create.Timezone,
+	}
+	check.PingURL = "https://hc-ping.com/" + check.UUID
+	f.checks = append(f.checks, check)
+	return &check, nil
+}
+
+func TestProvision(t *testing.T) {
+	ctx := context.Background()
+	lister := staticLister{
+		{Namespace: "billing", Name: "invoices", Schedule: "0 2 * * *", TimeZone: "America/Chicago", Containers: []string{"worker"}},
+		{Namespace: "billing", Name: "reports", Schedule: "*/30 * * * *", Containers: []string{"report", "sidecar"}},
+		{Namespace: "billing", Name: "old", Schedule: "@daily", Suspend: true},
+		{Namespace: "search", Name: "reindex", Schedule: "0 * * * *"},
+	}
+	client := &fakeChecks{}
+
+	provisioned, err := healthcheckskube.Provision(ctx, lister, client, healthcheckskube.Options{
+		Namespace: "billing",
+		Tags:      "k8s cluster:prod",
+		Timezone:  "UTC",
+	})
+	require.NoError(t, err)
+	require.Len(t, provisioned, 2)
+	require.Len(t, client.checks, 2)
+
+	invoices := provisioned[0]
+	require.Equal(t, "billing-invoices", invoices.Check.Slug)
+	require.Equal(t, "0 2 * * *", invoices.Check.Schedule)
+	require.Equal(t, "America/Chicago", invoices.Check.Timezone)
+	require.Equal(t, "UTC", provisioned[1].Check.Timezone)
+	require.JSONEq(t, `{
+		"metadata": {"annotations": {"healthchecks.io/ping-url": "https://hc-ping.com/uuid-1"}},
+		"spec": {"jobTemplate": {"spec": {"template": {"spec": {"containers": [
+			{"name": "worker", "env": [{"name": "HEALTHCHECKS_PING_URL", "value": "https://hc-ping.com/uuid-1"}]}
+		]}}}}}
+	}`, string(invoices.Patch))
+
+	// Provisioning again reuses the checks
+	again, err := healthcheckskube.Provision(ctx, lister, client, healthcheckskube.Options{
+		Namespace: "billing",
+		Tags:      "k8s cluster:prod",
+		Timezone:  "UTC",
+	})
+	require.NoError(t, err)
+	require.Len(t, again, 2)
+	require.Len(t, client.checks, 2)
+
+	_, err = healthcheckskube.Provision(ctx, lister, client, healthcheckskube.Options{})
+	require.Error(t, err)
+}
+
+func ExampleListerFunc() {
+	// A clientset's BatchV1().CronJobs(namespace).List goes here, converting each item into a
+	// CronJob as the README shows. This one serves a fixed list so the example runs.
+	cluster := map[string][]healthcheckskube.CronJob{
+		"billing": {{Namespace: "billing", Name: "invoices", Schedule: "0 2 * * *", Containers: []string{"worker"}}},
+	}
+	lister := healthcheckskube.ListerFunc(func(ctx context.Context, namespace, labelSelector string) ([]healthcheckskube.CronJob, error) {
+		return cluster[namespace], nil
+	})
+
+	provisioned, err := healthcheckskube.Provision(context.Background(), lister, &fakeChecks{}, healthcheckskube.Options{
+		Namespace: "billing",
+		Tags:      "k8s cluster:prod",
+		Timezone:  "UTC",
+	})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, p := range provisioned {
+		fmt.Println(p.Check.Slug, p.Check.PingURL)
+	}
+	// Output: billing-invoices https://hc-ping.com/uuid-1
+}
@@ -0,0 +1,82 @@
+package healthchecksnagios
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Icinga2 submits passive results through the Icinga 2 API's process-check-result action
+type Icinga2 struct {
+	// URL is the API address, e.g. https://icinga:5665
+	URL string
+
+	// User and Password of an ApiUser allowed "actions/process-check-result"
+	User, Password string
+
+	// Client defaults to http.DefaultClient. Icinga's API usually has a self-signed
+	// certificate, see InsecureClient.
+	Client *http.Client
+}
+
+// InsecureClient skips certificate verification, for Icinga APIs with their default
+// self-signed certificate
+func InsecureClient() *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+	}
+}
+
+type processCheckResult struct {
+	Type         string `json:"type"`
+	Filter       string `json:"filter"`
+	ExitStatus   State  `json:"exit_status"`
+	PluginOutput string `json:"plugin_output"`
+	Performance  string `json:"performance_data,omitempty"`
+}
+
+// Submit sends each result, stopping at the first failure
+func (i *Icinga2) Submit(ctx context.Context, results []Result) error {
+	client := i.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	for _, r := range results {
+		output, perf, _ := strings.Cut(r.Output, " | ")
+		payload, err := json.Marshal(processCheckResult{
+			Type:         "Service",
+			Filter:       fmt.Sprintf("host.name==%q && service.name==%q", r.Host, r.Service),
+			ExitStatus:   r.State,
+			PluginOutput: output,
+			Performance:  perf,
+		})
+		if err != nil {
+			return err
+		}
+
+		address := strings.TrimSuffix(i.URL, "/") + "/v1/actions/process-check-result"
+		req, err := http.NewRequestWithContext(ctx, "POST", address, bytes.NewReader(payload))
+		if err != nil {
+			return err
+		}
+		req.SetBasicAuth(i.User, i.Password)
+		req.Header.Set("Accept", "application/json")
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return fmt.Errorf("icinga2 %s/%s: %w", r.Host, r.Service, err)
+		}
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		resp.Body.Close()
+		if resp.StatusCode/100 != 2 {
+			return fmt.Errorf("icinga2 %s/%s failed with %d: %s", r.Host, r.Service, resp.StatusCode, bytes.TrimSpace(msg))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+// Package healthchecksnagios maps check statuses to Nagios plugin states, so Healthchecks
+// state can be shown in a Nagios or Icinga console, either by running the CLI's nagios
+// command as a plugin or by submitting passive check results.
+package healthchecksnagios
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+)
+
+// State is a Nagios plugin state, which plugins also use as their exit code
+type State int
+
+const (
+	OK State = iota
+	Warning
+	Critical
+	Unknown
+)
+
+func (s State) String() string {
+	switch s {
+	case OK:
+		return "OK"
+	case Warning:
+		return "WARNING"
+	case Critical:
+		return "CRITICAL"
+	}
+	return "UNKNOWN"
+}
+
+// StateOf maps a check's status to a State. Checks in their grace period are warnings,
+// new and paused checks are unknown since nothing is monitoring them.
+func StateOf(check healthchecksio.Check) State {
+	switch healthchecksio.CheckStatus(check.Status) {
+	case healthchecksio.StatusUp, healthchecksio.StatusStarted:
+		return OK
+	case healthchecksio.StatusGrace:
+		return Warning
+	case healthchecksio.StatusDown:
+		return Critical
+	}
+	return Unknown
+}
+
+// Output is the plugin output for check, a status line followed by performance data, e.g.
+// "OK - backup is up | last_ping_age=30s;;;0"
+func Output(check healthchecksio.Check, now time.Time) string {
+	name := check.Name
+	if name == "" {
+		name = check.UUID
+	}
+	out := fmt.Sprintf("%s - %s is %s", StateOf(check), name, check.Status)
+	if last, pinged := check.LastPingTime(); pinged {
+		age := max(now.Sub(last).Truncate(time.Second), 0)
+		out += fmt.Sprintf(", last ping %s ago | last_ping_age=%ds;;;0", age, int(age.Seconds()))
+	}
+	return out
+}
+
+// Result is a passive check result for one check
+type Result struct {
+	Host    string
+	Service string
+	State   State
+	Output  string
+}
+
+// Results converts checks into passive results for host. Services are named by the check's
+// slug, falling back to its name.
+func Results(host string, checks []healthchecksio.Check, now time.Time) []Result {
+	out := make([]Result, 0, len(checks))
+	for _, check := range checks {
+		service := check.Slug
+		if service == "" {
+			service = check.Name
+		}
+		out = append(out, Result{
+			Host:    host,
+			Service: service,
+			State:   StateOf(check),
+			Output:  Output(check, now),
+		})
+	}
+	return out
+}
+
+// commandEscaper strips the separators of the external command syntax
+var commandEscaper = strings.NewReplacer(";", ",", "\n", " ")
+
+// WriteCommands writes results as PROCESS_SERVICE_CHECK_RESULT external commands, which is
+// what Nagios, Naemon and Icinga read from their command file (nagios.cmd) or NSCA forwards.
+func WriteCommands(w io.Writer, results []Result, at time.Time) error {
+	var sb strings.Builder
+	for _, r := range results {
+		fmt.Fprintf(&sb, "[%d] PROCESS_SERVICE_CHECK_RESULT;%s;%s;%d;%s\n",
+			at.Unix(), commandEscaper.Replace(r.Host), commandEscaper.Replace(r.Service), r.State, strings.ReplaceAll(r.Output, "\n", `\n`))
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
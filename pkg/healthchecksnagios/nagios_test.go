@@ -0,0 +1,74 @@
+package healthchecksnagios
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+var now = time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+func TestStateOf(t *testing.T) {
+	cases := map[string]State{
+		"up": OK, "started": OK, "grace": Warning, "down": Critical, "new": Unknown, "paused": Unknown, "": Unknown,
+	}
+	for status, want := range cases {
+		require.Equal(t, want, StateOf(healthchecksio.Check{Status: status}), status)
+	}
+}
+
+func TestOutput(t *testing.T) {
+	check := healthchecksio.Check{Name: "backup", Status: "down", LastPing: "2025-03-01T11:58:30+00:00"}
+	require.Equal(t, "CRITICAL - backup is down, last ping 1m30s ago | last_ping_age=90s;;;0", Output(check, now))
+
+	require.Equal(t, "UNKNOWN - abc is new", Output(healthchecksio.Check{UUID: "abc", Status: "new"}, now))
+}
+
+func TestWriteCommands(t *testing.T) {
+	results := Results("web-1", []healthchecksio.Check{
+		{Name: "Nightly backup", Slug: "backup", Status: "up"},
+		{Name: "reports; weekly", Status: "grace"},
+	}, now)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteCommands(&buf, results, now))
+	require.Equal(t, `[1740830400] PROCESS_SERVICE_CHECK_RESULT;web-1;backup;0;OK - Nightly backup is up
+[1740830400] PROCESS_SERVICE_CHECK_RESULT;web-1;reports, weekly;1;WARNING - reports; weekly is grace
+`, buf.String())
+}
+
+func TestIcinga2Submit(t *testing.T) {
+	var got []processCheckResult
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/actions/process-check-result", r.URL.Path)
+		user, pass, _ := r.BasicAuth()
+		require.Equal(t, "root:secret", user+":"+pass)
+
+		var body processCheckResult
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		got = append(got, body)
+		w.Write([]byte(`{"results":[{"code":200,"status":"Successfully processed check result"}]}`))
+	}))
+	defer srv.Close()
+
+	icinga := &Icinga2{URL: srv.URL, User: "root", Password: "secret"}
+	err := icinga.Submit(context.Background(), []Result{{
+		Host: "web-1", Service: "backup", State: Critical, Output: "CRITICAL - backup is down | last_ping_age=90s;;;0",
+	}})
+	require.NoError(t, err)
+	require.Equal(t, []processCheckResult{{
+		Type:         "Service",
+		Filter:       `host.name=="web-1" && service.name=="backup"`,
+		ExitStatus:   Critical,
+		PluginOutput: "CRITICAL - backup is down",
+		Performance:  "last_ping_age=90s;;;0",
+	}}, got)
+}
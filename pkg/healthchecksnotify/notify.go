@@ -0,0 +1,245 @@
+// Package healthchecksnotify posts a Watcher's down and recovery events to a Slack incoming
+// webhook or any HTTP endpoint, for app-side notifications alongside the project's own channels.
+package healthchecksnotify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+)
+
+// Kind is the transition a notification is about
+type Kind string
+
+const (
+	Down      Kind = "down"
+	Recovered Kind = "recovered"
+)
+
+// Format is the request body a Notifier sends
+type Format string
+
+const (
+	// Slack posts {"text": "..."} as expected by Slack (and Mattermost) incoming webhooks
+	Slack Format = "slack"
+
+	// Webhook posts a Payload as JSON
+	Webhook Format = "webhook"
+)
+
+// DefaultTemplate renders messages such as "Nightly backup is down"
+const DefaultTemplate = `{{.Check.Name}} {{if eq .Kind "down"}}is down{{else}}has recovered{{end}}`
+
+// Event is what a message template is rendered with
+type Event struct {
+	Kind  Kind
+	Check healthchecksio.Check
+	At    time.Time
+}
+
+// Payload is the body posted in the Webhook format
+type Payload struct {
+	Kind   Kind      `json:"kind"`
+	Text   string    `json:"text"`
+	UUID   string    `json:"uuid"`
+	Name   string    `json:"name"`
+	Slug   string    `json:"slug,omitempty"`
+	Status string    `json:"status"`
+	At     time.Time `json:"at"`
+
+	// Suppressed counts notifications dropped by the rate limit since the previous one
+	Suppressed int `json:"suppressed,omitempty"`
+}
+
+// Options configures a Notifier
+type Options struct {
+	// URL is the Slack incoming webhook or HTTP endpoint notifications are posted to
+	URL string
+
+	// Format of the request body, defaults to Slack
+	Format Format
+
+	// Template is a text/template rendered with an Event for the message text,
+	// defaults to DefaultTemplate
+	Template string
+
+	// Limit is how many notifications are sent per Window, defaults to 10. Notifications over
+	// the limit are dropped and counted in the next message. Negative disables the limit.
+	Limit int
+
+	// Window is the rate limit's period, defaults to one minute
+	Window time.Duration
+
+	// Timeout bounds each post made from Watcher callbacks, defaults to 10 seconds
+	Timeout time.Duration
+
+	// Client sends the requests, defaults to http.DefaultClient
+	Client *http.Client
+
+	// OnError is called when a notification from a Watcher callback fails
+	OnError func(error)
+}
+
+// Notifier posts formatted messages about check transitions
+type Notifier struct {
+	opts     Options
+	template *template.Template
+	now      func() time.Time
+
+	mu          sync.Mutex
+	windowStart time.Time
+	sent        int
+	suppressed  int
+}
+
+// New creates a Notifier, returning an error for a missing URL or an invalid template
+func New(opts Options) (*Notifier, error) {
+	if opts.URL == "" {
+		return nil, errors.New("notifier: URL is required")
+	}
+	switch opts.Format {
+	case "":
+		opts.Format = Slack
+	case Slack, Webhook:
+	default:
+		return nil, fmt.Errorf("notifier: unknown format %q", opts.Format)
+	}
+	if opts.Template == "" {
+		opts.Template = DefaultTemplate
+	}
+	if opts.Limit == 0 {
+		opts.Limit = 10
+	}
+	if opts.Window <= 0 {
+		opts.Window = time.Minute
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = 10 * time.Second
+	}
+	if opts.Client == nil {
+		opts.Client = http.DefaultClient
+	}
+
+	tmpl, err := template.New("message").Parse(opts.Template)
+	if err != nil {
+		return nil, fmt.Errorf("notifier: %w", err)
+	}
+	return &Notifier{
+		opts:     opts,
+		template: tmpl,
+		now:      time.Now,
+	}, nil
+}
+
+// Watch notifies about watcher's OnDown and OnRecovered events, so WatcherOptions.Debounce
+// applies. Watcher callbacks run on the polling goroutine, which each post holds up for at
+// most Options.Timeout.
+func (n *Notifier) Watch(watcher *healthchecksio.Watcher) {
+	watcher.OnDown(func(check healthchecksio.Check) {
+		n.notifyWatched(Down, check)
+	})
+	watcher.OnRecovered(func(check healthchecksio.Check) {
+		n.notifyWatched(Recovered, check)
+	})
+}
+
+func (n *Notifier) notifyWatched(kind Kind, check healthchecksio.Check) {
+	ctx, cancel := context.WithTimeout(context.Background(), n.opts.Timeout)
+	defer cancel()
+
+	err := n.Notify(ctx, Event{Kind: kind, Check: check, At: n.now()})
+	if err != nil && n.opts.OnError != nil {
+		n.opts.OnError(err)
+	}
+}
+
+// Notify posts a message about event. Events over the rate limit are dropped without an error.
+func (n *Notifier) Notify(ctx context.Context, event Event) error {
+	suppressed, allowed := n.allow()
+	if !allowed {
+		return nil
+	}
+
+	var text bytes.Buffer
+	if err := n.template.Execute(&text, event); err != nil {
+		return fmt.Errorf("notify %s: %w", event.Check.Name, err)
+	}
+
+	body, err := n.encode(event, text.String(), suppressed)
+	if err != nil {
+		return fmt.Errorf("notify %s: %w", event.Check.Name, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", n.opts.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("notify %s: %w", event.Check.Name, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.opts.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("notify %s: %w", event.Check.Name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		bs, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("notify %s failed with %d: %s", event.Check.Name, resp.StatusCode, strings.TrimSpace(string(bs)))
+	}
+	return nil
+}
+
+// allow reports whether a notification fits in the current window, and how many were
+// suppressed since the last one which did
+func (n *Notifier) allow() (int, bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.opts.Limit < 0 {
+		return 0, true
+	}
+	now := n.now()
+	if now.Sub(n.windowStart) >= n.opts.Window {
+		n.windowStart = now
+		n.sent = 0
+	}
+	if n.sent >= n.opts.Limit {
+		n.suppressed++
+		return 0, false
+	}
+	n.sent++
+
+	suppressed := n.suppressed
+	n.suppressed = 0
+	return suppressed, true
+}
+
+func (n *Notifier) encode(event Event, text string, suppressed int) ([]byte, error) {
+	if n.opts.Format == Webhook {
+		return json.Marshal(Payload{
+			Kind:       event.Kind,
+			Text:       text,
+			UUID:       event.Check.UUID,
+			Name:       event.Check.Name,
+			Slug:       event.Check.Slug,
+			Status:     event.Check.Status,
+			At:         event.At,
+			Suppressed: suppressed,
+		})
+	}
+
+	if suppressed > 0 {
+		text += fmt.Sprintf(" (%d more notifications were rate limited)", suppressed)
+	}
+	return json.Marshal(map[string]string{"text": text})
+}
@@ -0,0 +1,148 @@
+package healthchecksnotify
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+type receiver struct {
+	mu     sync.Mutex
+	bodies []string
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	bs, _ := io.ReadAll(req.Body)
+	r.mu.Lock()
+	r.bodies = append(r.bodies, string(bs))
+	r.mu.Unlock()
+}
+
+func (r *receiver) received() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.bodies...)
+}
+
+func TestNotifier(t *testing.T) {
+	recv := &receiver{}
+	srv := httptest.NewServer(recv)
+	defer srv.Close()
+
+	n, err := New(Options{URL: srv.URL})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	check := healthchecksio.Check{UUID: "1", Name: "backup", Status: "down"}
+	require.NoError(t, n.Notify(ctx, Event{Kind: Down, Check: check}))
+	require.NoError(t, n.Notify(ctx, Event{Kind: Recovered, Check: check}))
+
+	require.Equal(t, []string{
+		`{"text":"backup is down"}`,
+		`{"text":"backup has recovered"}`,
+	}, recv.received())
+}
+
+func TestNotifierWebhook(t *testing.T) {
+	recv := &receiver{}
+	srv := httptest.NewServer(recv)
+	defer srv.Close()
+
+	n, err := New(Options{
+		URL:      srv.URL,
+		Format:   Webhook,
+		Template: "{{.Check.Slug}}: {{.Kind}}",
+	})
+	require.NoError(t, err)
+
+	at := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+	check := healthchecksio.Check{UUID: "1", Name: "backup", Slug: "nightly", Status: "down"}
+	require.NoError(t, n.Notify(context.Background(), Event{Kind: Down, Check: check, At: at}))
+
+	var payload Payload
+	require.NoError(t, json.Unmarshal([]byte(recv.received()[0]), &payload))
+	require.Equal(t, Payload{Kind: Down, Text: "nightly: down", UUID: "1", Name: "backup", Slug: "nightly", Status: "down", At: at}, payload)
+}
+
+func TestNotifierRateLimit(t *testing.T) {
+	recv := &receiver{}
+	srv := httptest.NewServer(recv)
+	defer srv.Close()
+
+	n, err := New(Options{URL: srv.URL, Limit: 2, Window: time.Minute})
+	require.NoError(t, err)
+
+	now := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+	n.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	event := Event{Kind: Down, Check: healthchecksio.Check{Name: "backup"}}
+	for range 5 {
+		require.NoError(t, n.Notify(ctx, event))
+	}
+	require.Len(t, recv.received(), 2)
+
+	now = now.Add(time.Minute)
+	require.NoError(t, n.Notify(ctx, event))
+	require.Equal(t, `{"text":"backup is down (3 more notifications were rate limited)"}`, recv.received()[2])
+}
+
+func TestNotifierErrors(t *testing.T) {
+	_, err := New(Options{})
+	require.ErrorContains(t, err, "URL is required")
+	_, err = New(Options{URL: "http://example.com", Format: "xml"})
+	require.ErrorContains(t, err, `unknown format "xml"`)
+	_, err = New(Options{URL: "http://example.com", Template: "{{"})
+	require.Error(t, err)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "invalid_token", http.StatusForbidden)
+	}))
+	defer srv.Close()
+
+	n, err := New(Options{URL: srv.URL})
+	require.NoError(t, err)
+	err = n.Notify(context.Background(), Event{Kind: Down, Check: healthchecksio.Check{Name: "backup"}})
+	require.ErrorContains(t, err, "notify backup failed with 403: invalid_token")
+}
+
+func TestNotifierWatch(t *testing.T) {
+	var status atomic.Value
+	status.Store("up")
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"checks":[{"uuid":"1","name":"backup","status":"` + status.Load().(string) + `"}]}`))
+	}))
+	defer api.Close()
+
+	recv := &receiver{}
+	srv := httptest.NewServer(recv)
+	defer srv.Close()
+
+	client := healthchecksio.NewClient("key", healthchecksio.WithBaseURL(api.URL))
+	watcher := healthchecksio.NewWatcher(client, healthchecksio.WatcherOptions{})
+
+	n, err := New(Options{URL: srv.URL, OnError: func(err error) { t.Error(err) }})
+	require.NoError(t, err)
+	n.Watch(watcher)
+
+	ctx := context.Background()
+	for _, s := range []string{"up", "down", "down", "up"} {
+		status.Store(s)
+		_, err := watcher.Poll(ctx)
+		require.NoError(t, err)
+	}
+	require.Equal(t, []string{
+		`{"text":"backup is down"}`,
+		`{"text":"backup has recovered"}`,
+	}, recv.received())
+}
@@ -0,0 +1,63 @@
+package healthchecksotel_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksotel"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestRetryAttemptEvents(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"uuid":"abc"}`))
+	}))
+	defer srv.Close()
+
+	client := healthchecksio.NewClient("key",
+		healthchecksio.WithBaseURL(srv.URL),
+		healthchecksotel.WithGlobalTracer(),
+		healthchecksio.WithRetries(3, time.Millisecond, 5*time.Millisecond),
+	)
+	_, err := client.GetCheck(context.Background(), "abc")
+	require.NoError(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	span := spans[0]
+
+	require.Contains(t, span.Attributes(), attribute.Int("http.attempts", 3))
+
+	var names []string
+	var statuses []int64
+	for _, event := range span.Events() {
+		names = append(names, event.Name)
+		for _, attr := range event.Attributes {
+			if attr.Key == "http.status_code" {
+				statuses = append(statuses, attr.Value.AsInt64())
+			}
+		}
+	}
+	require.Equal(t, []string{"attempt", "retry", "attempt", "retry", "attempt"}, names)
+	require.Equal(t, []int64{503, 503, 200}, statuses)
+}
@@ -0,0 +1,92 @@
+// Package healthchecksotel traces healthchecksio clients with OpenTelemetry
+package healthchecksotel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the instrumentation scope of spans created by this package
+const TracerName = "github.com/adamdecaf/go-healthchecksio"
+
+// WithTracerProvider traces the client's calls with tp
+func WithTracerProvider(tp trace.TracerProvider) healthchecksio.ClientOption {
+	return healthchecksio.WithTracer(NewTracer(tp))
+}
+
+// WithGlobalTracer traces the client's calls with the global OpenTelemetry TracerProvider,
+// which is what setups like moov-io/base's telemetry package configure.
+func WithGlobalTracer() healthchecksio.ClientOption {
+	return healthchecksio.WithTracer(NewTracer(nil))
+}
+
+// NewTracer adapts tp into a healthchecksio.Tracer. A nil tp uses the global TracerProvider
+// at the time each span is started.
+func NewTracer(tp trace.TracerProvider) healthchecksio.Tracer {
+	return &tracer{provider: tp}
+}
+
+type tracer struct {
+	provider trace.TracerProvider
+}
+
+func (t *tracer) Start(ctx context.Context, name string, attrs ...healthchecksio.Attribute) (context.Context, healthchecksio.Span) {
+	provider := t.provider
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+
+	var opts []trace.SpanStartOption
+	if len(attrs) > 0 {
+		opts = append(opts, trace.WithAttributes(convert(attrs)...))
+	}
+	ctx, s := provider.Tracer(TracerName).Start(ctx, name, opts...)
+	return ctx, span{s}
+}
+
+type span struct {
+	span trace.Span
+}
+
+func (s span) End() {
+	s.span.End()
+}
+
+func (s span) IsRecording() bool {
+	return s.span.IsRecording()
+}
+
+func (s span) SetAttributes(attrs ...healthchecksio.Attribute) {
+	s.span.SetAttributes(convert(attrs)...)
+}
+
+func (s span) AddEvent(name string, attrs ...healthchecksio.Attribute) {
+	s.span.AddEvent(name, trace.WithAttributes(convert(attrs)...))
+}
+
+func convert(attrs []healthchecksio.Attribute) []attribute.KeyValue {
+	out := make([]attribute.KeyValue, len(attrs))
+	for i, a := range attrs {
+		switch v := a.Value.(type) {
+		case string:
+			out[i] = attribute.String(a.Key, v)
+		case int:
+			out[i] = attribute.Int(a.Key, v)
+		case int64:
+			out[i] = attribute.Int64(a.Key, v)
+		case bool:
+			out[i] = attribute.Bool(a.Key, v)
+		case float64:
+			out[i] = attribute.Float64(a.Key, v)
+		default:
+			out[i] = attribute.String(a.Key, fmt.Sprint(v))
+		}
+	}
+	return out
+}
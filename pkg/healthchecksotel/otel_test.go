@@ -0,0 +1,42 @@
+package healthchecksotel_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksotel"
+
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestWithTracerProvider(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"uuid":"abc"}`))
+	}))
+	defer srv.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	client := healthchecksio.NewClient("key",
+		healthchecksio.WithBaseURL(srv.URL),
+		healthchecksotel.WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))),
+		healthchecksio.WithSpanPrefix("billing.hc."),
+	)
+
+	ctx := context.Background()
+	_, err := client.GetCheck(ctx, "abc")
+	require.NoError(t, err)
+	_, err = client.Ping(ctx, srv.URL+"/ping/abc", "")
+	require.NoError(t, err)
+
+	var names []string
+	for _, span := range recorder.Ended() {
+		names = append(names, span.Name())
+		require.Equal(t, "github.com/adamdecaf/go-healthchecksio", span.InstrumentationScope().Name)
+	}
+	require.Equal(t, []string{"billing.hc.api-get-check", "billing.hc.api-ping"}, names)
+}
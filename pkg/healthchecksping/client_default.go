@@ -0,0 +1,10 @@
+//go:build !wasip1
+
+package healthchecksping
+
+import (
+	"net/http"
+	"time"
+)
+
+var defaultClient Doer = &http.Client{Timeout: 10 * time.Second}
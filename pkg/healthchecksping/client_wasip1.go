@@ -0,0 +1,6 @@
+//go:build wasip1
+
+package healthchecksping
+
+// WASI preview 1 has no sockets, so pings need a Doer backed by the host
+var defaultClient Doer
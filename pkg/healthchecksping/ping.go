@@ -0,0 +1,122 @@
+// Package healthchecksping sends Healthchecks.io pings with nothing beyond the standard library.
+// It is meant for TinyGo binaries and WASM workers where the healthchecksio package is too heavy,
+// and only supports pinging: use healthchecksio for the management API.
+package healthchecksping
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Doer sends HTTP requests, *http.Client implements it
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// ErrNoClient is returned when no Doer is set on a platform without a default one (e.g. wasip1)
+var ErrNoClient = errors.New("healthchecksping: no HTTP client configured")
+
+// Pinger sends pings to one check
+type Pinger struct {
+	// URL is the check's ping URL, e.g. https://hc-ping.com/<uuid>
+	URL string
+
+	// Client sends the pings, defaults to an *http.Client with a 10s timeout where the platform has one
+	Client Doer
+
+	// Retries is how many more times a ping is sent after a network error or 5xx response
+	Retries int
+
+	// RetryWait is the delay between attempts, defaults to 1s
+	RetryWait time.Duration
+}
+
+// New returns a Pinger for pingURL which retries twice
+func New(pingURL string) *Pinger {
+	return &Pinger{
+		URL:     pingURL,
+		Retries: 2,
+	}
+}
+
+// Success sends a success ping
+func (p *Pinger) Success(ctx context.Context, body string) error {
+	return p.send(ctx, "", body)
+}
+
+// Start sends a start ping
+func (p *Pinger) Start(ctx context.Context, body string) error {
+	return p.send(ctx, "/start", body)
+}
+
+// Fail sends a failure ping
+func (p *Pinger) Fail(ctx context.Context, body string) error {
+	return p.send(ctx, "/fail", body)
+}
+
+// Log sends a log ping which records the body without changing the check's status
+func (p *Pinger) Log(ctx context.Context, body string) error {
+	return p.send(ctx, "/log", body)
+}
+
+// ExitStatus reports a command's exit status, zero is a success and anything else a failure
+func (p *Pinger) ExitStatus(ctx context.Context, status int, body string) error {
+	return p.send(ctx, "/"+strconv.Itoa(status), body)
+}
+
+func (p *Pinger) send(ctx context.Context, suffix, body string) error {
+	client := p.Client
+	if client == nil {
+		client = defaultClient
+	}
+	if client == nil {
+		return ErrNoClient
+	}
+	wait := p.RetryWait
+	if wait <= 0 {
+		wait = time.Second
+	}
+	address := strings.TrimSuffix(p.URL, "/") + suffix
+
+	for attempt := 0; ; attempt++ {
+		retry, err := p.attempt(ctx, client, address, body)
+		if err == nil || !retry || attempt >= p.Retries {
+			return err
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// attempt sends one ping, retry reports whether a failure is worth another attempt
+func (p *Pinger) attempt(ctx context.Context, client Doer, address, body string) (retry bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", address, strings.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("User-Agent", "go-healthchecks-client")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return ctx.Err() == nil, errors.New("ping: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return resp.StatusCode >= 500, errors.New("ping failed with " + strconv.Itoa(resp.StatusCode) + ": " + string(msg))
+	}
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	return false, nil
+}
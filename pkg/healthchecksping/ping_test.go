@@ -0,0 +1,74 @@
+package healthchecksping_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksping"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPinger(t *testing.T) {
+	var mu sync.Mutex
+	var received []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bs, _ := io.ReadAll(r.Body)
+
+		mu.Lock()
+		defer mu.Unlock()
+		received = append(received, r.URL.Path+" "+string(bs))
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	p := healthchecksping.New(srv.URL + "/ping/abc")
+	require.NoError(t, p.Start(ctx, ""))
+	require.NoError(t, p.Log(ctx, "halfway"))
+	require.NoError(t, p.ExitStatus(ctx, 3, "exited"))
+	require.NoError(t, p.Fail(ctx, "boom"))
+	require.NoError(t, p.Success(ctx, "done"))
+
+	require.Equal(t, []string{
+		"/ping/abc/start ",
+		"/ping/abc/log halfway",
+		"/ping/abc/3 exited",
+		"/ping/abc/fail boom",
+		"/ping/abc done",
+	}, received)
+}
+
+func TestPingerRetries(t *testing.T) {
+	var attempts int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer srv.Close()
+
+	p := healthchecksping.New(srv.URL + "/ping/abc")
+	p.RetryWait = time.Millisecond
+	require.NoError(t, p.Success(context.Background(), ""))
+	require.Equal(t, 3, attempts)
+
+	// Client errors aren't retried
+	notFound := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("not found"))
+	}))
+	defer notFound.Close()
+
+	attempts = 0
+	p.URL = notFound.URL + "/ping/abc"
+	err := p.Success(context.Background(), "")
+	require.EqualError(t, err, "ping failed with 404: not found")
+	require.Equal(t, 1, attempts)
+}
@@ -0,0 +1,120 @@
+// Package healthchecksprom exports check availability to Prometheus, either as history
+// through remote write or as current state through a Pushgateway. It only uses the standard library.
+package healthchecksprom
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+)
+
+// UpMetric is 1 while a check is up and 0 while it's down
+const UpMetric = "healthchecks_check_up"
+
+// Sample is one value of a series
+type Sample struct {
+	Time  time.Time
+	Value float64
+}
+
+// Series is a time series, Labels includes the metric name under "__name__"
+type Series struct {
+	Labels  map[string]string
+	Samples []Sample
+}
+
+// CheckLabels are the labels identifying a check's series
+func CheckLabels(check healthchecksio.Check) map[string]string {
+	labels := map[string]string{
+		"__name__": UpMetric,
+		"check":    check.Name,
+		"uuid":     check.UUID,
+	}
+	if check.Slug != "" {
+		labels["slug"] = check.Slug
+	}
+	return labels
+}
+
+// FlipSeries samples check's up/down state every step from start to end, labelled with
+// CheckLabels. The state before the first flip is seeded like healthchecksio.ComputeUptime
+// does, so checks which didn't flip during the period are exported too.
+func FlipSeries(check healthchecksio.Check, flips []healthchecksio.Flip, start, end time.Time, step time.Duration) (Series, error) {
+	if step <= 0 {
+		return Series{}, errors.New("flip series: step must be positive")
+	}
+	report, err := healthchecksio.ComputeUptime(check, flips, start, end)
+	if err != nil {
+		return Series{}, fmt.Errorf("flip series: %w", err)
+	}
+	periods := report.Periods()
+
+	series := Series{Labels: CheckLabels(check)}
+	next := 0
+	for t := start.Truncate(step); !t.After(end); t = t.Add(step) {
+		if t.Before(start) {
+			continue
+		}
+		for next+1 < len(periods) && !t.Before(periods[next+1].Start) {
+			next++
+		}
+		value := 0.0
+		if periods[next].Up {
+			value = 1
+		}
+		series.Samples = append(series.Samples, Sample{Time: t, Value: value})
+	}
+	return series, nil
+}
+
+// ExportOptions configures ExportFlips
+type ExportOptions struct {
+	// Filter limits which checks are exported
+	Filter healthchecksio.GetChecks
+
+	// Since is how much history is exported, defaults to 7 days
+	Since time.Duration
+
+	// Step is the interval between samples, defaults to one minute
+	Step time.Duration
+}
+
+// ExportFlips writes the availability history of checks matching opts.Filter to w
+func ExportFlips(ctx context.Context, client healthchecksio.CheckReader, w *RemoteWriter, opts ExportOptions) error {
+	if opts.Since <= 0 {
+		opts.Since = 7 * 24 * time.Hour
+	}
+	if opts.Step <= 0 {
+		opts.Step = time.Minute
+	}
+	end := time.Now()
+	start := end.Add(-opts.Since)
+
+	checks, err := client.GetChecks(ctx, opts.Filter)
+	if err != nil {
+		return fmt.Errorf("export flips: %w", err)
+	}
+
+	var series []Series
+	for _, check := range checks.Checks {
+		// Flips before start are needed for the state the period starts in
+		flips, err := client.GetFlips(ctx, check.UUID, healthchecksio.GetFlipsRequest{End: end.Unix()})
+		if err != nil {
+			return fmt.Errorf("export flips: %s: %w", check.UUID, err)
+		}
+		s, err := FlipSeries(check, flips.Flips, start, end, opts.Step)
+		if err != nil {
+			return fmt.Errorf("export flips: %s: %w", check.UUID, err)
+		}
+		if len(s.Samples) > 0 {
+			series = append(series, s)
+		}
+	}
+	if len(series) == 0 {
+		return nil
+	}
+	return w.Write(ctx, series)
+}
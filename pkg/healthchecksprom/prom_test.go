@@ -0,0 +1,168 @@
+package healthchecksprom
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlipSeries(t *testing.T) {
+	start := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+	flips := []healthchecksio.Flip{
+		{Timestamp: "2025-03-01T12:02:30Z", Up: 0},
+		{Timestamp: "2025-03-01T12:01:00Z", Up: 1},
+		{Timestamp: "2025-03-01T12:04:00Z", Up: 1},
+	}
+
+	check := healthchecksio.Check{UUID: "abc", Name: "backup", Status: "up"}
+	series, err := FlipSeries(check, flips, start, start.Add(5*time.Minute), time.Minute)
+	require.NoError(t, err)
+
+	var values []float64
+	for _, s := range series.Samples {
+		values = append(values, s.Value)
+	}
+	// Down until the first flip up
+	require.Equal(t, UpMetric, series.Labels["__name__"])
+	require.Equal(t, start, series.Samples[0].Time)
+	require.Equal(t, []float64{0, 1, 1, 0, 1, 1}, values)
+
+	// Checks which didn't flip keep their current state
+	series, err = FlipSeries(check, nil, start, start.Add(5*time.Minute), time.Minute)
+	require.NoError(t, err)
+	require.Len(t, series.Samples, 6)
+	require.Equal(t, 1.0, series.Samples[5].Value)
+
+	_, err = FlipSeries(check, flips, start, start, 0)
+	require.Error(t, err)
+}
+
+func TestEncodeWriteRequest(t *testing.T) {
+	at := time.UnixMilli(1000)
+	got := encodeWriteRequest([]Series{{
+		Labels:  map[string]string{"b": "2", "a": "1"},
+		Samples: []Sample{{Time: at, Value: 1}},
+	}})
+
+	want := []byte{
+		0x0a, 0x1e, // timeseries, 30 bytes
+		0x0a, 0x06, 0x0a, 0x01, 'a', 0x12, 0x01, '1', // label a=1, sorted first
+		0x0a, 0x06, 0x0a, 0x01, 'b', 0x12, 0x01, '2',
+		0x12, 0x0c, // sample, 12 bytes
+		0x09, 0, 0, 0, 0, 0, 0, 0xf0, 0x3f, // value 1.0
+		0x10, 0xe8, 0x07, // timestamp 1000
+	}
+	require.Equal(t, want, got)
+}
+
+// snappyDecodeLiterals decodes snappy blocks made only of literals
+func snappyDecodeLiterals(t *testing.T, data []byte) []byte {
+	t.Helper()
+
+	size, n := binary.Uvarint(data)
+	data = data[n:]
+	var out []byte
+	for len(data) > 0 {
+		tag := data[0]
+		require.Zero(t, tag&3, "only literals are expected")
+		length := int(tag>>2) + 1
+		data = data[1:]
+		switch tag >> 2 {
+		case 60:
+			length = int(data[0]) + 1
+			data = data[1:]
+		case 61:
+			length = int(data[0]) | int(data[1])<<8 + 1
+			data = data[2:]
+		}
+		out = append(out, data[:length]...)
+		data = data[length:]
+	}
+	require.Len(t, out, int(size))
+	return out
+}
+
+func TestSnappyEncode(t *testing.T) {
+	for _, size := range []int{0, 1, 60, 61, 256, 257, 1 << 16, 1<<16 + 1, 200_000} {
+		data := []byte(strings.Repeat("x", size))
+		require.Equal(t, data, append([]byte{}, snappyDecodeLiterals(t, snappyEncode(data))...), "size %d", size)
+	}
+}
+
+func TestExportFlips(t *testing.T) {
+	var body []byte
+	var header http.Header
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	recent := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/checks/":
+			w.Write([]byte(`{"checks":[{"uuid":"abc","name":"Backup"},{"uuid":"quiet","name":"Quiet"}]}`))
+		case "/checks/abc/flips/":
+			w.Write([]byte(`{"flips":[{"timestamp":"` + recent + `","up":1}]}`))
+		default:
+			w.Write([]byte(`{"flips":[]}`))
+		}
+	}))
+	defer api.Close()
+
+	client := healthchecksio.NewClient("key", healthchecksio.WithBaseURL(api.URL))
+	err := ExportFlips(context.Background(), client, &RemoteWriter{
+		URL:    receiver.URL,
+		Header: http.Header{"X-Scope-Orgid": []string{"ops"}},
+	}, ExportOptions{Since: 2 * time.Hour, Step: 10 * time.Minute})
+	require.NoError(t, err)
+
+	require.Equal(t, "snappy", header.Get("Content-Encoding"))
+	require.Equal(t, "ops", header.Get("X-Scope-OrgID"))
+
+	// Checks without flips are exported from their current status
+	decoded := snappyDecodeLiterals(t, body)
+	require.Contains(t, string(decoded), "abc")
+	require.Contains(t, string(decoded), "quiet")
+}
+
+func TestPushgateway(t *testing.T) {
+	var path, body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "PUT", r.Method)
+		path = r.URL.Path
+		bs, _ := io.ReadAll(r.Body)
+		body = string(bs)
+	}))
+	defer srv.Close()
+
+	pg := &Pushgateway{URL: srv.URL}
+	err := pg.Push(context.Background(), []healthchecksio.Check{
+		{UUID: "1", Name: `Nightly "backup"`, Status: "up", LastPing: "2025-03-01T12:00:00+00:00"},
+		{UUID: "2", Name: "Reports", Status: "down"},
+		{UUID: "3", Name: "New", Status: "new"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "/metrics/job/healthchecks", path)
+	require.Equal(t, `# TYPE healthchecks_check_up gauge
+healthchecks_check_up{check="Nightly \"backup\"",uuid="1"} 1
+healthchecks_check_up{check="Reports",uuid="2"} 0
+# TYPE healthchecks_check_status gauge
+healthchecks_check_status{check="Nightly \"backup\"",uuid="1",status="up"} 1
+healthchecks_check_status{check="Reports",uuid="2",status="down"} 1
+healthchecks_check_status{check="New",uuid="3",status="new"} 1
+# TYPE healthchecks_check_last_ping_timestamp_seconds gauge
+healthchecks_check_last_ping_timestamp_seconds{check="Nightly \"backup\"",uuid="1"} 1740830400
+`, body)
+}
@@ -0,0 +1,100 @@
+package healthchecksprom
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+)
+
+// Pushgateway pushes the current state of checks to a Prometheus Pushgateway. The Pushgateway
+// doesn't accept timestamps, use RemoteWriter for history.
+type Pushgateway struct {
+	// URL is the Pushgateway's address, e.g. http://pushgateway:9091
+	URL string
+
+	// Job groups the pushed metrics, defaults to "healthchecks"
+	Job string
+
+	// Client defaults to http.DefaultClient
+	Client *http.Client
+}
+
+// Push replaces the job's metrics with the state of checks:
+//
+//	healthchecks_check_up                         1 when up or in its grace period, 0 when down
+//	healthchecks_check_status{status="..."}       1 for the check's current status
+//	healthchecks_check_last_ping_timestamp_seconds
+func (p *Pushgateway) Push(ctx context.Context, checks []healthchecksio.Check) error {
+	var buf bytes.Buffer
+	buf.WriteString("# TYPE healthchecks_check_up gauge\n")
+	for _, check := range checks {
+		var up string
+		switch healthchecksio.CheckStatus(check.Status) {
+		case healthchecksio.StatusUp, healthchecksio.StatusGrace, healthchecksio.StatusStarted:
+			up = "1"
+		case healthchecksio.StatusDown:
+			up = "0"
+		default:
+			continue // new and paused checks are neither
+		}
+		writeSample(&buf, UpMetric, checkLabels(check), up)
+	}
+	buf.WriteString("# TYPE healthchecks_check_status gauge\n")
+	for _, check := range checks {
+		writeSample(&buf, "healthchecks_check_status", checkLabels(check)+`,status="`+escapeLabel(check.Status)+`"`, "1")
+	}
+	buf.WriteString("# TYPE healthchecks_check_last_ping_timestamp_seconds gauge\n")
+	for _, check := range checks {
+		if last, pinged := check.LastPingTime(); pinged {
+			writeSample(&buf, "healthchecks_check_last_ping_timestamp_seconds", checkLabels(check), strconv.FormatInt(last.Unix(), 10))
+		}
+	}
+
+	job := p.Job
+	if job == "" {
+		job = "healthchecks"
+	}
+	address := strings.TrimSuffix(p.URL, "/") + "/metrics/job/" + url.PathEscape(job)
+	req, err := http.NewRequestWithContext(ctx, "PUT", address, &buf)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "text/plain; version=0.0.4")
+
+	client := p.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("pushgateway: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("pushgateway failed with %d: %s", resp.StatusCode, bytes.TrimSpace(msg))
+	}
+	return nil
+}
+
+func checkLabels(check healthchecksio.Check) string {
+	return `check="` + escapeLabel(check.Name) + `",uuid="` + escapeLabel(check.UUID) + `"`
+}
+
+func writeSample(buf *bytes.Buffer, name, labels, value string) {
+	fmt.Fprintf(buf, "%s{%s} %s\n", name, labels, value)
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabel(value string) string {
+	return labelEscaper.Replace(value)
+}
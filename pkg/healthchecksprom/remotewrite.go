@@ -0,0 +1,113 @@
+package healthchecksprom
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"net/http"
+	"slices"
+)
+
+// RemoteWriter sends series to a Prometheus remote write (v1) endpoint, e.g.
+// http://prometheus:9090/api/v1/write or a Mimir, Thanos or VictoriaMetrics receiver.
+type RemoteWriter struct {
+	URL string
+
+	// Client defaults to http.DefaultClient
+	Client *http.Client
+
+	// Header is added to every request, e.g. for authentication or X-Scope-OrgID
+	Header http.Header
+}
+
+// Write sends series in one request
+func (w *RemoteWriter) Write(ctx context.Context, series []Series) error {
+	body := snappyEncode(encodeWriteRequest(series))
+
+	req, err := http.NewRequestWithContext(ctx, "POST", w.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, v := range w.Header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Encoding", "snappy")
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("X-Prometheus-Remote-Write-Version", "0.1.0")
+
+	client := w.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("remote write: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("remote write failed with %d: %s", resp.StatusCode, bytes.TrimSpace(msg))
+	}
+	return nil
+}
+
+// encodeWriteRequest encodes a prometheus.WriteRequest protobuf message:
+//
+//	WriteRequest { repeated TimeSeries timeseries = 1; }
+//	TimeSeries   { repeated Label labels = 1; repeated Sample samples = 2; }
+//	Label        { string name = 1; string value = 2; }
+//	Sample       { double value = 1; int64 timestamp = 2; }
+func encodeWriteRequest(series []Series) []byte {
+	var out []byte
+	for _, s := range series {
+		var ts []byte
+		for _, name := range slices.Sorted(maps.Keys(s.Labels)) {
+			var label []byte
+			label = appendBytes(label, 1, []byte(name))
+			label = appendBytes(label, 2, []byte(s.Labels[name]))
+			ts = appendBytes(ts, 1, label)
+		}
+		for _, sample := range s.Samples {
+			var sm []byte
+			sm = binary.AppendUvarint(sm, 1<<3|1) // fixed64
+			sm = binary.LittleEndian.AppendUint64(sm, math.Float64bits(sample.Value))
+			sm = binary.AppendUvarint(sm, 2<<3|0) // varint
+			sm = binary.AppendUvarint(sm, uint64(sample.Time.UnixMilli()))
+			ts = appendBytes(ts, 2, sm)
+		}
+		out = appendBytes(out, 1, ts)
+	}
+	return out
+}
+
+// appendBytes appends a length delimited protobuf field
+func appendBytes(b []byte, field uint64, value []byte) []byte {
+	b = binary.AppendUvarint(b, field<<3|2)
+	b = binary.AppendUvarint(b, uint64(len(value)))
+	return append(b, value...)
+}
+
+// snappyEncode frames data in the snappy block format as literals only. Receivers
+// decode it like any other snappy block, it's just not compressed.
+func snappyEncode(data []byte) []byte {
+	out := binary.AppendUvarint(nil, uint64(len(data)))
+	for len(data) > 0 {
+		n := min(len(data), 1<<16)
+		switch {
+		case n <= 60:
+			out = append(out, byte(n-1)<<2)
+		case n <= 1<<8:
+			out = append(out, 60<<2, byte(n-1))
+		default:
+			out = append(out, 61<<2, byte(n-1), byte((n-1)>>8))
+		}
+		out = append(out, data[:n]...)
+		data = data[n:]
+	}
+	return out
+}
@@ -0,0 +1,55 @@
+// Package healthchecksretryablehttp retries healthchecksio clients with hashicorp/go-retryablehttp's backoff
+package healthchecksretryablehttp
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// WithRetryableHTTP retries the client's calls using rc's RetryMax, RetryWaitMin,
+// RetryWaitMax and Backoff. Which responses are retried is still decided by the client.
+func WithRetryableHTTP(rc *retryablehttp.Client) healthchecksio.ClientOption {
+	return healthchecksio.WithRetryEngine(NewEngine(rc))
+}
+
+// NewEngine adapts rc into a healthchecksio.RetryEngine
+func NewEngine(rc *retryablehttp.Client) healthchecksio.RetryEngine {
+	return &engine{client: rc}
+}
+
+type engine struct {
+	client *retryablehttp.Client
+}
+
+func (e *engine) Do(ctx context.Context, attempt func(ctx context.Context) healthchecksio.AttemptResult) (*http.Response, error) {
+	backoff := e.client.Backoff
+	if backoff == nil {
+		backoff = retryablehttp.DefaultBackoff
+	}
+
+	for i := 0; ; i++ {
+		result := attempt(ctx)
+		if !result.Retry || i >= e.client.RetryMax {
+			return result.Response, result.Err
+		}
+
+		wait := result.Wait
+		if wait <= 0 {
+			wait = backoff(e.client.RetryWaitMin, e.client.RetryWaitMax, i, result.Response)
+		}
+		result.Discard()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
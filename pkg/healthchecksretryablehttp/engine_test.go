@@ -0,0 +1,52 @@
+package healthchecksretryablehttp_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksretryablehttp"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRetryableHTTP(t *testing.T) {
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"uuid":"abc"}`))
+	}))
+	defer srv.Close()
+
+	var waits atomic.Int32
+	rc := retryablehttp.NewClient()
+	rc.RetryMax = 2
+	rc.Backoff = func(min, max time.Duration, attempt int, resp *http.Response) time.Duration {
+		waits.Add(1)
+		return time.Millisecond
+	}
+
+	client := healthchecksio.NewClient("key",
+		healthchecksio.WithBaseURL(srv.URL),
+		healthchecksretryablehttp.WithRetryableHTTP(rc),
+	)
+	check, err := client.GetCheck(context.Background(), "abc")
+	require.NoError(t, err)
+	require.Equal(t, "abc", check.UUID)
+	require.Equal(t, int32(3), attempts.Load())
+	require.Equal(t, int32(2), waits.Load())
+
+	// RetryMax bounds attempts
+	attempts.Store(-10)
+	_, err = client.GetCheck(context.Background(), "abc")
+	require.ErrorContains(t, err, "get check failed with 503")
+	require.Equal(t, int32(-7), attempts.Load())
+}
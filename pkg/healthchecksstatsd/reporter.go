@@ -0,0 +1,156 @@
+package healthchecksstatsd
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+)
+
+// StatsSource is implemented by healthchecksio.Client
+type StatsSource interface {
+	Stats() healthchecksio.Stats
+}
+
+// ReporterOptions configures a Reporter
+type ReporterOptions struct {
+	// Interval between reports, defaults to ten seconds
+	Interval time.Duration
+
+	// Checks, when set, is polled for check status gauges
+	Checks healthchecksio.CheckReader
+
+	// Filter limits which checks are reported
+	Filter healthchecksio.GetChecks
+
+	// OnError is called when polling checks fails. Run keeps going afterwards.
+	OnError func(error)
+}
+
+// Reporter sends a client's counters and, optionally, the status of checks to a Sink:
+//
+//	api.requests{endpoint}   counter of API calls
+//	api.errors               counter of API calls which failed
+//	api.retries              counter of retried attempts
+//	pings.sent               counter of pings
+//	pings.failed             counter of pings which failed
+//	checks{status}           gauge of checks per status
+//	check.up{check}          gauge by slug or UUID, 1 when up, in its grace period or started, 0 when down
+type Reporter struct {
+	stats StatsSource
+	sink  *Sink
+	opts  ReporterOptions
+
+	mu   sync.Mutex
+	last healthchecksio.Stats
+}
+
+// NewReporter creates a Reporter sending stats' counters to sink
+func NewReporter(stats StatsSource, sink *Sink, opts ReporterOptions) *Reporter {
+	if opts.Interval <= 0 {
+		opts.Interval = 10 * time.Second
+	}
+	return &Reporter{stats: stats, sink: sink, opts: opts}
+}
+
+// Report sends the counters accumulated since the previous Report and the current check statuses
+func (r *Reporter) Report(ctx context.Context) error {
+	r.reportStats()
+
+	var err error
+	if r.opts.Checks != nil {
+		err = r.reportChecks(ctx)
+	}
+	if ferr := r.sink.Flush(); err == nil && ferr != nil {
+		err = fmt.Errorf("report: %w", ferr)
+	}
+	return err
+}
+
+func (r *Reporter) reportStats() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current := r.stats.Stats()
+	for _, endpoint := range slices.Sorted(maps.Keys(current.Requests)) {
+		if n := current.Requests[endpoint] - r.last.Requests[endpoint]; n > 0 {
+			r.sink.Count("api.requests", int64(n), Tag{"endpoint", endpoint})
+		}
+	}
+	counters := []struct {
+		name          string
+		current, last uint64
+	}{
+		{"api.errors", current.Failures, r.last.Failures},
+		{"api.retries", current.Retries, r.last.Retries},
+		{"pings.sent", current.PingsSent, r.last.PingsSent},
+		{"pings.failed", current.PingsFailed, r.last.PingsFailed},
+	}
+	for _, counter := range counters {
+		if n := counter.current - counter.last; n > 0 {
+			r.sink.Count(counter.name, int64(n))
+		}
+	}
+	r.last = current
+}
+
+var statuses = []healthchecksio.CheckStatus{
+	healthchecksio.StatusNew, healthchecksio.StatusUp, healthchecksio.StatusGrace,
+	healthchecksio.StatusStarted, healthchecksio.StatusDown, healthchecksio.StatusPaused,
+}
+
+func (r *Reporter) reportChecks(ctx context.Context) error {
+	list, err := r.opts.Checks.GetChecks(ctx, r.opts.Filter)
+	if err != nil {
+		return fmt.Errorf("report: %w", err)
+	}
+
+	counts := make(map[healthchecksio.CheckStatus]int)
+	for _, check := range list.Checks {
+		status := healthchecksio.CheckStatus(check.Status)
+		counts[status]++
+
+		name := check.Slug
+		if name == "" {
+			name = check.UUID
+		}
+		switch status {
+		case healthchecksio.StatusUp, healthchecksio.StatusGrace, healthchecksio.StatusStarted:
+			r.sink.Gauge("check.up", 1, Tag{"check", name})
+		case healthchecksio.StatusDown:
+			r.sink.Gauge("check.up", 0, Tag{"check", name})
+		}
+	}
+	// Statuses without checks are reported as zero so gauges don't stay at their last value
+	for _, status := range statuses {
+		r.sink.Gauge("checks", float64(counts[status]), Tag{"status", string(status)})
+	}
+	return nil
+}
+
+// Run reports every Interval until ctx is cancelled
+func (r *Reporter) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.Report(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if r.opts.OnError != nil {
+				r.opts.OnError(err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
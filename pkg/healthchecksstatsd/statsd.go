@@ -0,0 +1,144 @@
+// Package healthchecksstatsd reports client and check metrics to statsd or a Datadog agent
+// (DogStatsD), for setups without an OpenTelemetry collector. It only uses the standard library.
+package healthchecksstatsd
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// maxPacket keeps datagrams under the usual Ethernet MTU
+const maxPacket = 1432
+
+// Options configures a Sink
+type Options struct {
+	// Prefix is prepended to every metric name, defaults to "healthchecks."
+	Prefix string
+
+	// DogStatsD sends tags in the DogStatsD format. Plain statsd has no tags, so their
+	// values are appended to the metric name instead, e.g. healthchecks.api.requests.get-checks
+	DogStatsD bool
+
+	// Tags are added to every metric, as "key:value". They're dropped for plain statsd.
+	Tags []string
+}
+
+// Tag is a metric dimension
+type Tag struct {
+	Key, Value string
+}
+
+// Sink buffers metrics and writes them to a statsd server in as few packets as possible.
+// It's safe for concurrent use.
+type Sink struct {
+	w    io.Writer
+	opts Options
+
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+// Dial creates a Sink sending UDP packets to address, e.g. "127.0.0.1:8125"
+func Dial(address string, opts Options) (*Sink, error) {
+	conn, err := net.Dial("udp", address)
+	if err != nil {
+		return nil, err
+	}
+	return New(conn, opts), nil
+}
+
+// New creates a Sink writing packets to w
+func New(w io.Writer, opts Options) *Sink {
+	if opts.Prefix == "" {
+		opts.Prefix = "healthchecks."
+	}
+	return &Sink{w: w, opts: opts}
+}
+
+// Count adds value to a counter
+func (s *Sink) Count(name string, value int64, tags ...Tag) {
+	s.add(name, strconv.FormatInt(value, 10), "c", tags)
+}
+
+// Gauge sets a gauge to value
+func (s *Sink) Gauge(name string, value float64, tags ...Tag) {
+	s.add(name, strconv.FormatFloat(value, 'f', -1, 64), "g", tags)
+}
+
+func (s *Sink) add(name, value, kind string, tags []Tag) {
+	var line strings.Builder
+	line.WriteString(sanitize(s.opts.Prefix + name))
+	if !s.opts.DogStatsD {
+		for _, tag := range tags {
+			line.WriteByte('.')
+			line.WriteString(sanitize(strings.ReplaceAll(tag.Value, ".", "_")))
+		}
+	}
+	line.WriteByte(':')
+	line.WriteString(value)
+	line.WriteByte('|')
+	line.WriteString(kind)
+	if s.opts.DogStatsD && len(tags)+len(s.opts.Tags) > 0 {
+		line.WriteString("|#")
+		for i, tag := range s.opts.Tags {
+			if i > 0 {
+				line.WriteByte(',')
+			}
+			line.WriteString(sanitizeTag(tag))
+		}
+		for i, tag := range tags {
+			if i > 0 || len(s.opts.Tags) > 0 {
+				line.WriteByte(',')
+			}
+			line.WriteString(sanitizeTag(tag.Key + ":" + tag.Value))
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.buf.Len() > 0 && s.buf.Len()+1+line.Len() > maxPacket {
+		s.flush()
+	}
+	if s.buf.Len() > 0 {
+		s.buf.WriteByte('\n')
+	}
+	s.buf.WriteString(line.String())
+}
+
+// Flush sends buffered metrics
+func (s *Sink) Flush() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.flush()
+}
+
+func (s *Sink) flush() error {
+	if s.buf.Len() == 0 {
+		return nil
+	}
+	_, err := s.w.Write(s.buf.Bytes())
+	s.buf.Reset()
+	return err
+}
+
+// Close flushes buffered metrics and closes the underlying connection when it's an io.Closer
+func (s *Sink) Close() error {
+	err := s.Flush()
+	if closer, ok := s.w.(io.Closer); ok {
+		if cerr := closer.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+// sanitize replaces the characters separating a statsd line's parts
+var sanitize = strings.NewReplacer(":", "_", "|", "_", "@", "_", "\n", "_", " ", "_").Replace
+
+var sanitizeTag = strings.NewReplacer(",", "_", "|", "_", "#", "_", "\n", "_").Replace
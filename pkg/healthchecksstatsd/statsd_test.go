@@ -0,0 +1,104 @@
+package healthchecksstatsd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+type packets struct {
+	sent []string
+}
+
+func (p *packets) Write(b []byte) (int, error) {
+	p.sent = append(p.sent, string(b))
+	return len(b), nil
+}
+
+func TestSinkFormats(t *testing.T) {
+	var plain packets
+	sink := New(&plain, Options{})
+	sink.Count("api.requests", 2, Tag{"endpoint", "get-checks"})
+	sink.Gauge("check.up", 1, Tag{"check", "db.backup"})
+	require.NoError(t, sink.Flush())
+	require.Equal(t, []string{"healthchecks.api.requests.get-checks:2|c\nhealthchecks.check.up.db_backup:1|g"}, plain.sent)
+
+	var dog packets
+	sink = New(&dog, Options{Prefix: "hc.", DogStatsD: true, Tags: []string{"env:prod"}})
+	sink.Count("api.requests", 2, Tag{"endpoint", "get-checks"})
+	sink.Count("api.errors", 1)
+	require.NoError(t, sink.Flush())
+	require.Equal(t, []string{"hc.api.requests:2|c|#env:prod,endpoint:get-checks\nhc.api.errors:1|c|#env:prod"}, dog.sent)
+}
+
+func TestSinkSplitsPackets(t *testing.T) {
+	var out packets
+	sink := New(&out, Options{})
+	for i := 0; i < 200; i++ {
+		sink.Count("pings.sent", 1)
+	}
+	require.NoError(t, sink.Close())
+
+	require.Greater(t, len(out.sent), 1)
+	lines := 0
+	for _, packet := range out.sent {
+		require.LessOrEqual(t, len(packet), maxPacket)
+		lines += strings.Count(packet, "\n") + 1
+	}
+	require.Equal(t, 200, lines)
+}
+
+type fixedStats struct {
+	stats healthchecksio.Stats
+}
+
+func (f *fixedStats) Stats() healthchecksio.Stats {
+	return f.stats
+}
+
+func TestReporter(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"checks":[{"uuid":"1","slug":"backup","status":"up"},{"uuid":"2","status":"down"}]}`))
+	}))
+	defer api.Close()
+	client := healthchecksio.NewClient("key", healthchecksio.WithBaseURL(api.URL))
+
+	stats := &fixedStats{healthchecksio.Stats{
+		Requests:  map[string]uint64{"get-checks": 3},
+		Failures:  1,
+		PingsSent: 5,
+	}}
+	var out packets
+	reporter := NewReporter(stats, New(&out, Options{DogStatsD: true}), ReporterOptions{Checks: client})
+
+	require.NoError(t, reporter.Report(context.Background()))
+	require.Len(t, out.sent, 1)
+	require.Equal(t, `healthchecks.api.requests:3|c|#endpoint:get-checks
+healthchecks.api.errors:1|c
+healthchecks.pings.sent:5|c
+healthchecks.check.up:1|g|#check:backup
+healthchecks.check.up:0|g|#check:2
+healthchecks.checks:0|g|#status:new
+healthchecks.checks:1|g|#status:up
+healthchecks.checks:0|g|#status:grace
+healthchecks.checks:0|g|#status:started
+healthchecks.checks:1|g|#status:down
+healthchecks.checks:0|g|#status:paused`, out.sent[0])
+
+	// Only what changed since the last report is counted
+	stats.stats = healthchecksio.Stats{
+		Requests:  map[string]uint64{"get-checks": 4},
+		Failures:  1,
+		PingsSent: 5,
+	}
+	reporter.opts.Checks = nil
+	out.sent = nil
+	require.NoError(t, reporter.Report(context.Background()))
+	require.Equal(t, []string{"healthchecks.api.requests:1|c|#endpoint:get-checks"}, out.sent)
+}
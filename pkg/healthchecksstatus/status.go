@@ -0,0 +1,225 @@
+// Package healthchecksstatus serves a read-only HTML status page of checks and a live
+// stream of their status changes, for sharing check state with teams which shouldn't hold an API key.
+package healthchecksstatus
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+)
+
+//go:embed status.html
+var statusHTML string
+
+var statusTemplate = template.Must(template.New("status").Parse(statusHTML))
+
+// Untagged is the group of checks without tags
+const Untagged = "untagged"
+
+// Options configures a Handler
+type Options struct {
+	// Title of the page, defaults to "Status"
+	Title string
+
+	// Filter limits which checks are shown
+	Filter healthchecksio.GetChecks
+
+	// Refresh is how long checks are cached for, and how often the page reloads itself.
+	// Defaults to one minute.
+	Refresh time.Duration
+}
+
+// Handler renders the status page. Checks are fetched at most once per Refresh however
+// many people have the page open, and a failed fetch keeps showing the previous checks.
+type Handler struct {
+	client healthchecksio.CheckReader
+	opts   Options
+	now    func() time.Time
+
+	mu      sync.Mutex
+	checks  []healthchecksio.Check
+	fetched time.Time // when checks were last fetched successfully
+	tried   time.Time // when checks were last fetched
+	err     error
+}
+
+// NewHandler creates a Handler showing checks matching opts.Filter
+func NewHandler(client healthchecksio.CheckReader, opts Options) *Handler {
+	if opts.Title == "" {
+		opts.Title = "Status"
+	}
+	if opts.Refresh <= 0 {
+		opts.Refresh = time.Minute
+	}
+	return &Handler{client: client, opts: opts, now: time.Now}
+}
+
+// Group is a tag and the checks carrying it
+type Group struct {
+	Tag    string
+	Checks []Row
+}
+
+// Row is a check as shown on the page
+type Row struct {
+	Name        string
+	Badge       string
+	LastPing    string
+	LastPingAge string
+}
+
+type page struct {
+	Title          string
+	Summary        string
+	Groups         []Group
+	Updated        string
+	Error          error
+	RefreshSeconds int
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	checks, fetched, err := h.load(r.Context())
+	if fetched.IsZero() {
+		http.Error(w, "checks are unavailable", http.StatusBadGateway)
+		return
+	}
+
+	now := h.now()
+	var buf bytes.Buffer
+	err = statusTemplate.Execute(&buf, page{
+		Title:          h.opts.Title,
+		Summary:        summary(checks),
+		Groups:         Groups(checks, now),
+		Updated:        fetched.Format(time.RFC1123),
+		Error:          err,
+		RefreshSeconds: int(h.opts.Refresh.Seconds()),
+	})
+	if err != nil {
+		slog.ErrorContext(r.Context(), "rendering status page", slog.String("error", err.Error()))
+		http.Error(w, "rendering status page failed", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", int(h.opts.Refresh.Seconds())))
+	w.Write(buf.Bytes())
+}
+
+// load returns the cached checks, refreshing them when they're older than Refresh
+func (h *Handler) load(ctx context.Context) ([]healthchecksio.Check, time.Time, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.now()
+	if now.Sub(h.tried) < h.opts.Refresh {
+		return h.checks, h.fetched, h.err
+	}
+	h.tried = now
+
+	// One viewer going away shouldn't fail the fetch everyone else is waiting on
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
+	defer cancel()
+
+	list, err := h.client.GetChecks(ctx, h.opts.Filter)
+	if err != nil {
+		// Don't echo API details to viewers
+		slog.ErrorContext(ctx, "fetching checks for status page", slog.String("error", err.Error()))
+		h.err = errors.New("checks couldn't be fetched")
+		return h.checks, h.fetched, h.err
+	}
+	h.checks, h.fetched, h.err = list.Checks, now, nil
+	return h.checks, h.fetched, nil
+}
+
+// Groups sorts checks into one group per tag, a check with several tags is in each.
+// Groups are sorted by tag with Untagged last, checks by name.
+func Groups(checks []healthchecksio.Check, now time.Time) []Group {
+	byTag := make(map[string][]Row)
+	for _, check := range checks {
+		tags := strings.Fields(check.Tags)
+		if len(tags) == 0 {
+			tags = []string{Untagged}
+		}
+		row := newRow(check, now)
+		for _, tag := range slices.Compact(slices.Sorted(slices.Values(tags))) {
+			byTag[tag] = append(byTag[tag], row)
+		}
+	}
+
+	groups := make([]Group, 0, len(byTag))
+	for tag, rows := range byTag {
+		slices.SortFunc(rows, func(a, b Row) int { return strings.Compare(a.Name, b.Name) })
+		groups = append(groups, Group{Tag: tag, Checks: rows})
+	}
+	slices.SortFunc(groups, func(a, b Group) int {
+		switch {
+		case a.Tag == Untagged:
+			return 1
+		case b.Tag == Untagged:
+			return -1
+		}
+		return strings.Compare(a.Tag, b.Tag)
+	})
+	return groups
+}
+
+func newRow(check healthchecksio.Check, now time.Time) Row {
+	row := Row{
+		Name:        check.Name,
+		Badge:       badge(healthchecksio.CheckStatus(check.Status)),
+		LastPingAge: "never pinged",
+	}
+	if row.Name == "" {
+		row.Name = check.Slug
+	}
+	if last, pinged := check.LastPingTime(); pinged {
+		row.LastPing = last.Format(time.RFC3339)
+		row.LastPingAge = max(now.Sub(last), 0).Truncate(time.Second).String() + " ago"
+	}
+	return row
+}
+
+func badge(status healthchecksio.CheckStatus) string {
+	switch status {
+	case healthchecksio.StatusGrace:
+		return "late"
+	case healthchecksio.StatusUp, healthchecksio.StatusDown, healthchecksio.StatusStarted, healthchecksio.StatusPaused:
+		return string(status)
+	}
+	return "new"
+}
+
+func summary(checks []healthchecksio.Check) string {
+	var down, late int
+	for _, check := range checks {
+		switch healthchecksio.CheckStatus(check.Status) {
+		case healthchecksio.StatusDown:
+			down++
+		case healthchecksio.StatusGrace:
+			late++
+		}
+	}
+	switch {
+	case down > 0:
+		return fmt.Sprintf("%d of %d checks down, %d late", down, len(checks), late)
+	case late > 0:
+		return fmt.Sprintf("All checks up, %d late", late)
+	}
+	return "All checks up"
+}
@@ -0,0 +1,93 @@
+package healthchecksstatus
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+var now = time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+func TestGroups(t *testing.T) {
+	groups := Groups([]healthchecksio.Check{
+		{Name: "reports", Tags: "prod", Status: "grace"},
+		{Name: "backup", Tags: "prod db", Status: "up", LastPing: "2025-03-01T11:59:00+00:00"},
+		{Slug: "scratch", Status: "new"},
+	}, now)
+
+	require.Equal(t, []Group{
+		{Tag: "db", Checks: []Row{{Name: "backup", Badge: "up", LastPing: "2025-03-01T11:59:00Z", LastPingAge: "1m0s ago"}}},
+		{Tag: "prod", Checks: []Row{
+			{Name: "backup", Badge: "up", LastPing: "2025-03-01T11:59:00Z", LastPingAge: "1m0s ago"},
+			{Name: "reports", Badge: "late", LastPingAge: "never pinged"},
+		}},
+		{Tag: Untagged, Checks: []Row{{Name: "scratch", Badge: "new", LastPingAge: "never pinged"}}},
+	}, groups)
+}
+
+func TestHandler(t *testing.T) {
+	var calls atomic.Int32
+	var fail atomic.Bool
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if fail.Load() {
+			http.Error(w, "down for maintenance", http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"checks":[{"name":"<backup>","tags":"db","status":"down"}]}`))
+	}))
+	defer api.Close()
+
+	client := healthchecksio.NewClient("key", healthchecksio.WithBaseURL(api.URL), healthchecksio.WithRetryEngine(healthchecksio.NoRetries()))
+	handler := NewHandler(client, Options{Title: "Ops"})
+	clock := now
+	handler.now = func() time.Time { return clock }
+
+	get := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+		return w
+	}
+
+	w := get()
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), "&lt;backup&gt;")
+	require.Contains(t, w.Body.String(), `<span class="badge down">down</span>`)
+	require.Contains(t, w.Body.String(), "1 of 1 checks down")
+
+	// Cached until Refresh passes
+	get()
+	require.EqualValues(t, 1, calls.Load())
+
+	// Failures keep showing the last checks without leaking the API's response
+	fail.Store(true)
+	clock = clock.Add(2 * time.Minute)
+	w = get()
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), "refreshing failed")
+	require.Contains(t, w.Body.String(), "&lt;backup&gt;")
+	require.NotContains(t, w.Body.String(), "maintenance")
+	require.EqualValues(t, 2, calls.Load())
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("POST", "/", nil))
+	require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
+
+func TestHandlerUnavailable(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer api.Close()
+
+	client := healthchecksio.NewClient("key", healthchecksio.WithBaseURL(api.URL))
+	w := httptest.NewRecorder()
+	NewHandler(client, Options{}).ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+	require.Equal(t, http.StatusBadGateway, w.Code)
+}
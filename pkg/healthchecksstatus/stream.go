@@ -0,0 +1,188 @@
+package healthchecksstatus
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+)
+
+// Event is a status change as sent to Stream subscribers
+type Event struct {
+	UUID string    `json:"uuid"`
+	Name string    `json:"name"`
+	Slug string    `json:"slug,omitempty"`
+	From string    `json:"from"`
+	To   string    `json:"to"`
+	At   time.Time `json:"at"`
+}
+
+// StreamOptions configures a Stream
+type StreamOptions struct {
+	// Buffer is how many events can queue for a slow subscriber before it's disconnected,
+	// defaults to 64. Browsers reconnect by themselves and are sent the current state.
+	Buffer int
+
+	// KeepAlive is how often a comment is sent on idle connections so proxies don't close
+	// them, defaults to 30 seconds
+	KeepAlive time.Duration
+}
+
+// Stream is an http.Handler sending a Watcher's status changes as Server-Sent Events, so
+// dashboards can update live without each of them polling the API. Subscribers are first
+// sent the latest status of every check, including the ones a restarted Watcher restored from
+// its Store, then a "change" event per transition:
+//
+//	const events = new EventSource("/events")
+//	events.addEventListener("change", e => update(JSON.parse(e.data)))
+type Stream struct {
+	opts StreamOptions
+
+	mu          sync.Mutex
+	latest      map[string]Event
+	subscribers map[chan Event]struct{}
+}
+
+// NewStream creates a Stream of watcher's changes. The watcher still has to be Run.
+func NewStream(watcher *healthchecksio.Watcher, opts StreamOptions) *Stream {
+	if opts.Buffer <= 0 {
+		opts.Buffer = 64
+	}
+	if opts.KeepAlive <= 0 {
+		opts.KeepAlive = 30 * time.Second
+	}
+	s := &Stream{
+		opts:        opts,
+		latest:      make(map[string]Event),
+		subscribers: make(map[chan Event]struct{}),
+	}
+	watcher.OnChange(s.publish)
+	watcher.OnPoll(s.refresh)
+	return s
+}
+
+// refresh replaces the latest status of every check with a poll's, dropping deleted checks.
+// Checks without a published change, such as ones restored from a WatcherStore, are seen at.
+func (s *Stream) refresh(checks []healthchecksio.Check, at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	latest := make(map[string]Event, len(checks))
+	for _, check := range checks {
+		event, exists := s.latest[check.UUID]
+		if !exists || event.To != check.Status {
+			event = Event{UUID: check.UUID, To: check.Status, At: at}
+		}
+		event.Name, event.Slug = check.Name, check.Slug
+		latest[check.UUID] = event
+	}
+	s.latest = latest
+}
+
+func (s *Stream) publish(change healthchecksio.StatusChange) {
+	event := Event{
+		UUID: change.Check.UUID,
+		Name: change.Check.Name,
+		Slug: change.Check.Slug,
+		From: string(change.From),
+		To:   string(change.To),
+		At:   change.At,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.latest[event.UUID] = event
+	for sub := range s.subscribers {
+		select {
+		case sub <- event:
+		default:
+			// The watcher can't wait on a slow subscriber, drop it instead
+			delete(s.subscribers, sub)
+			close(sub)
+		}
+	}
+}
+
+// subscribe registers a subscriber and returns the current state of every check
+func (s *Stream) subscribe() (chan Event, []Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sub := make(chan Event, s.opts.Buffer)
+	s.subscribers[sub] = struct{}{}
+
+	current := make([]Event, 0, len(s.latest))
+	for _, event := range s.latest {
+		current = append(current, event)
+	}
+	slices.SortFunc(current, func(a, b Event) int { return strings.Compare(a.UUID, b.UUID) })
+	return sub, current
+}
+
+func (s *Stream) unsubscribe(sub chan Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.subscribers[sub]; exists {
+		delete(s.subscribers, sub)
+		close(sub)
+	}
+}
+
+func (s *Stream) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no") // nginx buffers responses otherwise
+
+	sub, current := s.subscribe()
+	defer s.unsubscribe(sub)
+
+	for _, event := range current {
+		if err := writeEvent(w, "status", event); err != nil {
+			return
+		}
+	}
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(s.opts.KeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		case event, open := <-sub:
+			if !open {
+				return
+			}
+			if err := writeEvent(w, "change", event); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+	}
+}
+
+func writeEvent(w http.ResponseWriter, name string, event Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", name, data)
+	return err
+}
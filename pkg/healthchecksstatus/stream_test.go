@@ -0,0 +1,124 @@
+package healthchecksstatus
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/go-healthchecksio/pkg/healthchecksio"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStream(t *testing.T) {
+	var status atomic.Value
+	status.Store("up")
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"checks":[{"uuid":"1","name":"backup","status":"` + status.Load().(string) + `"}]}`))
+	}))
+	defer api.Close()
+
+	client := healthchecksio.NewClient("key", healthchecksio.WithBaseURL(api.URL))
+	watcher := healthchecksio.NewWatcher(client, healthchecksio.WatcherOptions{})
+	stream := NewStream(watcher, StreamOptions{})
+
+	ctx := context.Background()
+	_, err := watcher.Poll(ctx)
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(stream)
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	lines := bufio.NewReader(resp.Body)
+	readEvent := func() (string, string) {
+		var name, data string
+		for {
+			line, err := lines.ReadString('\n')
+			require.NoError(t, err)
+			line = strings.TrimSuffix(line, "\n")
+			switch {
+			case line == "":
+				return name, data
+			case strings.HasPrefix(line, "event: "):
+				name = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				data = strings.TrimPrefix(line, "data: ")
+			}
+		}
+	}
+
+	// New subscribers get the current state first
+	name, data := readEvent()
+	require.Equal(t, "status", name)
+	require.Contains(t, data, `"uuid":"1","name":"backup","from":"","to":"up"`)
+
+	status.Store("down")
+	_, err = watcher.Poll(ctx)
+	require.NoError(t, err)
+
+	name, data = readEvent()
+	require.Equal(t, "change", name)
+	require.Contains(t, data, `"from":"up","to":"down"`)
+}
+
+func TestStreamDropsSlowSubscribers(t *testing.T) {
+	watcher := healthchecksio.NewWatcher(nil, healthchecksio.WatcherOptions{})
+	stream := NewStream(watcher, StreamOptions{Buffer: 1})
+
+	sub, _ := stream.subscribe()
+	stream.publish(healthchecksio.StatusChange{To: healthchecksio.StatusUp})
+	stream.publish(healthchecksio.StatusChange{To: healthchecksio.StatusDown})
+
+	<-sub
+	_, open := <-sub
+	require.False(t, open)
+	stream.unsubscribe(sub) // doesn't close twice
+}
+
+func TestStreamAfterRestart(t *testing.T) {
+	var checks atomic.Value
+	checks.Store(`[{"uuid":"1","name":"backup","status":"up"}]`)
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"checks":` + checks.Load().(string) + `}`))
+	}))
+	defer api.Close()
+
+	client := healthchecksio.NewClient("key", healthchecksio.WithBaseURL(api.URL))
+	store := healthchecksio.NewFileWatcherStore(filepath.Join(t.TempDir(), "watcher.json"))
+	ctx := context.Background()
+
+	_, err := healthchecksio.NewWatcher(client, healthchecksio.WatcherOptions{Store: store}).Poll(ctx)
+	require.NoError(t, err)
+
+	// The restarted watcher knows the check, so there's no change yet subscribers still get its status
+	watcher := healthchecksio.NewWatcher(client, healthchecksio.WatcherOptions{Store: store})
+	stream := NewStream(watcher, StreamOptions{})
+	changes, err := watcher.Poll(ctx)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	sub, current := stream.subscribe()
+	defer stream.unsubscribe(sub)
+	require.Len(t, current, 1)
+	require.Equal(t, "backup", current[0].Name)
+	require.Equal(t, "up", current[0].To)
+	require.WithinDuration(t, time.Now(), current[0].At, time.Minute)
+
+	// Deleted checks are dropped
+	checks.Store(`[]`)
+	_, err = watcher.Poll(ctx)
+	require.NoError(t, err)
+	_, current = stream.subscribe()
+	require.Empty(t, current)
+}